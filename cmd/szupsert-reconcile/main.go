@@ -0,0 +1,151 @@
+/*
+Command szupsert-reconcile rebuilds the fingerprints of an [szupsert.BoltStore]
+from the records in the Senzing repository, using [szupsert.Upserter.Reconcile].
+
+Run it after records were added or deleted without the Upserter, or after the store was lost:
+
+	szupsert-reconcile -store /var/lib/loader/fingerprints.db -records customers.jsonl
+
+Every record with a fingerprint is checked.
+Records in the -records file, a JSON Lines file with DATA_SOURCE and RECORD_ID on each line
+such as the file that was loaded, are checked as well.
+The Senzing settings are built from the SENZING_TOOLS_* environment variables,
+e.g. SENZING_TOOLS_DATABASE_URL or SENZING_TOOLS_ENGINE_CONFIGURATION_JSON.
+The summary is written to standard output as JSON.
+*/
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/sz-sdk-go-core/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go-core/szupsert"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// Fragment of a line of the -records file.
+type recordKey struct {
+	DataSourceCode string `json:"DATA_SOURCE"`
+	RecordID       string `json:"RECORD_ID"`
+}
+
+const maxLineBytes = 64 * 1024 * 1024
+
+var errNoStore = errors.New("-store is required")
+
+// ----------------------------------------------------------------------------
+// Main
+// ----------------------------------------------------------------------------
+
+func main() {
+	storePath := flag.String("store", "", "The fingerprint store, as opened by szupsert.OpenBoltStore().")
+	recordsPath := flag.String("records", "", "Optional JSON Lines file of records to check in addition to the store.")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := run(ctx, *storePath, *recordsPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "szupsert-reconcile:", err)
+		stop()
+		os.Exit(1) //nolint:gocritic
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func run(ctx context.Context, storePath string, recordsPath string) error {
+	if len(storePath) == 0 {
+		return errNoStore
+	}
+
+	keys, err := readKeys(recordsPath)
+	if err != nil {
+		return err
+	}
+
+	engineSettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	if err != nil {
+		return fmt.Errorf("building settings: %w", err)
+	}
+
+	store, err := szupsert.OpenBoltStore(storePath)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	defer func() { _ = store.Close() }()
+
+	szAbstractFactory := &szabstractfactory.Szabstractfactory{
+		ConfigID:       senzing.SzInitializeWithDefaultConfiguration,
+		InstanceName:   "szupsert-reconcile",
+		Settings:       engineSettings,
+		VerboseLogging: senzing.SzNoLogging,
+	}
+
+	defer func() { _ = szAbstractFactory.Close(ctx) }()
+
+	szEngine, err := szAbstractFactory.CreateEngine(ctx)
+	if err != nil {
+		return fmt.Errorf("creating SzEngine: %w", err)
+	}
+
+	summary, err := szupsert.New(szEngine, store).Reconcile(ctx, keys...)
+	if summary != nil {
+		_ = json.NewEncoder(os.Stdout).Encode(summary)
+	}
+
+	return err //nolint:wrapcheck
+}
+
+// The keys of the records in a JSON Lines file; none when path is "".
+func readKeys(path string) ([]szupsert.Key, error) {
+	result := []szupsert.Key{}
+
+	if len(path) == 0 {
+		return result, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading records: %w", err)
+	}
+
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxLineBytes)
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var key recordKey
+
+		err = json.Unmarshal(scanner.Bytes(), &key)
+		if err != nil {
+			return nil, fmt.Errorf("reading records: line %d: %w", lineNumber, err)
+		}
+
+		result = append(result, szupsert.NewKey(key.DataSourceCode, key.RecordID))
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("reading records: %w", err)
+	}
+
+	return result, nil
+}
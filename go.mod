@@ -10,6 +10,8 @@ require (
 	github.com/senzing-garage/go-observing v0.3.7
 	github.com/senzing-garage/sz-sdk-go v0.15.14
 	github.com/stretchr/testify v1.12.0
+	go.etcd.io/bbolt v1.5.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -20,5 +22,4 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20260810153831-ec0a7760b754 // indirect
 	google.golang.org/grpc v1.83.0 // indirect
 	google.golang.org/protobuf v1.36.12 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
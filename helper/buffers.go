@@ -0,0 +1,58 @@
+package helper
+
+import (
+	"bytes"
+	"sync"
+)
+
+// ExceptionBufferSize is the size of the buffers that receive the text of the last Senzing exception.
+const ExceptionBufferSize = 65535
+
+// Buffers for exception text are reused; allocating 64 KB on every error path is costly under load.
+var exceptionBuffers = sync.Pool{
+	New: func() any {
+		buffer := make([]byte, ExceptionBufferSize)
+
+		return &buffer
+	},
+}
+
+/*
+The GetExceptionBuffer function returns a buffer of ExceptionBufferSize bytes from a pool.
+Return it with PutExceptionBuffer once its text has been copied.
+
+Output
+  - A pointer to the buffer.
+*/
+func GetExceptionBuffer() *[]byte {
+	buffer, _ := exceptionBuffers.Get().(*[]byte)
+
+	return buffer
+}
+
+/*
+The PutExceptionBuffer function returns a buffer obtained from GetExceptionBuffer to the pool.
+
+Input
+  - buffer: The buffer. It must not be used afterwards.
+*/
+func PutExceptionBuffer(buffer *[]byte) {
+	exceptionBuffers.Put(buffer)
+}
+
+/*
+The NulTerminatedString function returns the text in the buffer before the first NUL byte.
+
+Input
+  - buffer: Bytes written by the Senzing C binary.
+
+Output
+  - A copy of the text.
+*/
+func NulTerminatedString(buffer []byte) string {
+	if index := bytes.IndexByte(buffer, 0); index >= 0 {
+		buffer = buffer[:index]
+	}
+
+	return string(buffer)
+}
@@ -0,0 +1,69 @@
+package helper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Like a buffer handed to the Senzing C binary, the allocated buffer escapes to the heap.
+var escapedBuffer []byte
+
+// ----------------------------------------------------------------------------
+// Interface methods - test
+// ----------------------------------------------------------------------------
+
+func TestHelpers_GetExceptionBuffer(test *testing.T) {
+	buffer := helper.GetExceptionBuffer()
+	require.NotNil(test, buffer)
+	assert.Len(test, *buffer, helper.ExceptionBufferSize)
+	helper.PutExceptionBuffer(buffer)
+}
+
+func TestHelpers_NulTerminatedString(test *testing.T) {
+	assert.Equal(test, "SENZ0033|Unknown record", helper.NulTerminatedString([]byte("SENZ0033|Unknown record\x00\x00")))
+	assert.Empty(test, helper.NulTerminatedString([]byte("\x00left over from earlier use")))
+	assert.Equal(test, "no terminator", helper.NulTerminatedString([]byte("no terminator")))
+	assert.Empty(test, helper.NulTerminatedString(nil))
+}
+
+func TestHelpers_NulTerminatedString_reusedBuffer(test *testing.T) {
+	buffer := helper.GetExceptionBuffer()
+	copy(*buffer, "a longer exception from an earlier call\x00")
+	helper.PutExceptionBuffer(buffer)
+
+	buffer = helper.GetExceptionBuffer()
+	defer helper.PutExceptionBuffer(buffer)
+
+	copy(*buffer, "short\x00")
+	assert.Equal(test, "short", helper.NulTerminatedString(*buffer))
+}
+
+// ----------------------------------------------------------------------------
+// Benchmarks
+// ----------------------------------------------------------------------------
+
+func BenchmarkHelpers_ExceptionBuffer_allocated(benchmark *testing.B) {
+	benchmark.ReportAllocs()
+
+	for benchmark.Loop() {
+		buffer := make([]byte, helper.ExceptionBufferSize)
+		escapedBuffer = buffer
+		copy(buffer, "SENZ0033|Unknown record\x00")
+		_ = string(bytes.Trim(buffer, "\x00"))
+	}
+}
+
+func BenchmarkHelpers_ExceptionBuffer_pooled(benchmark *testing.B) {
+	benchmark.ReportAllocs()
+
+	for benchmark.Loop() {
+		buffer := helper.GetExceptionBuffer()
+		copy(*buffer, "SENZ0033|Unknown record\x00")
+		_ = helper.NulTerminatedString(*buffer)
+		helper.PutExceptionBuffer(buffer)
+	}
+}
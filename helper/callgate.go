@@ -0,0 +1,230 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+/*
+Type CallGate struct counts the calls in flight into a Senzing object so that
+the object can be destroyed only after those calls have finished.
+It can also hold new calls while the object is being reinitialized.
+
+The zero value is an open gate.
+*/
+type CallGate struct {
+	drained     chan struct{}
+	held        chan struct{}
+	heldDrained chan struct{}
+	inFlight    int
+	isClosed    bool
+	mutex       sync.Mutex
+}
+
+var (
+	// ErrCallGateClosed is returned by [CallGate.Close] and [CallGate.Hold] when the gate has already been closed.
+	ErrCallGateClosed = errors.New("call gate is closed")
+
+	// ErrCallGateHeld is returned by [CallGate.Hold] when the gate is already held.
+	ErrCallGateHeld = errors.New("call gate is held")
+)
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Close stops accepting new calls and waits for the calls in flight to finish.
+
+If the context is done before the calls finish, the gate is reopened so the caller may retry.
+
+Input
+  - ctx: A context to bound the wait.
+
+Output
+  - nil when all calls have finished.
+  - ErrCallGateClosed if the gate was already closed, otherwise the error of the context.
+*/
+func (gate *CallGate) Close(ctx context.Context) error {
+	gate.mutex.Lock()
+
+	if gate.isClosed {
+		gate.mutex.Unlock()
+
+		return ErrCallGateClosed
+	}
+
+	gate.isClosed = true
+
+	if gate.inFlight == 0 {
+		gate.mutex.Unlock()
+
+		return nil
+	}
+
+	drained := make(chan struct{})
+	gate.drained = drained
+	gate.mutex.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		gate.mutex.Lock()
+		defer gate.mutex.Unlock()
+
+		select {
+		case <-drained:
+			return nil
+		default:
+		}
+
+		gate.drained = nil
+		gate.isClosed = false
+
+		return ctx.Err()
+	}
+}
+
+/*
+Method Enter registers a call in flight.
+Each successful Enter must be followed by an Exit.
+While the gate is held, Enter waits for it to be released.
+
+Output
+  - false if the gate is closed and the call must be rejected.
+*/
+func (gate *CallGate) Enter() bool {
+	gate.mutex.Lock()
+	defer gate.mutex.Unlock()
+
+	for gate.held != nil && !gate.isClosed {
+		held := gate.held
+
+		gate.mutex.Unlock()
+		<-held
+		gate.mutex.Lock()
+	}
+
+	if gate.isClosed {
+		return false
+	}
+
+	gate.inFlight++
+
+	return true
+}
+
+/*
+Method Exit unregisters a call in flight.
+*/
+func (gate *CallGate) Exit() {
+	gate.mutex.Lock()
+	defer gate.mutex.Unlock()
+
+	gate.inFlight--
+
+	if gate.inFlight == 0 && gate.drained != nil {
+		close(gate.drained)
+		gate.drained = nil
+	}
+
+	if gate.inFlight == 0 && gate.heldDrained != nil {
+		close(gate.heldDrained)
+		gate.heldDrained = nil
+	}
+}
+
+/*
+Method Hold makes new calls wait, then waits for the calls in flight to finish.
+Each successful Hold must be followed by a Release.
+
+If the context is done before the calls finish, the gate is released so the caller may retry.
+
+Input
+  - ctx: A context to bound the wait.
+
+Output
+  - nil when all calls have finished.
+  - ErrCallGateClosed or ErrCallGateHeld if the gate is closed or held, otherwise the error of the context.
+*/
+func (gate *CallGate) Hold(ctx context.Context) error {
+	gate.mutex.Lock()
+
+	switch {
+	case gate.isClosed:
+		gate.mutex.Unlock()
+
+		return ErrCallGateClosed
+	case gate.held != nil:
+		gate.mutex.Unlock()
+
+		return ErrCallGateHeld
+	}
+
+	gate.held = make(chan struct{})
+
+	if gate.inFlight == 0 {
+		gate.mutex.Unlock()
+
+		return nil
+	}
+
+	drained := make(chan struct{})
+	gate.heldDrained = drained
+	gate.mutex.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		gate.Release()
+
+		return ctx.Err()
+	}
+}
+
+/*
+Method InFlight returns the number of calls in flight.
+*/
+func (gate *CallGate) InFlight() int {
+	gate.mutex.Lock()
+	defer gate.mutex.Unlock()
+
+	return gate.inFlight
+}
+
+/*
+Method IsClosed reports whether the gate rejects new calls.
+*/
+func (gate *CallGate) IsClosed() bool {
+	gate.mutex.Lock()
+	defer gate.mutex.Unlock()
+
+	return gate.isClosed
+}
+
+/*
+Method IsHeld reports whether new calls wait.
+*/
+func (gate *CallGate) IsHeld() bool {
+	gate.mutex.Lock()
+	defer gate.mutex.Unlock()
+
+	return gate.held != nil
+}
+
+/*
+Method Release lets the calls held by Hold proceed.
+*/
+func (gate *CallGate) Release() {
+	gate.mutex.Lock()
+	defer gate.mutex.Unlock()
+
+	if gate.held != nil {
+		close(gate.held)
+		gate.held = nil
+		gate.heldDrained = nil
+	}
+}
@@ -0,0 +1,128 @@
+package helper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Interface methods - test
+// ----------------------------------------------------------------------------
+
+func TestHelpers_CallGate_Close(test *testing.T) {
+	ctx := test.Context()
+	gate := &helper.CallGate{}
+	require.True(test, gate.Enter())
+	assert.Equal(test, 1, gate.InFlight())
+
+	closed := make(chan error)
+
+	go func() { closed <- gate.Close(ctx) }()
+
+	require.Eventually(test, gate.IsClosed, time.Second, time.Millisecond)
+	assert.False(test, gate.Enter())
+
+	select {
+	case <-closed:
+		require.Fail(test, "Close returned while a call was in flight")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	gate.Exit()
+	require.NoError(test, <-closed)
+	assert.Equal(test, 0, gate.InFlight())
+	require.ErrorIs(test, gate.Close(ctx), helper.ErrCallGateClosed)
+}
+
+func TestHelpers_CallGate_Close_idle(test *testing.T) {
+	gate := &helper.CallGate{}
+	require.NoError(test, gate.Close(test.Context()))
+	assert.True(test, gate.IsClosed())
+	assert.False(test, gate.Enter())
+}
+
+func TestHelpers_CallGate_Close_timeout(test *testing.T) {
+	gate := &helper.CallGate{}
+	require.True(test, gate.Enter())
+
+	ctx, cancel := context.WithTimeout(test.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	err := gate.Close(ctx)
+	require.ErrorIs(test, err, context.DeadlineExceeded)
+	assert.False(test, gate.IsClosed())
+
+	gate.Exit()
+	require.True(test, gate.Enter())
+	gate.Exit()
+	require.NoError(test, gate.Close(test.Context()))
+}
+
+func TestHelpers_CallGate_Hold(test *testing.T) {
+	ctx := test.Context()
+	gate := &helper.CallGate{}
+	require.True(test, gate.Enter())
+
+	held := make(chan error)
+
+	go func() { held <- gate.Hold(ctx) }()
+
+	entered := make(chan bool)
+
+	require.Eventually(test, gate.IsHeld, time.Second, time.Millisecond)
+	require.ErrorIs(test, gate.Hold(ctx), helper.ErrCallGateHeld)
+
+	go func() { entered <- gate.Enter() }()
+
+	select {
+	case <-held:
+		require.Fail(test, "Hold returned while a call was in flight")
+	case <-entered:
+		require.Fail(test, "Enter did not wait while the gate was held")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	gate.Exit()
+	require.NoError(test, <-held)
+	assert.Equal(test, 0, gate.InFlight())
+
+	gate.Release()
+	assert.True(test, <-entered)
+	assert.Equal(test, 1, gate.InFlight())
+	gate.Exit()
+}
+
+func TestHelpers_CallGate_Hold_timeout(test *testing.T) {
+	gate := &helper.CallGate{}
+	require.True(test, gate.Enter())
+
+	ctx, cancel := context.WithTimeout(test.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(test, gate.Hold(ctx), context.DeadlineExceeded)
+	gate.Exit()
+	require.True(test, gate.Enter())
+	gate.Exit()
+	require.NoError(test, gate.Hold(test.Context()))
+	gate.Release()
+}
+
+func TestHelpers_CallGate_Hold_closed(test *testing.T) {
+	ctx := test.Context()
+	gate := &helper.CallGate{}
+	require.NoError(test, gate.Hold(ctx))
+
+	entered := make(chan bool)
+
+	go func() { entered <- gate.Enter() }()
+
+	require.NoError(test, gate.Close(ctx))
+	gate.Release()
+	assert.False(test, <-entered)
+	require.ErrorIs(test, gate.Hold(ctx), helper.ErrCallGateClosed)
+}
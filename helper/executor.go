@@ -0,0 +1,147 @@
+package helper
+
+import (
+	"runtime"
+	"sync"
+)
+
+/*
+Type Executor struct runs calls into the Senzing C binary on a fixed pool of
+goroutines that are permanently locked to their OS threads.
+
+Senzing keeps the last exception per OS thread, so a call and the retrieval of
+its exception must happen on the same thread.
+Without an Executor every method locks and unlocks the calling goroutine to its thread.
+With an Executor the lock is taken once per worker and the nested
+runtime.LockOSThread() calls in the Sz* methods only adjust a counter.
+
+A nil *Executor runs calls on the calling goroutine.
+*/
+type Executor struct {
+	closeOnce sync.Once
+	done      chan struct{}
+	jobs      chan executorJob
+	waitGroup sync.WaitGroup
+}
+
+type executorJob struct {
+	finished chan any
+	task     func()
+}
+
+// Channels that receive the panic value, or nil, when a job finishes; reused to avoid an allocation per call.
+var finishedChannels = sync.Pool{
+	New: func() any { return make(chan any, 1) },
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The NewExecutor function starts an Executor.
+
+Input
+  - workers: The number of OS threads. Values less than 1 are treated as runtime.GOMAXPROCS(0).
+
+Output
+  - A running Executor. Call Close() to stop its workers.
+*/
+func NewExecutor(workers int) *Executor {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	result := &Executor{
+		done: make(chan struct{}),
+		jobs: make(chan executorJob),
+	}
+
+	result.waitGroup.Add(workers)
+
+	for range workers {
+		go result.work()
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Call runs the task on a worker and waits for it to finish.
+If the Executor is nil or has been closed, the task runs on the calling goroutine.
+A panic in the task is raised again on the calling goroutine.
+
+Input
+  - task: The function to run. It must not call Call() on the same Executor.
+*/
+func (executor *Executor) Call(task func()) {
+	if executor == nil {
+		task()
+
+		return
+	}
+
+	finished, _ := finishedChannels.Get().(chan any)
+	job := executorJob{
+		finished: finished,
+		task:     task,
+	}
+
+	select {
+	case executor.jobs <- job:
+		panicValue := <-finished
+		finishedChannels.Put(finished)
+
+		if panicValue != nil {
+			panic(panicValue)
+		}
+	case <-executor.done:
+		finishedChannels.Put(finished)
+		task()
+	}
+}
+
+/*
+Method Close stops the workers after the tasks they are running have finished.
+Calls made after Close run on the calling goroutine.
+*/
+func (executor *Executor) Close() {
+	if executor == nil {
+		return
+	}
+
+	executor.closeOnce.Do(func() { close(executor.done) })
+	executor.waitGroup.Wait()
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// The worker never unlocks its OS thread.
+// When the goroutine exits, the Go runtime terminates the thread with it.
+func (executor *Executor) work() {
+	runtime.LockOSThread()
+
+	defer executor.waitGroup.Done()
+
+	for {
+		select {
+		case job := <-executor.jobs:
+			job.run()
+		case <-executor.done:
+			return
+		}
+	}
+}
+
+// A panic is handed to the caller instead of taking down the worker.
+func (job executorJob) run() {
+	defer func() { job.finished <- recover() }()
+
+	job.task()
+}
@@ -0,0 +1,124 @@
+package helper_test
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/internal/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const benchmarkCallers = 64
+
+// ----------------------------------------------------------------------------
+// Interface methods - test
+// ----------------------------------------------------------------------------
+
+func TestHelpers_Executor_Call(test *testing.T) {
+	executor := helper.NewExecutor(2)
+	defer executor.Close()
+
+	var waitGroup sync.WaitGroup
+
+	results := make([]int, 100)
+
+	for index := range results {
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			executor.Call(func() { results[index] = index })
+		}()
+	}
+
+	waitGroup.Wait()
+
+	for index, result := range results {
+		assert.Equal(test, index, result)
+	}
+}
+
+func TestHelpers_Executor_Call_afterClose(test *testing.T) {
+	executor := helper.NewExecutor(1)
+	executor.Close()
+	executor.Close()
+
+	called := false
+
+	executor.Call(func() { called = true })
+	assert.True(test, called)
+}
+
+func TestHelpers_Executor_Call_nil(test *testing.T) {
+	var executor *helper.Executor
+
+	called := false
+
+	executor.Call(func() { called = true })
+	assert.True(test, called)
+	executor.Close()
+}
+
+func TestHelpers_Executor_Call_panic(test *testing.T) {
+	executor := helper.NewExecutor(1)
+	defer executor.Close()
+
+	require.PanicsWithValue(test, "boom", func() {
+		executor.Call(func() { panic("boom") })
+	})
+
+	// The worker survives the panic.
+
+	called := false
+
+	executor.Call(func() { called = true })
+	assert.True(test, called)
+}
+
+func TestHelpers_Executor_NewExecutor_defaultWorkers(test *testing.T) {
+	executor := helper.NewExecutor(0)
+	defer executor.Close()
+
+	called := false
+
+	executor.Call(func() { called = true })
+	assert.True(test, called)
+}
+
+// ----------------------------------------------------------------------------
+// Benchmarks
+// ----------------------------------------------------------------------------
+
+// The task mimics a private Sz* method: it locks the OS thread twice, nested,
+// as the public method, the choreography and the C helper do.
+
+func BenchmarkHelpers_Executor_Call(benchmark *testing.B) {
+	executor := helper.NewExecutor(runtime.GOMAXPROCS(0))
+	defer executor.Close()
+
+	benchmark.SetParallelism(max(1, benchmarkCallers/runtime.GOMAXPROCS(0)))
+	testhelper.BenchmarkLatency(benchmark, func() { executor.Call(lockedTask) })
+}
+
+func BenchmarkHelpers_Executor_inline(benchmark *testing.B) {
+	benchmark.SetParallelism(max(1, benchmarkCallers/runtime.GOMAXPROCS(0)))
+	testhelper.BenchmarkLatency(benchmark, lockedTask)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func lockedTask() {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	runtime.Gosched()
+}
@@ -39,3 +39,24 @@ func GetLogger(componentID int, idMessages map[int]string, callerSkip int, optio
 
 	return result
 }
+
+/*
+The MergeIDMessages function combines maps of error identifiers to error strings.
+
+Input
+  - idMessages: Maps of error identifiers to error strings. Later maps take precedence.
+
+Output
+  - A new map containing all entries.
+*/
+func MergeIDMessages(idMessages ...map[int]string) map[int]string {
+	result := map[int]string{}
+
+	for _, messages := range idMessages {
+		for key, value := range messages {
+			result[key] = value
+		}
+	}
+
+	return result
+}
@@ -15,3 +15,10 @@ func TestHelpers_GetLogger(test *testing.T) {
 	x := helper.GetLogger(1, map[int]string{}, 4)
 	assert.NotEmpty(test, x)
 }
+
+func TestHelpers_MergeIDMessages(test *testing.T) {
+	base := map[int]string{1: "one", 2: "two"}
+	actual := helper.MergeIDMessages(base, map[int]string{2: "deux", 3: "trois"})
+	assert.Equal(test, map[int]string{1: "one", 2: "deux", 3: "trois"}, actual)
+	assert.Equal(test, "two", base[2])
+}
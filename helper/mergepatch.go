@@ -0,0 +1,98 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+/*
+The MergePatch function applies a JSON Merge Patch, as defined by [RFC 7386], to a JSON document.
+
+An object in the patch is merged key by key: a null value removes the key from the target,
+an object value is merged recursively, and any other value replaces the target's value.
+A patch that is not an object replaces the whole target.
+
+Input
+  - target: The JSON document to patch. Empty means null.
+  - patch: The JSON Merge Patch.
+
+Output
+  - The patched JSON document, with object keys sorted and numbers kept as written.
+
+[RFC 7386]: https://www.rfc-editor.org/rfc/rfc7386
+*/
+func MergePatch(target []byte, patch []byte) ([]byte, error) {
+	var targetValue, patchValue any
+
+	if len(bytes.TrimSpace(target)) > 0 {
+		err := decodeJSON(target, &targetValue)
+		if err != nil {
+			return nil, fmt.Errorf("parsing merge patch target: %w", err)
+		}
+	}
+
+	err := decodeJSON(patch, &patchValue)
+	if err != nil {
+		return nil, fmt.Errorf("parsing merge patch: %w", err)
+	}
+
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	err = encoder.Encode(mergeValue(targetValue, patchValue))
+	if err != nil {
+		return nil, fmt.Errorf("encoding merge patch result: %w", err)
+	}
+
+	return bytes.TrimSpace(buffer.Bytes()), nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Decode a single JSON value, keeping numbers as json.Number so they are not rounded.
+func decodeJSON(document []byte, value *any) error {
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+
+	err := decoder.Decode(value)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	_, err = decoder.Token()
+	if !errors.Is(err, io.EOF) {
+		return fmt.Errorf("unexpected data after offset %d", decoder.InputOffset()) //nolint:err113
+	}
+
+	return nil
+}
+
+// The MergePatch(Target, Patch) pseudo-code of RFC 7386, section 2.
+func mergeValue(target any, patch any) any {
+	patchObject, isObject := patch.(map[string]any)
+	if !isObject {
+		return patch
+	}
+
+	targetObject, isObject := target.(map[string]any)
+	if !isObject {
+		targetObject = map[string]any{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+		} else {
+			targetObject[key] = mergeValue(targetObject[key], value)
+		}
+	}
+
+	return targetObject
+}
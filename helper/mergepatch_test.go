@@ -0,0 +1,65 @@
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Interface methods - test
+// ----------------------------------------------------------------------------
+
+func TestHelpers_MergePatch(test *testing.T) {
+	// The examples of RFC 7386, appendix A.
+	testCases := []struct {
+		target   string
+		patch    string
+		expected string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+		{``, `{"a":"b"}`, `{"a":"b"}`},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := helper.MergePatch([]byte(testCase.target), []byte(testCase.patch))
+		require.NoError(test, err, testCase.patch)
+		assert.JSONEq(test, testCase.expected, string(actual), "%s + %s", testCase.target, testCase.patch)
+	}
+}
+
+func TestHelpers_MergePatch_keepsNumbersAndText(test *testing.T) {
+	actual, err := helper.MergePatch(
+		[]byte(`{"ACCOUNT_NUMBER": 12345678901234567890, "NAME_FULL": "Smith & Sons <Ltd>"}`),
+		[]byte(`{"AMOUNT": 1.50}`),
+	)
+	require.NoError(test, err)
+	assert.Equal(test, `{"ACCOUNT_NUMBER":12345678901234567890,"AMOUNT":1.50,"NAME_FULL":"Smith & Sons <Ltd>"}`, string(actual))
+}
+
+func TestHelpers_MergePatch_badJSON(test *testing.T) {
+	_, err := helper.MergePatch([]byte(`{"a":`), []byte(`{}`))
+	require.ErrorContains(test, err, "parsing merge patch target")
+
+	_, err = helper.MergePatch([]byte(`{}`), []byte(`{"a": 1} {"b": 2}`))
+	require.ErrorContains(test, err, "parsing merge patch")
+
+	_, err = helper.MergePatch([]byte(`{}`), []byte(`{"a": 1}}`))
+	require.Error(test, err)
+}
@@ -43,3 +43,32 @@ func GetMessenger(
 
 	return result
 }
+
+// Type jsonError has a JSON message for wraperror.Errorf and unwraps to the error that caused it.
+type jsonError struct {
+	cause   error
+	message string
+}
+
+func (err *jsonError) Error() string { return err.message }
+
+func (err *jsonError) Unwrap() error { return err.cause }
+
+/*
+The NewJSONError function returns an error whose text is a JSON message and which unwraps to its cause.
+wraperror.Errorf only keeps the chain of errors whose text is JSON,
+so this keeps errors such as those from an [io.Writer] visible to errors.Is().
+
+Input
+  - message: A JSON document, e.g. from [messenger.Messenger.NewJSON].
+  - cause: The underlying error.
+
+Output
+  - The error.
+*/
+func NewJSONError(message string, cause error) error {
+	return &jsonError{
+		cause:   cause,
+		message: message,
+	}
+}
@@ -1,10 +1,13 @@
 package helper_test
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/senzing-garage/go-helpers/wraperror"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // ----------------------------------------------------------------------------
@@ -15,3 +18,11 @@ func TestHelpers_GetMessenger(test *testing.T) {
 	x := helper.GetMessenger(1, map[int]string{}, 4)
 	assert.NotEmpty(test, x)
 }
+
+func TestHelpers_NewJSONError(test *testing.T) {
+	cause := errors.New("broken pipe")
+	err := helper.NewJSONError(`{"id":"SZSDK60044030","reason":"broken pipe"}`, cause)
+	wrapped := wraperror.Errorf(err, wraperror.NoMessage)
+	require.ErrorIs(test, wrapped, cause)
+	require.JSONEq(test, `{"id":"SZSDK60044030","reason":"broken pipe"}`, err.Error())
+}
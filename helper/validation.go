@@ -0,0 +1,43 @@
+package helper
+
+import (
+	"errors"
+
+	"github.com/senzing-garage/go-messaging/messenger"
+	"github.com/senzing-garage/sz-sdk-go-core/sdkerror"
+	"github.com/senzing-garage/sz-sdk-go-core/validation"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+/*
+The CheckInputs function validates parameters before they are sent to the Senzing C binary.
+
+Input
+  - componentID: The 4-digit identifier of the component used as "cccc" in the "SZSDKcccceeee" message identifier.
+  - errorNumber: The error identifier used as "eeee" in the "SZSDKcccceeee" message identifier.
+  - aMessenger: The message generator of the component.
+  - inputs: The parameters to check.
+
+Output
+  - nil if all parameters are acceptable, otherwise an *sdkerror.Error wrapping [szerror.ErrSzBadInput].
+
+[szerror.ErrSzBadInput]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+*/
+func CheckInputs(componentID int, errorNumber int, aMessenger messenger.Messenger, inputs ...validation.Input) error {
+	err := validation.Check(inputs...)
+	if err == nil {
+		return nil
+	}
+
+	reason := err.Error()
+	errorMessage := aMessenger.NewJSON(errorNumber, messenger.MessageReason{Value: reason})
+
+	return sdkerror.New(
+		componentID,
+		errorNumber,
+		0,
+		reason,
+		nil,
+		errors.Join(szerror.ErrSzBadInput, szerror.ErrSz, errors.New(errorMessage)), //nolint:err113
+	)
+}
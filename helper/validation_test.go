@@ -0,0 +1,38 @@
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/sdkerror"
+	"github.com/senzing-garage/sz-sdk-go-core/validation"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Interface methods - test
+// ----------------------------------------------------------------------------
+
+func TestHelpers_CheckInputs(test *testing.T) {
+	aMessenger := helper.GetMessenger(6004, map[int]string{}, 4)
+	err := helper.CheckInputs(6004, 4001, aMessenger, validation.Identifier("recordID", "1001"))
+	require.NoError(test, err)
+}
+
+func TestHelpers_CheckInputs_badInput(test *testing.T) {
+	aMessenger := helper.GetMessenger(6004, map[int]string{}, 4)
+	err := helper.CheckInputs(6004, 4001, aMessenger, validation.Identifier("recordID", "1001\x00"))
+	err = wraperror.Errorf(err, wraperror.NoMessage)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	require.ErrorIs(test, err, szerror.ErrSz)
+	assert.Contains(test, err.Error(), `"id":"SZSDK60044001"`)
+	assert.Contains(test, err.Error(), `"reason":"recordID contains a NUL byte at offset 4"`)
+
+	sdkError, isOK := sdkerror.From(err)
+	require.True(test, isOK)
+	assert.Equal(test, "SZSDK60044001", sdkError.MessageID)
+	assert.Equal(test, "recordID contains a NUL byte at offset 4", sdkError.Reason)
+}
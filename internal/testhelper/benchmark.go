@@ -0,0 +1,65 @@
+package testhelper
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The BenchmarkLatency function runs call in parallel and reports latency percentiles along with throughput.
+Call benchmark.SetParallelism() first to change the number of calling goroutines.
+
+Input
+  - benchmark: The benchmark.
+  - call: The function to measure.
+*/
+func BenchmarkLatency(benchmark *testing.B, call func()) {
+	benchmark.Helper()
+
+	var (
+		latencies = make([]time.Duration, 0, benchmark.N)
+		mutex     sync.Mutex
+	)
+
+	benchmark.ResetTimer()
+	benchmark.RunParallel(func(pb *testing.PB) {
+		local := []time.Duration{}
+
+		for pb.Next() {
+			start := time.Now()
+
+			call()
+
+			local = append(local, time.Since(start))
+		}
+
+		mutex.Lock()
+
+		latencies = append(latencies, local...)
+
+		mutex.Unlock()
+	})
+	benchmark.StopTimer()
+
+	if len(latencies) == 0 {
+		return
+	}
+
+	slices.Sort(latencies)
+	benchmark.ReportMetric(float64(percentile(latencies, percentile50).Nanoseconds()), "p50-ns")
+	benchmark.ReportMetric(float64(percentile(latencies, percentile99).Nanoseconds()), "p99-ns")
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func percentile(sorted []time.Duration, pct int) time.Duration {
+	return sorted[(len(sorted)-1)*pct/percentile100]
+}
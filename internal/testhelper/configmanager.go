@@ -0,0 +1,253 @@
+package testhelper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+/*
+Type Config struct is a configuration that exports its definition unchanged.
+Other senzing.SzConfig methods panic.
+*/
+type Config struct {
+	senzing.SzConfig
+
+	Definition string
+}
+
+/*
+Type ConfigManager struct is an in-memory senzing.SzConfigManager
+with the configuration registry and the compare-and-swap of the default configuration.
+Other senzing.SzConfigManager methods panic.
+
+Fields may be read and set by a test while no method is running.
+*/
+type ConfigManager struct {
+	senzing.SzConfigManager
+
+	Comments        map[int64]string
+	Conflicts       int // ReplaceDefaultConfigID loses this many more races; see Rival.
+	CreatedAt       map[int64]time.Time
+	DefaultConfigID int64
+	Definitions     map[int64]string
+	NextConfigID    int64
+
+	// Builds the configurations returned by CreateConfigFromConfigID. Nil means a *Config.
+	NewConfig func(definition string) senzing.SzConfig
+
+	// The definition another process registers and makes the default when a race is lost.
+	// Nil means the definition of the default configuration followed by a space,
+	// so that it gets its own identifier.
+	Rival func(definition string) string
+
+	mutex sync.Mutex
+}
+
+// A CONFIGS entry of a GetConfigRegistry() response.
+type registryEntry struct {
+	ConfigComments string `json:"CONFIG_COMMENTS"`
+	ConfigID       int64  `json:"CONFIG_ID"`
+	SysCreateDate  string `json:"SYS_CREATE_DT"`
+}
+
+// When the first configuration of a ConfigManager is registered.
+var firstCreatedAt = time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewConfigManager function returns a ConfigManager holding a configuration per definition,
+with configuration identifiers from 1 and comment "Initial".
+The last one is the default.
+*/
+func NewConfigManager(definitions ...string) *ConfigManager {
+	result := &ConfigManager{ //exhaustruct:ignore
+		Comments:     map[int64]string{},
+		CreatedAt:    map[int64]time.Time{},
+		Definitions:  map[int64]string{},
+		NextConfigID: 1,
+	}
+
+	for _, definition := range definitions {
+		result.DefaultConfigID, _ = result.RegisterConfig(context.Background(), definition, "Initial")
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Export returns the definition.
+*/
+func (config *Config) Export(ctx context.Context) (string, error) {
+	_ = ctx
+
+	return config.Definition, nil
+}
+
+/*
+Method AddConfig adds a configuration as if it had been registered at createdAt.
+*/
+func (manager *ConfigManager) AddConfig(configID int64, definition string, comment string, createdAt time.Time) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	manager.Comments[configID] = comment
+	manager.CreatedAt[configID] = createdAt
+	manager.Definitions[configID] = definition
+	manager.NextConfigID = max(manager.NextConfigID, configID+1)
+}
+
+/*
+Method CreateConfigFromConfigID returns the configuration built by NewConfig.
+Unknown identifiers are errors wrapping szerror.ErrSzConfiguration.
+*/
+func (manager *ConfigManager) CreateConfigFromConfigID(ctx context.Context, configID int64) (senzing.SzConfig, error) {
+	_ = ctx
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	definition, isFound := manager.Definitions[configID]
+	if !isFound {
+		return nil, errors.Join(szerror.ErrSzConfiguration, errors.New("unknown configuration "+strconv.FormatInt(configID, baseTen)))
+	}
+
+	if manager.NewConfig != nil {
+		return manager.NewConfig(definition), nil
+	}
+
+	return &Config{Definition: definition}, nil //exhaustruct:ignore
+}
+
+/*
+Method GetConfigRegistry returns the configurations in the order of their identifiers.
+*/
+func (manager *ConfigManager) GetConfigRegistry(ctx context.Context) (string, error) {
+	_ = ctx
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	document := struct {
+		Configs []registryEntry `json:"CONFIGS"`
+	}{Configs: []registryEntry{}}
+
+	for _, configID := range slices.Sorted(maps.Keys(manager.Definitions)) {
+		document.Configs = append(document.Configs, registryEntry{
+			ConfigComments: manager.Comments[configID],
+			ConfigID:       configID,
+			SysCreateDate:  manager.CreatedAt[configID].Format(time.RFC3339),
+		})
+	}
+
+	response, err := json.Marshal(document)
+
+	return string(response), err //nolint:wrapcheck
+}
+
+/*
+Method GetDefaultConfigID returns DefaultConfigID.
+*/
+func (manager *ConfigManager) GetDefaultConfigID(ctx context.Context) (int64, error) {
+	_ = ctx
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	return manager.DefaultConfigID, nil
+}
+
+/*
+Method RegisterConfig adds a configuration with the next identifier, a minute after the previous one.
+As in a Senzing repository, where configuration identifiers are derived from the definition,
+a definition already registered keeps its identifier and comment.
+*/
+func (manager *ConfigManager) RegisterConfig(ctx context.Context, configDefinition string, configComment string) (int64, error) {
+	_ = ctx
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	return manager.register(configDefinition, configComment), nil
+}
+
+/*
+Method ReplaceDefaultConfigID makes newDefaultConfigID the default if currentDefaultConfigID still is.
+While Conflicts is above 0, another process first registers a configuration, see Rival, and makes it the default.
+*/
+func (manager *ConfigManager) ReplaceDefaultConfigID(
+	ctx context.Context,
+	currentDefaultConfigID int64,
+	newDefaultConfigID int64,
+) error {
+	_ = ctx
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if manager.Conflicts > 0 {
+		manager.Conflicts--
+
+		definition := manager.Definitions[manager.DefaultConfigID] + " "
+		if manager.Rival != nil {
+			definition = manager.Rival(manager.Definitions[manager.DefaultConfigID])
+		}
+
+		manager.DefaultConfigID = manager.register(definition, "Rival")
+	}
+
+	if currentDefaultConfigID != manager.DefaultConfigID {
+		return errors.Join(szerror.ErrSzReplaceConflict, errors.New("default changed"))
+	}
+
+	if _, isFound := manager.Definitions[newDefaultConfigID]; !isFound {
+		return errors.Join(szerror.ErrSzConfiguration, errors.New("unknown configuration"))
+	}
+
+	manager.DefaultConfigID = newDefaultConfigID
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Register a configuration. The caller holds the mutex.
+func (manager *ConfigManager) register(definition string, comment string) int64 {
+	for configID, otherDefinition := range manager.Definitions {
+		if otherDefinition == definition {
+			return configID
+		}
+	}
+
+	createdAt := firstCreatedAt
+	for _, otherCreatedAt := range manager.CreatedAt {
+		if !otherCreatedAt.Before(createdAt) {
+			createdAt = otherCreatedAt.Add(time.Minute)
+		}
+	}
+
+	configID := manager.NextConfigID
+	manager.NextConfigID++
+	manager.Comments[configID] = comment
+	manager.CreatedAt[configID] = createdAt
+	manager.Definitions[configID] = definition
+
+	return configID
+}
@@ -0,0 +1,6 @@
+/*
+Package testhelper holds code shared by the tests of the sz-sdk-go-core packages.
+
+It is imported only by _test.go files.
+*/
+package testhelper
@@ -0,0 +1,12 @@
+package testhelper
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	baseTen       = 10
+	percentile50  = 50
+	percentile99  = 99
+	percentile100 = 100
+)
@@ -0,0 +1,86 @@
+package testhelper
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing-garage/go-helpers/fileutil"
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewRepository function copies the template SQLite Senzing repository to a temporary directory
+and makes the template configuration, with the given data sources, its default configuration.
+
+Input
+  - test: The test. The repository is removed when it ends.
+  - dataSourceCodes: Data sources registered in the default configuration.
+
+Output
+  - The settings of the repository, for the Initialize() methods.
+*/
+func NewRepository(test *testing.T, dataSourceCodes ...string) string {
+	test.Helper()
+
+	ctx := test.Context()
+	testDirectoryPath := test.TempDir()
+
+	databaseTemplatePath, err := filepath.Abs(filepath.FromSlash("../testdata/sqlite/G2C.db"))
+	require.NoError(test, err)
+
+	_, _, err = fileutil.CopyFile(databaseTemplatePath, testDirectoryPath, true)
+	require.NoError(test, err)
+
+	result, err := settings.BuildSimpleSettingsUsingMap(map[string]string{
+		"databaseURL": "sqlite3://na:na@nowhere/" + filepath.Join(testDirectoryPath, "G2C.db"),
+	})
+	require.NoError(test, err)
+
+	szConfigManager := &szconfigmanager.Szconfigmanager{}
+	require.NoError(test, szConfigManager.Initialize(ctx, test.Name(), result, senzing.SzNoLogging))
+
+	defer func() { require.NoError(test, szConfigManager.Destroy(ctx)) }()
+
+	szConfig, err := szConfigManager.CreateConfigFromTemplate(ctx)
+	require.NoError(test, err)
+
+	for _, dataSourceCode := range dataSourceCodes {
+		_, err = szConfig.RegisterDataSource(ctx, dataSourceCode)
+		require.NoError(test, err)
+	}
+
+	configDefinition, err := szConfig.Export(ctx)
+	require.NoError(test, err)
+
+	_, err = szConfigManager.SetDefaultConfig(ctx, configDefinition, "Created by "+test.Name())
+	require.NoError(test, err)
+
+	return result
+}
+
+/*
+The NewSzConfigManager function returns an SzConfigManager on a repository made by [NewRepository].
+
+Input
+  - test: The test. The SzConfigManager is destroyed when it ends.
+  - dataSourceCodes: Data sources registered in the default configuration.
+*/
+func NewSzConfigManager(test *testing.T, dataSourceCodes ...string) *szconfigmanager.Szconfigmanager {
+	test.Helper()
+
+	repositorySettings := NewRepository(test, dataSourceCodes...)
+	result := &szconfigmanager.Szconfigmanager{}
+	require.NoError(test, result.Initialize(test.Context(), test.Name(), repositorySettings, senzing.SzNoLogging))
+
+	test.Cleanup(func() { _ = result.Destroy(context.Background()) })
+
+	return result
+}
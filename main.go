@@ -16,6 +16,7 @@ import (
 	"github.com/senzing-garage/go-helpers/wraperror"
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/sz-sdk-go-core/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go-core/szrecord"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 )
 
@@ -105,9 +106,22 @@ func demonstrateAddRecord(ctx context.Context, szEngine senzing.SzEngine) (strin
 	failOnError(5010, err)
 
 	recordID := randomNumber.String()
-	jsonData := `{"SOCIAL_HANDLE": "flavorh", "DATE_OF_BIRTH": "4/8/1983", "ADDR_STATE": "LA", "ADDR_POSTAL_CODE": "71232", "SSN_NUMBER": "053-39-3251", "ENTITY_TYPE": "TEST", "GENDER": "F", "srccode": "MDMPER", "CC_ACCOUNT_NUMBER": "5534202208773608", "RECORD_ID": "` +
-		recordID +
-		`", "DSRC_ACTION": "A", "ADDR_CITY": "Delhi", "DRIVERS_LICENSE_STATE": "DE", "PHONE_NUMBER": "225-671-0796", "NAME_LAST": "SEAMAN", "entityid": "284430058", "ADDR_LINE1": "772 Armstrong RD"}`
+	jsonData, err := szrecord.New(dataSourceCode, recordID).
+		AddName(szrecord.Name{Last: "SEAMAN"}).
+		AddAddress(szrecord.Address{Line1: "772 Armstrong RD", City: "Delhi", State: "LA", PostalCode: "71232"}).
+		AddPhone(szrecord.Phone{Number: "225-671-0796"}).
+		AddDateOfBirth("4/8/1983").
+		AddSSN("053-39-3251").
+		AddFeature(map[string]string{"GENDER": "F"}).
+		AddFeature(map[string]string{"CC_ACCOUNT_NUMBER": "5534202208773608"}).
+		AddFeature(map[string]string{"DRIVERS_LICENSE_STATE": "DE"}).
+		AddFeature(map[string]string{"SOCIAL_HANDLE": "flavorh"}).
+		SetPayload("ENTITY_TYPE", "TEST").
+		SetPayload("DSRC_ACTION", "A").
+		SetPayload("srccode", "MDMPER").
+		SetPayload("entityid", "284430058").
+		Build()
+	failOnError(5011, err)
 
 	// Using SzEngine: Add record and return "withInfo".
 
@@ -0,0 +1,22 @@
+/*
+Package sdkerror provides a structured error type for errors returned by the
+[szconfig], [szconfigmanager], [szdiagnostic], [szengine], and [szproduct] packages.
+
+Errors created from a Senzing exception can be inspected with [errors.As]
+to retrieve the component ID, SZSDK message ID, Senzing exception code, Senzing reason text,
+SDK method name and the (redacted) arguments of the failing call.
+The error text is unchanged, so the existing JSON rendering and [errors.Is]
+checks against [szerror] sentinel errors keep working.
+
+Well-known errors carry a remediation [Hint], retrievable with [HintFor].
+Applications may add their own hints with [RegisterExceptionCodeHint],
+[RegisterMessageIDHint] and [RegisterReasonHint].
+
+[szconfig]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfig
+[szconfigmanager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfigmanager
+[szdiagnostic]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szdiagnostic
+[szengine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szengine
+[szproduct]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szproduct
+[szerror]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+*/
+package sdkerror
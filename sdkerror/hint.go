@@ -0,0 +1,231 @@
+package sdkerror
+
+import (
+	"strings"
+	"sync"
+)
+
+/*
+Type Hint struct describes how to remediate a well-known Senzing error.
+*/
+type Hint struct {
+	Text             string // Human-readable remediation advice.
+	DocumentationURL string // Link to the documentation describing the error.
+}
+
+type hintRegistry struct {
+	byExceptionCode map[int]Hint
+	byMessageID     map[string]Hint
+	byReason        map[string]Hint
+	mutex           sync.RWMutex
+}
+
+var registry = newHintRegistry()
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The HintFor function returns the remediation hint attached to an error.
+
+Input
+  - err: An error returned by an Sz* object.
+
+Output
+  - The hint, if the error is a well-known Senzing error.
+  - true if a hint was found.
+*/
+func HintFor(err error) (Hint, bool) {
+	sdkError, ok := From(err)
+	if !ok || sdkError.Hint == nil {
+		return Hint{}, false
+	}
+
+	return *sdkError.Hint, true
+}
+
+/*
+The LookupHint function finds the remediation hint for a Senzing error.
+
+Message ID hints take precedence over reason text hints, which take precedence over exception code hints.
+When several reason fragments match, the longest fragment wins.
+
+Input
+  - exceptionCode: The Senzing exception code. Example: 33.
+  - messageID: The SZSDK message identifier. Example: "SZSDK60044001".
+  - reason: The text returned by Senzing's getLastException.
+
+Output
+  - The hint, if one is registered.
+  - true if a hint was found.
+*/
+func LookupHint(exceptionCode int, messageID string, reason string) (Hint, bool) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	if hint, ok := registry.byMessageID[messageID]; ok {
+		return hint, true
+	}
+
+	var (
+		found           bool
+		longestFragment string
+	)
+
+	for fragment := range registry.byReason {
+		if strings.Contains(reason, fragment) && len(fragment) > len(longestFragment) {
+			found = true
+			longestFragment = fragment
+		}
+	}
+
+	if found {
+		return registry.byReason[longestFragment], true
+	}
+
+	hint, ok := registry.byExceptionCode[exceptionCode]
+
+	return hint, ok
+}
+
+/*
+The RegisterExceptionCodeHint function adds or replaces the hint for a Senzing exception code.
+
+Input
+  - exceptionCode: The Senzing exception code. Example: 33.
+  - hint: The remediation hint.
+*/
+func RegisterExceptionCodeHint(exceptionCode int, hint Hint) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.byExceptionCode[exceptionCode] = hint
+}
+
+/*
+The RegisterMessageIDHint function adds or replaces the hint for an SZSDK message identifier.
+
+Input
+  - messageID: The SZSDK message identifier. Example: "SZSDK60044001".
+  - hint: The remediation hint.
+*/
+func RegisterMessageIDHint(messageID string, hint Hint) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.byMessageID[messageID] = hint
+}
+
+/*
+The RegisterReasonHint function adds or replaces the hint for errors whose reason text contains a fragment.
+
+Input
+  - fragment: Text to look for in the reason. Example: "SSL is not enabled on the server".
+  - hint: The remediation hint.
+*/
+func RegisterReasonHint(fragment string, hint Hint) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.byReason[fragment] = hint
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func documentationURL(anchor string) string {
+	return ErrorsDocumentationURL + "#" + anchor
+}
+
+func newHintRegistry() *hintRegistry {
+	return &hintRegistry{
+		byExceptionCode: knownExceptionCodeHints(),
+		byMessageID:     knownMessageIDHints(),
+		byReason:        knownReasonHints(),
+	}
+}
+
+func knownExceptionCodeHints() map[int]Hint {
+	return map[int]Hint{
+		7: {
+			Text:             "An empty JSON document was sent. Verify the record definition or attributes.",
+			DocumentationURL: documentationURL("bad-input"),
+		},
+		33: {
+			Text:             "The record is not in the repository. Verify the data source code and record ID.",
+			DocumentationURL: documentationURL("not-found"),
+		},
+		37: {
+			Text:             "The entity is not in the repository. Entity IDs change as records resolve.",
+			DocumentationURL: documentationURL("not-found"),
+		},
+		48: {
+			Text:             "The Senzing object is not initialized. Call Initialize() before other methods.",
+			DocumentationURL: documentationURL("not-initialized"),
+		},
+		50: {
+			Text:             "The Senzing object is not initialized. Call Initialize() before other methods.",
+			DocumentationURL: documentationURL("not-initialized"),
+		},
+		999: {
+			Text:             "The Senzing license has expired. Install a new license.",
+			DocumentationURL: documentationURL("license"),
+		},
+		1006: {
+			Text:             "The database could not be reached. Verify the database URL in the settings. The call may be retried.",
+			DocumentationURL: documentationURL("database-connection"),
+		},
+		1007: {
+			Text:             "The database connection was lost. The call may be retried.",
+			DocumentationURL: documentationURL("database-connection"),
+		},
+		1019: {
+			Text:             "The Senzing schema was not found. Create the Senzing tables in the database.",
+			DocumentationURL: documentationURL("database-schema"),
+		},
+		2207: {
+			Text:             "The data source is not registered. Register it with Szconfig.RegisterDataSource().",
+			DocumentationURL: documentationURL("unknown-data-source"),
+		},
+		3121: {
+			Text:             "The JSON document could not be parsed. Verify the JSON syntax.",
+			DocumentationURL: documentationURL("bad-input"),
+		},
+		7220: {
+			Text:             "No configuration is registered. Use Szconfigmanager.SetDefaultConfig() to register one.",
+			DocumentationURL: documentationURL("configuration"),
+		},
+		7221: {
+			Text:             "The configuration ID is not registered. Use Szconfigmanager.GetConfigRegistry() to list IDs.",
+			DocumentationURL: documentationURL("configuration"),
+		},
+		7245: {
+			Text:             "The default configuration changed concurrently. Get the new default configuration ID and retry.",
+			DocumentationURL: documentationURL("configuration"),
+		},
+	}
+}
+
+func knownMessageIDHints() map[string]Hint {
+	return map[string]Hint{
+		"SZSDK60044003": {
+			Text:             "The export handle is not open. Use a handle returned by an Export*EntityReport() method.",
+			DocumentationURL: documentationURL("export-handle"),
+		},
+		"SZSDK60044009": {
+			Text:             "The export handle is not open. Use a handle returned by an Export*EntityReport() method.",
+			DocumentationURL: documentationURL("export-handle"),
+		},
+	}
+}
+
+func knownReasonHints() map[string]Hint {
+	return map[string]Hint{
+		"SSL is not enabled on the server": {
+			Text:             "The database URL needs the sslmode parameter. Example: ?sslmode=disable",
+			DocumentationURL: documentationURL("postgresql"),
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package sdkerror_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-core/sdkerror"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkerror_HintFor(test *testing.T) {
+	err := wraperror.Errorf((&testClient{}).PublicMethod(), "outer")
+	hint, isOK := sdkerror.HintFor(err)
+	require.True(test, isOK)
+	assert.NotEmpty(test, hint.Text)
+	assert.Equal(test, sdkerror.ErrorsDocumentationURL+"#not-found", hint.DocumentationURL)
+}
+
+func TestSdkerror_HintFor_notSdkError(test *testing.T) {
+	_, isOK := sdkerror.HintFor(errors.New("plain")) //nolint
+	assert.False(test, isOK)
+}
+
+func TestSdkerror_HintFor_unknownCode(test *testing.T) {
+	err := sdkerror.New(6004, 4001, 12345, "12345E|Unknown", nil, szerror.New(12345, "{}"))
+	assert.Nil(test, err.Hint)
+
+	_, isOK := sdkerror.HintFor(err)
+	assert.False(test, isOK)
+}
+
+func TestSdkerror_LookupHint_reason(test *testing.T) {
+	reason := "1006E|Database Connection Failure 'pq: SSL is not enabled on the server'"
+	hint, isOK := sdkerror.LookupHint(1006, "SZSDK60044001", reason)
+	require.True(test, isOK)
+	assert.Contains(test, hint.Text, "sslmode")
+	assert.Equal(test, sdkerror.ErrorsDocumentationURL+"#postgresql", hint.DocumentationURL)
+}
+
+func TestSdkerror_LookupHint_messageID(test *testing.T) {
+	hint, isOK := sdkerror.LookupHint(0, "SZSDK60044009", "")
+	require.True(test, isOK)
+	assert.Contains(test, hint.DocumentationURL, "#export-handle")
+}
+
+func TestSdkerror_RegisterHint(test *testing.T) {
+	const exceptionCode = 99999
+
+	_, isOK := sdkerror.LookupHint(exceptionCode, "", "")
+	assert.False(test, isOK)
+
+	sdkerror.RegisterExceptionCodeHint(exceptionCode, sdkerror.Hint{Text: "by code", DocumentationURL: ""})
+	hint, isOK := sdkerror.LookupHint(exceptionCode, "", "")
+	require.True(test, isOK)
+	assert.Equal(test, "by code", hint.Text)
+
+	sdkerror.RegisterReasonHint("custom reason", sdkerror.Hint{Text: "by reason", DocumentationURL: ""})
+	hint, _ = sdkerror.LookupHint(exceptionCode, "", "a custom reason text")
+	assert.Equal(test, "by reason", hint.Text)
+
+	sdkerror.RegisterMessageIDHint("SZSDK99999999", sdkerror.Hint{Text: "by message ID", DocumentationURL: ""})
+	hint, _ = sdkerror.LookupHint(exceptionCode, "SZSDK99999999", "a custom reason text")
+	assert.Equal(test, "by message ID", hint.Text)
+}
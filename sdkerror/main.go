@@ -0,0 +1,38 @@
+package sdkerror
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+ErrorsDocumentationURL is the document describing common errors. Hint documentation URLs are anchors within it.
+
+ExceptionCodeTemplate is a template for the error code returned by the Senzing C binary.
+
+MessageIDTemplate is a template for the "SZSDKcccceeee" message identifier
+where "cccc" is the component ID and "eeee" is the error identifier.
+
+RedactedValue replaces sensitive values in [Error.Arguments].
+*/
+const (
+	ErrorsDocumentationURL = "https://github.com/senzing-garage/sz-sdk-go-core/blob/main/docs/errors.md"
+	ExceptionCodeTemplate  = "SENZ%04d"
+	MessageIDTemplate      = "SZSDK%04d%04d"
+	RedactedValue          = "****"
+)
+
+const (
+	baseTen           = 10
+	bitSize64         = 64
+	maxArgumentLength = 256
+	maxStackDepth     = 64
+	truncationSuffix  = "..."
+)
+
+// sensitiveKeyFragments are case-insensitive fragments of JSON keys whose values are redacted.
+var sensitiveKeyFragments = []string{
+	"CONNECTION",
+	"PASSWORD",
+	"SECRET",
+	"TOKEN",
+}
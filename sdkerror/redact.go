@@ -0,0 +1,123 @@
+package sdkerror
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var urlCredentials = regexp.MustCompile(`([A-Za-z][A-Za-z0-9+.\-]*://[^:/@\s"]*:)[^@\s"]+@`)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The RedactArguments function returns string representations of call arguments
+that are safe to log.
+
+Only strings, numbers and booleans are kept.
+JSON values of sensitive keys (e.g. passwords and database connections) and passwords in URLs are
+replaced by [RedactedValue]. Long values are truncated.
+
+Input
+  - arguments: The arguments of a call.
+
+Output
+  - A redacted string for each kept argument.
+*/
+func RedactArguments(arguments ...interface{}) []string {
+	result := make([]string, 0, len(arguments))
+
+	for _, argument := range arguments {
+		formatted, ok := formatArgument(argument)
+		if ok {
+			result = append(result, RedactString(formatted))
+		}
+	}
+
+	return result
+}
+
+/*
+The RedactString function returns a copy of the string that is safe to log.
+
+Input
+  - value: A string which may be a JSON document or a URL.
+
+Output
+  - The redacted and truncated string.
+*/
+func RedactString(value string) string {
+	result := value
+
+	var document interface{}
+
+	if json.Valid([]byte(value)) && json.Unmarshal([]byte(value), &document) == nil {
+		if redacted, err := json.Marshal(redactJSON(document)); err == nil {
+			result = string(redacted)
+		}
+	} else {
+		result = redactURL(value)
+	}
+
+	return truncate(result)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func isSensitiveKey(key string) bool {
+	upperKey := strings.ToUpper(key)
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(upperKey, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func redactJSON(document interface{}) interface{} {
+	switch typedDocument := document.(type) {
+	case map[string]interface{}:
+		for key, value := range typedDocument {
+			if isSensitiveKey(key) {
+				typedDocument[key] = RedactedValue
+			} else {
+				typedDocument[key] = redactJSON(value)
+			}
+		}
+
+		return typedDocument
+	case []interface{}:
+		for index, value := range typedDocument {
+			typedDocument[index] = redactJSON(value)
+		}
+
+		return typedDocument
+	case string:
+		return redactURL(typedDocument)
+	default:
+		return document
+	}
+}
+
+func redactURL(value string) string {
+	return urlCredentials.ReplaceAllString(value, "${1}"+RedactedValue+"@")
+}
+
+func truncate(value string) string {
+	if len(value) <= maxArgumentLength {
+		return value
+	}
+
+	end := maxArgumentLength
+	for end > 0 && !utf8.RuneStart(value[end]) {
+		end--
+	}
+
+	return value[:end] + truncationSuffix
+}
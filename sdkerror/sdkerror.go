@@ -0,0 +1,217 @@
+package sdkerror
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+/*
+Type Error struct carries the details of a failed call into the Senzing C binary.
+
+Use [errors.As] to retrieve it from an error returned by an Sz* object.
+*/
+type Error struct {
+	Arguments     []string // Redacted string representation of the arguments of the failing call.
+	ComponentID   int      // Identifier of the package that created the error. Example: 6004 for szengine.
+	ExceptionCode int      // Senzing exception code. Example: 33 for "SENZ0033".
+	Hint          *Hint    // Remediation hint for well-known errors. nil if unknown.
+	Method        string   // SDK method that failed. Example: "Szengine.GetRecord".
+	MessageID     string   // SZSDK message identifier. Example: "SZSDK60044001".
+	Reason        string   // Text returned by Senzing's getLastException.
+	err           error
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The New function returns an *Error wrapping err.
+
+The method name is discovered from the call stack;
+it is the first exported method found above the caller.
+A closure counts as the method it was created in, so a call that an Sz* method hands to
+helper.Executor, which runs it on another goroutine, is still attributed to that Sz* method.
+A remediation hint is attached when the error is well-known. See [LookupHint].
+
+Input
+  - componentID: The 4-digit identifier of the component. Example: 6004.
+  - errorNumber: The 4-digit error identifier within the component. Example: 4001.
+  - exceptionCode: The code returned by Senzing's getLastExceptionCode.
+  - reason: The text returned by Senzing's getLastException.
+  - arguments: The arguments of the failing call. Values are redacted.
+  - err: The error to wrap. Its text becomes the text of the returned error.
+
+Output
+  - An *Error. Use [errors.As] to retrieve it after further wrapping.
+*/
+func New(
+	componentID int,
+	errorNumber int,
+	exceptionCode int,
+	reason string,
+	arguments []interface{},
+	err error,
+) *Error {
+	result := &Error{
+		Arguments:     RedactArguments(arguments...),
+		ComponentID:   componentID,
+		ExceptionCode: exceptionCode,
+		Hint:          nil,
+		Method:        callerMethod(),
+		MessageID:     fmt.Sprintf(MessageIDTemplate, componentID, errorNumber),
+		Reason:        reason,
+		err:           err,
+	}
+
+	if hint, ok := LookupHint(exceptionCode, result.MessageID, reason); ok {
+		result.Hint = &hint
+	}
+
+	return result
+}
+
+/*
+The From function extracts an *Error from an error chain.
+
+Input
+  - err: An error returned by an Sz* object.
+
+Output
+  - The *Error, if found.
+  - true if an *Error was found.
+*/
+func From(err error) (*Error, bool) {
+	var result *Error
+
+	ok := errors.As(err, &result)
+
+	return result, ok
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Error returns the text of the wrapped error.
+*/
+func (sdkError *Error) Error() string {
+	if sdkError.err == nil {
+		return sdkError.MessageID
+	}
+
+	return sdkError.err.Error()
+}
+
+/*
+Method ExceptionCodeText returns the Senzing exception code in "SENZnnnn" format.
+*/
+func (sdkError *Error) ExceptionCodeText() string {
+	return fmt.Sprintf(ExceptionCodeTemplate, sdkError.ExceptionCode)
+}
+
+/*
+Method Unwrap returns the wrapped error so that [errors.Is] works with [szerror] sentinel errors.
+
+[szerror]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+*/
+func (sdkError *Error) Unwrap() error {
+	return sdkError.err
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+Function callerMethod walks the call stack and returns "Type.Method" of the first exported method
+above the caller.  If no exported method is found, the first method found is returned.
+Closures are reported as the method they were created in.
+*/
+func callerMethod() string {
+	var (
+		firstFound      string
+		programCounters [maxStackDepth]uintptr
+	)
+
+	count := runtime.Callers(1, programCounters[:])
+	frames := runtime.CallersFrames(programCounters[:count])
+
+	for {
+		frame, more := frames.Next()
+		receiver, method, ok := splitMethod(frame.Function)
+
+		if ok {
+			if firstFound == "" {
+				firstFound = receiver + "." + method
+			}
+
+			if isExported(method) {
+				return receiver + "." + method
+			}
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return firstFound
+}
+
+func isExported(name string) bool {
+	for _, character := range name {
+		return unicode.IsUpper(character)
+	}
+
+	return false
+}
+
+/*
+Function splitMethod splits "path/pkg.(*Type).Method" into "Type" and "Method".
+Closures, such as "path/pkg.(*Type).Method.func1", are split into "Type" and the enclosing "Method".
+*/
+func splitMethod(function string) (string, string, bool) {
+	const receiverStart = ".(*"
+
+	index := strings.Index(function, receiverStart)
+	if index < 0 {
+		return "", "", false
+	}
+
+	remainder := function[index+len(receiverStart):]
+
+	receiver, method, found := strings.Cut(remainder, ").")
+	if !found {
+		return "", "", false
+	}
+
+	method, _, _ = strings.Cut(method, ".")
+
+	return receiver, method, true
+}
+
+func formatArgument(argument interface{}) (string, bool) {
+	value := reflect.ValueOf(argument)
+
+	switch value.Kind() { //nolint:exhaustive
+	case reflect.String:
+		return value.String(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), baseTen), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), baseTen), true
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'g', -1, bitSize64), true
+	default:
+		return "", false
+	}
+}
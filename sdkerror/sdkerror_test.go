@@ -0,0 +1,141 @@
+package sdkerror_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/sdkerror"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testComponentID   = 6004
+	testErrorNumber   = 4001
+	testExceptionCode = 33
+	testMessage       = `{"id":"SZSDK60044001","reason":"SENZ0033|Unknown record: dsrc[TEST], record[1]"}`
+	testReason        = "SENZ0033|Unknown record: dsrc[TEST], record[1]"
+)
+
+type testClient struct{}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSdkerror_New(test *testing.T) {
+	err := (&testClient{}).PublicMethod()
+	sdkError, isOK := sdkerror.From(err)
+	require.True(test, isOK)
+	assert.Equal(test, testComponentID, sdkError.ComponentID)
+	assert.Equal(test, "SZSDK60044001", sdkError.MessageID)
+	assert.Equal(test, testExceptionCode, sdkError.ExceptionCode)
+	assert.Equal(test, "SENZ0033", sdkError.ExceptionCodeText())
+	assert.Equal(test, testReason, sdkError.Reason)
+	assert.Equal(test, "testClient.PublicMethod", sdkError.Method)
+	assert.Equal(test, []string{"TEST", "1", "0"}, sdkError.Arguments)
+}
+
+func TestSdkerror_New_executor(test *testing.T) {
+	executor := helper.NewExecutor(1)
+	defer executor.Close()
+
+	err := (&testClient{}).PublicMethodWithExecutor(executor)
+	sdkError, isOK := sdkerror.From(err)
+	require.True(test, isOK)
+	assert.Equal(test, "testClient.PublicMethodWithExecutor", sdkError.Method)
+}
+
+func TestSdkerror_New_errorText(test *testing.T) {
+	err := (&testClient{}).PublicMethod()
+	wrapped := szerror.New(testExceptionCode, testMessage)
+	assert.Equal(test, wrapped.Error(), err.Error())
+}
+
+func TestSdkerror_New_errorsIs(test *testing.T) {
+	err := (&testClient{}).PublicMethod()
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+}
+
+func TestSdkerror_New_wrapped(test *testing.T) {
+	err := wraperror.Errorf((&testClient{}).PublicMethod(), "outer")
+
+	var sdkError *sdkerror.Error
+	require.ErrorAs(test, err, &sdkError)
+	assert.Equal(test, testExceptionCode, sdkError.ExceptionCode)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+}
+
+func TestSdkerror_From_notFound(test *testing.T) {
+	_, isOK := sdkerror.From(errors.New("plain")) //nolint
+	assert.False(test, isOK)
+}
+
+func TestSdkerror_RedactArguments(test *testing.T) {
+	type notBasic struct{ value int }
+
+	actual := sdkerror.RedactArguments("a", int64(1), uintptr(2), true, notBasic{value: 3}, errors.New("x")) //nolint
+	assert.Equal(test, []string{"a", "1", "2", "true"}, actual)
+}
+
+func TestSdkerror_RedactString_settings(test *testing.T) {
+	settings := `{"PIPELINE":{"CONFIGPATH":"/etc/opt/senzing"},"SQL":{"CONNECTION":"postgresql://user:secret@db:5432/G2"}}`
+	actual := sdkerror.RedactString(settings)
+	assert.NotContains(test, actual, "secret")
+	assert.Contains(test, actual, `"CONNECTION":"****"`)
+	assert.Contains(test, actual, "/etc/opt/senzing")
+}
+
+func TestSdkerror_RedactString_url(test *testing.T) {
+	actual := sdkerror.RedactString(`{"DATABASE_URL":"postgresql://user:secret@db:5432/G2"}`)
+	assert.JSONEq(test, `{"DATABASE_URL":"postgresql://user:****@db:5432/G2"}`, actual)
+
+	actual = sdkerror.RedactString("postgresql://user:secret@db:5432/G2/?sslmode=disable")
+	assert.Equal(test, "postgresql://user:****@db:5432/G2/?sslmode=disable", actual)
+}
+
+func TestSdkerror_RedactString_truncate(test *testing.T) {
+	long := make([]byte, 1000)
+	for index := range long {
+		long[index] = 'x'
+	}
+
+	actual := sdkerror.RedactString(string(long))
+	assert.Less(test, len(actual), len(long))
+	assert.True(test, len(actual) > 0)
+}
+
+// ----------------------------------------------------------------------------
+// Internal methods
+// ----------------------------------------------------------------------------
+
+func (client *testClient) PublicMethod() error {
+	return client.privateMethod("TEST", "1")
+}
+
+func (client *testClient) PublicMethodWithExecutor(executor *helper.Executor) error {
+	var err error
+
+	executor.Call(func() { err = client.privateMethod("TEST", "1") })
+
+	return err
+}
+
+func (client *testClient) privateMethod(dataSourceCode string, recordID string) error {
+	return client.newError(testErrorNumber, dataSourceCode, recordID, 0)
+}
+
+func (client *testClient) newError(errorNumber int, details ...interface{}) error {
+	return sdkerror.New(
+		testComponentID,
+		errorNumber,
+		testExceptionCode,
+		testReason,
+		details,
+		szerror.New(testExceptionCode, testMessage),
+	)
+}
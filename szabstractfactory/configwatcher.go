@@ -0,0 +1,281 @@
+package szabstractfactory
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-observing/notifier"
+	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/go-observing/subject"
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-go-core/szengine"
+)
+
+/*
+ConfigWatcherOptions tells a [ConfigWatcher] when to compare configuration identifiers.
+*/
+type ConfigWatcherOptions struct {
+	DrainTimeout time.Duration // Longest wait for calls in flight before a swap. Zero means DefaultDrainTimeout.
+	Interval     time.Duration // Poll every Interval. Zero disables polling.
+	Signals      []os.Signal   // Also check when one of these signals is received (e.g. syscall.SIGHUP).
+}
+
+/*
+ConfigWatcher reinitializes the objects created by an [Szabstractfactory]
+when the default Senzing configuration no longer matches the active one.
+
+Create with [Szabstractfactory.NewConfigWatcher].
+*/
+type ConfigWatcher struct {
+	factory        *Szabstractfactory
+	mutex          sync.Mutex
+	observerOrigin string
+	observers      subject.Subject
+	options        ConfigWatcherOptions
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method NewConfigWatcher returns a ConfigWatcher for the objects created by the AbstractFactory.
+Nothing is watched until [ConfigWatcher.Run] or [ConfigWatcher.Check] is called.
+
+Input
+  - options: When to compare the active and default configuration identifiers.
+
+Output
+  - A ConfigWatcher.
+*/
+func (factory *Szabstractfactory) NewConfigWatcher(options ConfigWatcherOptions) *ConfigWatcher {
+	return &ConfigWatcher{ //exhaustruct:ignore
+		factory: factory,
+		options: options,
+	}
+}
+
+/*
+Method Check compares the configuration identifier used by the Senzing engine with the
+default configuration identifier in the Senzing repository.
+If they differ, calls to the objects created by the AbstractFactory are held,
+[Szabstractfactory.Reinitialize] is called with the default configuration identifier,
+and the held calls are released.
+Observers are notified of every reinitialization attempt.
+
+Calls in flight, including open export iterators, are waited for at most DrainTimeout.
+A caller that makes further calls before finishing such a call, e.g. while reading an export iterator,
+would otherwise wait for the swap forever.
+When DrainTimeout expires, the held calls are released, nothing is reinitialized,
+and an error is returned; the next Check tries again.
+
+Nothing is done when no SzEngine has been created.
+
+Input
+  - ctx: A context to control lifecycle.
+    It also bounds the wait for calls in flight to finish.
+
+Output
+  - true if the objects were reinitialized.
+*/
+func (watcher *ConfigWatcher) Check(ctx context.Context) (bool, error) {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	if !watcher.factory.szEngineExists(ctx) {
+		return false, nil
+	}
+
+	szEngine := &szengine.Szengine{}
+
+	activeConfigID, err := szEngine.GetActiveConfigID(ctx)
+	if err != nil {
+		return false, wraperror.Errorf(err, "szEngine.GetActiveConfigID")
+	}
+
+	szConfigManager := &szconfigmanager.Szconfigmanager{}
+
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return false, wraperror.Errorf(err, "szConfigManager.GetDefaultConfigID")
+	}
+
+	if activeConfigID == defaultConfigID {
+		return false, nil
+	}
+
+	err = watcher.swap(ctx, defaultConfigID)
+
+	if watcher.observers != nil {
+		observers, origin := watcher.observers, watcher.observerOrigin
+
+		go func() {
+			details := map[string]string{
+				"activeConfigID":  strconv.FormatInt(activeConfigID, 10),
+				"defaultConfigID": strconv.FormatInt(defaultConfigID, 10),
+			}
+			notifier.Notify(ctx, observers, origin, ComponentID, 8001, err, details)
+		}()
+	}
+
+	return err == nil, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method RegisterObserver adds the observer to the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be added.
+*/
+func (watcher *ConfigWatcher) RegisterObserver(ctx context.Context, observer observer.Observer) error {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	if watcher.observers == nil {
+		watcher.observers = &subject.SimpleSubject{}
+	}
+
+	err := watcher.observers.RegisterObserver(ctx, observer)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method Run calls [ConfigWatcher.Check] every Interval and whenever one of the Signals is received,
+until ctx is done.
+Errors from Check are reported to observers and do not stop Run.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (watcher *ConfigWatcher) Run(ctx context.Context) error {
+	var (
+		signals chan os.Signal
+		ticks   <-chan time.Time
+	)
+
+	if watcher.options.Interval > 0 {
+		ticker := time.NewTicker(watcher.options.Interval)
+		defer ticker.Stop()
+
+		ticks = ticker.C
+	}
+
+	if len(watcher.options.Signals) > 0 {
+		signals = make(chan os.Signal, 1)
+		signal.Notify(signals, watcher.options.Signals...)
+
+		defer signal.Stop(signals)
+	}
+
+	if ticks == nil && signals == nil {
+		return wraperror.Errorf(errForPackage, "ConfigWatcher has neither an Interval nor Signals")
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticks:
+		case <-signals:
+		}
+
+		_, _ = watcher.Check(ctx)
+	}
+}
+
+/*
+Method SetObserverOrigin sets the "origin" value in future Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+  - origin: The value sent in the Observer's "origin" key/value pair.
+*/
+func (watcher *ConfigWatcher) SetObserverOrigin(ctx context.Context, origin string) {
+	_ = ctx
+
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	watcher.observerOrigin = origin
+}
+
+/*
+Method UnregisterObserver removes the observer from the list of observers notified.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to be removed.
+*/
+func (watcher *ConfigWatcher) UnregisterObserver(ctx context.Context, observer observer.Observer) error {
+	var err error
+
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	if watcher.observers != nil {
+		err = watcher.observers.UnregisterObserver(ctx, observer)
+
+		if !watcher.observers.HasObservers(ctx) {
+			watcher.observers = nil
+		}
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+/*
+Method swap holds calls to every live object created by the AbstractFactory,
+reinitializes with configID, then releases the calls.
+Holding gives up, releasing every object, after the DrainTimeout.
+*/
+func (watcher *ConfigWatcher) swap(ctx context.Context, configID int64) error {
+	factory := watcher.factory
+
+	drainTimeout := watcher.options.DrainTimeout
+	if drainTimeout <= 0 {
+		drainTimeout = DefaultDrainTimeout
+	}
+
+	holdCtx, cancel := context.WithTimeout(ctx, drainTimeout)
+	defer cancel()
+
+	factory.mutex.Lock()
+	liveObjects := make([]createdObject, 0, len(factory.createdObjects))
+
+	for _, createdObject := range factory.createdObjects {
+		if !createdObject.IsDestroyed(ctx) {
+			liveObjects = append(liveObjects, createdObject)
+		}
+	}
+	factory.mutex.Unlock()
+
+	for index, createdObject := range liveObjects {
+		err := createdObject.Hold(holdCtx)
+		if err != nil {
+			for _, heldObject := range liveObjects[:index] {
+				heldObject.Release(ctx)
+			}
+
+			return wraperror.Errorf(err, "cannot hold calls during reinitialization")
+		}
+	}
+
+	defer func() {
+		for _, heldObject := range liveObjects {
+			heldObject.Release(ctx)
+		}
+	}()
+
+	return factory.Reinitialize(ctx, configID)
+}
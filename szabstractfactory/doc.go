@@ -2,6 +2,17 @@
 Package szabstractfactory implements an Abstract Factory Pattern for Sz object creation.
 The [Abstract Factory Pattern] ensures common settings across all created objects.
 
+A [ConfigWatcher] keeps the objects created by an [Szabstractfactory] on the default Senzing configuration.
+It polls, or checks when signaled (e.g. syscall.SIGHUP), and calls [Szabstractfactory.Reinitialize]
+when the default configuration identifier differs from the active one.
+Calls to the created objects are held during the swap.
+
+	configWatcher := szAbstractFactory.NewConfigWatcher(szabstractfactory.ConfigWatcherOptions{
+		Interval: time.Minute,
+		Signals:  []os.Signal{syscall.SIGHUP},
+	})
+	go func() { _ = configWatcher.Run(ctx) }()
+
 [Abstract Factory Pattern]: https://en.wikipedia.org/wiki/Abstract_factory_pattern
 */
 package szabstractfactory
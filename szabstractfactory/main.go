@@ -1,6 +1,9 @@
 package szabstractfactory
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // ----------------------------------------------------------------------------
 // Constants
@@ -12,4 +15,7 @@ Package abstractfactory messages will have the format "SZSDK6000eeee" where "eee
 */
 const ComponentID = 6000
 
+// DefaultDrainTimeout is the wait for calls in flight when ConfigWatcherOptions.DrainTimeout is 0.
+const DefaultDrainTimeout = 30 * time.Second
+
 var errForPackage = errors.New("szabstractfactory")
@@ -2,9 +2,11 @@ package szabstractfactory
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go-core/helper"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
 	"github.com/senzing-garage/sz-sdk-go-core/szdiagnostic"
 	"github.com/senzing-garage/sz-sdk-go-core/szengine"
@@ -19,6 +21,8 @@ Szabstractfactory is an implementation of the [senzing.SzAbstractFactory] interf
 */
 type Szabstractfactory struct {
 	ConfigID       int64
+	createdObjects []createdObject
+	executor       *helper.Executor
 	InstanceName   string
 	isClosed       bool
 	mutex          sync.Mutex
@@ -28,6 +32,14 @@ type Szabstractfactory struct {
 	VerboseLogging int64
 }
 
+// Objects created by the AbstractFactory that are drained by Close() and held by a ConfigWatcher.
+type createdObject interface {
+	Drain(ctx context.Context) error
+	Hold(ctx context.Context) error
+	IsDestroyed(ctx context.Context) bool
+	Release(ctx context.Context)
+}
+
 // ----------------------------------------------------------------------------
 // senzing.SzAbstractFactory interface methods
 // ----------------------------------------------------------------------------
@@ -35,6 +47,12 @@ type Szabstractfactory struct {
 /*
 Method Close prevents the AbstractFactory from creating any more object.
 
+Objects created by the AbstractFactory that have not been destroyed stop accepting new calls.
+Close waits, bounded by ctx, for the calls in flight to all of them to finish before closing any of them
+and destroying its own native objects.
+If ctx is done first, nothing is closed or destroyed and an error is returned; Close may be called again.
+The objects themselves must still be destroyed with Destroy(). See [szengine.Szengine.Drain].
+
 Input
   - ctx: A context to control lifecycle.
 */
@@ -48,7 +66,42 @@ func (factory *Szabstractfactory) Close(ctx context.Context) error {
 		return wraperror.Errorf(errForPackage, "SzAbstractFactory is closed")
 	}
 
+	// Hold every object first so that a timeout leaves all of them open.
+
+	heldObjects := make([]createdObject, 0, len(factory.createdObjects))
+
+	for _, createdObject := range factory.createdObjects {
+		if createdObject.IsDestroyed(ctx) {
+			continue
+		}
+
+		err = createdObject.Hold(ctx)
+		if err != nil && createdObject.IsDestroyed(ctx) {
+			err = nil
+
+			continue
+		}
+
+		if err != nil {
+			for _, heldObject := range heldObjects {
+				heldObject.Release(ctx)
+			}
+
+			return wraperror.Errorf(err, wraperror.NoMessage)
+		}
+
+		heldObjects = append(heldObjects, createdObject)
+	}
+
+	// No calls are in flight, so draining does not wait. Released calls are then rejected.
+
+	for _, heldObject := range heldObjects {
+		err = errors.Join(err, heldObject.Drain(ctx))
+		heldObject.Release(ctx)
+	}
+
 	factory.isClosed = true
+	factory.createdObjects = nil
 
 	for _, semaphore := range factory.semaphores {
 		_ = semaphore.Destroy(ctx)
@@ -96,7 +149,11 @@ func (factory *Szabstractfactory) CreateConfigManager(ctx context.Context) (senz
 	}
 
 	result = &szconfigmanager.Szconfigmanager{}
+	result.SetExecutor(ctx, factory.executor)
 	err = result.Initialize(ctx, factory.InstanceName, factory.Settings, factory.VerboseLogging)
+	if err == nil {
+		factory.trackCreatedObject(ctx, result)
+	}
 
 	return result, wraperror.Errorf(err, wraperror.NoMessage)
 }
@@ -138,7 +195,11 @@ func (factory *Szabstractfactory) CreateDiagnostic(ctx context.Context) (senzing
 	}
 
 	result = &szdiagnostic.Szdiagnostic{}
+	result.SetExecutor(ctx, factory.executor)
 	err = result.Initialize(ctx, factory.InstanceName, factory.Settings, factory.ConfigID, factory.VerboseLogging)
+	if err == nil {
+		factory.trackCreatedObject(ctx, result)
+	}
 
 	return result, wraperror.Errorf(err, wraperror.NoMessage)
 }
@@ -180,7 +241,11 @@ func (factory *Szabstractfactory) CreateEngine(ctx context.Context) (senzing.SzE
 	}
 
 	result = &szengine.Szengine{}
+	result.SetExecutor(ctx, factory.executor)
 	err = result.Initialize(ctx, factory.InstanceName, factory.Settings, factory.ConfigID, factory.VerboseLogging)
+	if err == nil {
+		factory.trackCreatedObject(ctx, result)
+	}
 
 	return result, wraperror.Errorf(err, wraperror.NoMessage)
 }
@@ -222,7 +287,11 @@ func (factory *Szabstractfactory) CreateProduct(ctx context.Context) (senzing.Sz
 	}
 
 	result = &szproduct.Szproduct{}
+	result.SetExecutor(ctx, factory.executor)
 	err = result.Initialize(ctx, factory.InstanceName, factory.Settings, factory.VerboseLogging)
+	if err == nil {
+		factory.trackCreatedObject(ctx, result)
+	}
 
 	return result, wraperror.Errorf(err, wraperror.NoMessage)
 }
@@ -268,6 +337,29 @@ func (factory *Szabstractfactory) Reinitialize(ctx context.Context, configID int
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+// ----------------------------------------------------------------------------
+// Public non-interface methods
+// ----------------------------------------------------------------------------
+
+/*
+Method SetExecutor sets the [helper.Executor] given to objects created afterwards.
+See [szengine.Szengine.SetExecutor].
+
+The AbstractFactory does not close the executor.
+
+Input
+  - ctx: A context to control lifecycle.
+  - executor: The executor to use. nil restores the default.
+*/
+func (factory *Szabstractfactory) SetExecutor(ctx context.Context, executor *helper.Executor) {
+	_ = ctx
+
+	factory.mutex.Lock()
+	defer factory.mutex.Unlock()
+
+	factory.executor = executor
+}
+
 // ----------------------------------------------------------------------------
 // Private methods
 // ----------------------------------------------------------------------------
@@ -329,6 +421,22 @@ func (factory *Szabstractfactory) szProductExists(ctx context.Context) bool {
 	return szProduct.IsInitialized(ctx)
 }
 
+/*
+Method trackCreatedObject remembers an object so that Close() can destroy it.
+Objects that have already been destroyed are forgotten.
+*/
+func (factory *Szabstractfactory) trackCreatedObject(ctx context.Context, object createdObject) {
+	liveObjects := factory.createdObjects[:0]
+
+	for _, createdObject := range factory.createdObjects {
+		if !createdObject.IsDestroyed(ctx) {
+			liveObjects = append(liveObjects, createdObject)
+		}
+	}
+
+	factory.createdObjects = append(liveObjects, object)
+}
+
 /*
 Method verifyNoSenzingObjects determines if any Senzing objects are registered with the
 underlying C binaries.
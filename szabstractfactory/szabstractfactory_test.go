@@ -16,6 +16,7 @@ import (
 	"github.com/senzing-garage/sz-sdk-go-core/szabstractfactory"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfig"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-go-core/szengine"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-go/szerror"
 	"github.com/stretchr/testify/require"
@@ -56,6 +57,67 @@ const (
 // Interface methods - test
 // ----------------------------------------------------------------------------
 
+func TestSzAbstractFactory_Close_drainsCreatedObjects(test *testing.T) {
+	ctx := test.Context()
+	szAbstractFactory := getTestObject(test)
+
+	szEngine, err := szAbstractFactory.CreateEngine(ctx)
+	require.NoError(test, err)
+	require.NoError(test, szAbstractFactory.Close(ctx))
+
+	// Calls made after Close() are rejected, but the SzEngine is not destroyed.
+
+	_, err = szEngine.GetStats(ctx)
+	require.Error(test, err)
+
+	engine, isEngine := szEngine.(*szengine.Szengine)
+	require.True(test, isEngine)
+	require.False(test, engine.IsDestroyed(ctx))
+	require.NoError(test, szEngine.Destroy(ctx))
+	require.True(test, engine.IsDestroyed(ctx))
+}
+
+func TestSzAbstractFactory_Close_timeout(test *testing.T) {
+	ctx := test.Context()
+	szAbstractFactory := getTestObject(test)
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	require.NoError(test, err)
+
+	defer func() { require.NoError(test, szConfigManager.Destroy(ctx)) }()
+
+	szDiagnostic, err := szAbstractFactory.CreateDiagnostic(ctx)
+	require.NoError(test, err)
+
+	defer func() { require.NoError(test, szDiagnostic.Destroy(ctx)) }()
+
+	// Keep a call in flight to the SzDiagnostic, created after the SzConfigManager.
+
+	finished := make(chan error)
+
+	go func() {
+		_, err := szDiagnostic.CheckRepositoryPerformance(ctx, 2)
+		finished <- err
+	}()
+
+	time.Sleep(500 * time.Millisecond)
+
+	closeCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	require.Error(test, szAbstractFactory.Close(closeCtx))
+
+	// Nothing was closed.
+
+	_, err = szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	require.NoError(test, <-finished)
+	require.NoError(test, szAbstractFactory.Close(ctx))
+
+	_, err = szConfigManager.GetDefaultConfigID(ctx)
+	require.Error(test, err)
+}
+
 func TestSzAbstractFactory_CreateConfigManager(test *testing.T) {
 	ctx := test.Context()
 	szAbstractFactory := getTestObject(test)
@@ -268,6 +330,148 @@ func TestSzAbstractFactory_Reinitialize(test *testing.T) {
 	require.NoError(test, err)
 }
 
+func TestSzAbstractFactory_ConfigWatcher_Check(test *testing.T) {
+	ctx := test.Context()
+	dataSourceCode := "GO_TEST_WATCHER_" + strconv.FormatInt(time.Now().Unix(), baseTen)
+	szAbstractFactory := &szabstractfactory.Szabstractfactory{
+		ConfigID:       senzing.SzInitializeWithDefaultConfiguration,
+		InstanceName:   instanceName,
+		Settings:       getSettings(location1),
+		VerboseLogging: verboseLogging,
+	}
+
+	defer func() { require.NoError(test, szAbstractFactory.Close(ctx)) }()
+
+	szEngine, err := szAbstractFactory.CreateEngine(ctx)
+	require.NoError(test, err)
+
+	defer func() { panicOnError(szEngine.Destroy(ctx)) }()
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	require.NoError(test, err)
+
+	defer func() { panicOnError(szConfigManager.Destroy(ctx)) }()
+
+	configWatcher := szAbstractFactory.NewConfigWatcher(szabstractfactory.ConfigWatcherOptions{})
+
+	reinitialized, err := configWatcher.Check(ctx)
+	require.NoError(test, err)
+	require.False(test, reinitialized)
+
+	// Change the default Senzing configuration.
+
+	configID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+
+	szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, configID)
+	require.NoError(test, err)
+
+	_, err = szConfig.RegisterDataSource(ctx, dataSourceCode)
+	require.NoError(test, err)
+
+	configDefinition, err := szConfig.Export(ctx)
+	require.NoError(test, err)
+
+	newConfigID, err := szConfigManager.RegisterConfig(ctx, configDefinition, "Add "+dataSourceCode)
+	require.NoError(test, err)
+	require.NoError(test, szConfigManager.ReplaceDefaultConfigID(ctx, configID, newConfigID))
+
+	reinitialized, err = configWatcher.Check(ctx)
+	require.NoError(test, err)
+	require.True(test, reinitialized)
+
+	activeConfigID, err := szEngine.GetActiveConfigID(ctx)
+	require.NoError(test, err)
+	require.Equal(test, newConfigID, activeConfigID)
+}
+
+func TestSzAbstractFactory_ConfigWatcher_Check_exportIteratorOpen(test *testing.T) {
+	ctx := test.Context()
+	dataSourceCode := "GO_TEST_WATCHER_EXPORT_" + strconv.FormatInt(time.Now().Unix(), baseTen)
+	recordID := "WATCHER-EXPORT-1"
+	recordDefinition := `{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "` + recordID + `", "PRIMARY_NAME_LAST": "Smith", "PRIMARY_NAME_FIRST": "Bob"}`
+	szAbstractFactory := &szabstractfactory.Szabstractfactory{
+		ConfigID:       senzing.SzInitializeWithDefaultConfiguration,
+		InstanceName:   instanceName,
+		Settings:       getSettings(location1),
+		VerboseLogging: verboseLogging,
+	}
+
+	defer func() { require.NoError(test, szAbstractFactory.Close(ctx)) }()
+
+	szEngine, err := szAbstractFactory.CreateEngine(ctx)
+	require.NoError(test, err)
+
+	defer func() { panicOnError(szEngine.Destroy(ctx)) }()
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	require.NoError(test, err)
+
+	defer func() { panicOnError(szConfigManager.Destroy(ctx)) }()
+
+	_, err = szEngine.AddRecord(ctx, "CUSTOMERS", recordID, recordDefinition, senzing.SzNoFlags)
+	require.NoError(test, err)
+
+	defer func() {
+		_, err := szEngine.DeleteRecord(ctx, "CUSTOMERS", recordID, senzing.SzNoFlags)
+		panicOnError(err)
+	}()
+
+	// Change the default Senzing configuration.
+
+	configID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+
+	szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, configID)
+	require.NoError(test, err)
+
+	_, err = szConfig.RegisterDataSource(ctx, dataSourceCode)
+	require.NoError(test, err)
+
+	configDefinition, err := szConfig.Export(ctx)
+	require.NoError(test, err)
+
+	newConfigID, err := szConfigManager.RegisterConfig(ctx, configDefinition, "Add "+dataSourceCode)
+	require.NoError(test, err)
+	require.NoError(test, szConfigManager.ReplaceDefaultConfigID(ctx, configID, newConfigID))
+
+	// An unread export iterator is a call in flight; the swap gives up after DrainTimeout.
+
+	fragments := szEngine.ExportJSONEntityReportIterator(ctx, senzing.SzNoFlags)
+	configWatcher := szAbstractFactory.NewConfigWatcher(szabstractfactory.ConfigWatcherOptions{ //exhaustruct:ignore
+		DrainTimeout: 100 * time.Millisecond,
+	})
+
+	reinitialized, err := configWatcher.Check(ctx)
+	require.Error(test, err)
+	require.False(test, reinitialized)
+
+	// Calls are released, so the reader of the iterator is not blocked.
+
+	activeConfigID, err := szEngine.GetActiveConfigID(ctx)
+	require.NoError(test, err)
+	require.Equal(test, configID, activeConfigID)
+
+	for fragment := range fragments {
+		require.NoError(test, fragment.Error)
+	}
+
+	reinitialized, err = configWatcher.Check(ctx)
+	require.NoError(test, err)
+	require.True(test, reinitialized)
+
+	activeConfigID, err = szEngine.GetActiveConfigID(ctx)
+	require.NoError(test, err)
+	require.Equal(test, newConfigID, activeConfigID)
+}
+
+func TestSzAbstractFactory_ConfigWatcher_Run_nothingToWatch(test *testing.T) {
+	ctx := test.Context()
+	szAbstractFactory := &szabstractfactory.Szabstractfactory{} //exhaustruct:ignore
+	configWatcher := szAbstractFactory.NewConfigWatcher(szabstractfactory.ConfigWatcherOptions{})
+	require.Error(test, configWatcher.Run(ctx))
+}
+
 /*
 Verify that all object can be created and subsequent SzAbstractFactories cannot create
 objects until the first SzAbstractFactory is destroyed.
@@ -1,5 +1,50 @@
 package szconfig
 
+import (
+	"context"
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type ConfigTx interface holds the operations of a [Szconfig.Batch].
+They act on a single configuration handle, loaded once for the whole batch.
+*/
+type ConfigTx interface {
+	GetDataSourceRegistry() (string, error)
+	RegisterDataSource(dataSourceCode string) (string, error)
+	UnregisterDataSource(dataSourceCode string) (string, error)
+}
+
+/*
+Type EnsureDataSourcesResult struct reports what [Szconfig.EnsureDataSources] did with each code.
+*/
+type EnsureDataSourcesResult struct {
+	Added   []string // Codes that were registered, in the order given.
+	Present []string // Codes that were already registered, in the order given.
+}
+
+// The ConfigTx of a batch in progress.
+type batchTx struct {
+	callerErr    error
+	client       *Szconfig
+	configHandle uintptr
+	ctx          context.Context //nolint:containedctx
+	err          error
+	isDone       bool
+	operations   int
+}
+
+// Fragment of a GetDataSourceRegistry() response.
+type dataSourceRegistry struct {
+	DataSources []struct {
+		DataSourceCode string `json:"DSRC_CODE"`
+	} `json:"DATA_SOURCES"`
+}
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
@@ -14,3 +59,17 @@ const (
 	ComponentID           = 6001
 	ExceptionCodeTemplate = "SENZ%04d"
 )
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errForPackage = errors.New("szconfig")
+
+// Messages of this implementation, in addition to those of the sz-sdk-go szconfig package.
+var idMessages = map[int]string{
+	27:   "Enter szconfig.Batch().",
+	28:   "Exit  szconfig.Batch() returned (%d, %v).",
+	4011: "szconfig.Batch: the operation was called outside of its batch.",
+	8011: "szconfig.Batch",
+}
@@ -14,11 +14,13 @@ package szconfig
 import "C"
 
 import (
-	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"runtime"
 	"strconv"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -29,6 +31,8 @@ import (
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/go-observing/subject"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/sdkerror"
+	"github.com/senzing-garage/sz-sdk-go-core/validation"
 	"github.com/senzing-garage/sz-sdk-go/szconfig"
 	"github.com/senzing-garage/sz-sdk-go/szerror"
 )
@@ -38,7 +42,9 @@ Type Szconfig struct implements the [senzing.SzConfig] interface
 for communicating with the Senzing C binaries.
 */
 type Szconfig struct {
+	callGate         helper.CallGate
 	configDefinition string
+	executor         *helper.Executor
 	instanceName     string
 	isTrace          bool
 	logger           logging.Logging
@@ -50,10 +56,9 @@ type Szconfig struct {
 }
 
 const (
-	baseCallerSkip       = 4
-	baseTen              = 10
-	initialByteArraySize = 65535
-	noError              = 0
+	baseCallerSkip = 4
+	baseTen        = 10
+	noError        = 0
 )
 
 // ----------------------------------------------------------------------------
@@ -109,6 +114,11 @@ func (client *Szconfig) GetDataSourceRegistry(ctx context.Context) (string, erro
 		result string
 	)
 
+	if !client.callGate.Enter() {
+		return result, wraperror.Errorf(errForPackage, "This SzConfig has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
 	if client.isTrace {
 		client.traceEntry(15)
 
@@ -116,7 +126,9 @@ func (client *Szconfig) GetDataSourceRegistry(ctx context.Context) (string, erro
 		defer func() { client.traceExit(16, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.getDataSourceRegistryChoreography(ctx, client.configDefinition)
+	client.executor.Call(func() {
+		result, err = client.getDataSourceRegistryChoreography(ctx, client.configDefinition)
+	})
 
 	if client.observers != nil {
 		go func() {
@@ -147,6 +159,11 @@ func (client *Szconfig) RegisterDataSource(ctx context.Context, dataSourceCode s
 		result string
 	)
 
+	if !client.callGate.Enter() {
+		return result, wraperror.Errorf(errForPackage, "This SzConfig has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
 	if client.isTrace {
 		client.traceEntry(1, dataSourceCode)
 
@@ -156,7 +173,17 @@ func (client *Szconfig) RegisterDataSource(ctx context.Context, dataSourceCode s
 		}()
 	}
 
-	configDefinition, result, err := client.registerDataSourceChoreography(ctx, client.configDefinition, dataSourceCode)
+	err = client.checkInputs(4001, validation.Identifier("dataSourceCode", dataSourceCode))
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	var configDefinition string
+
+	client.executor.Call(func() {
+		configDefinition, result, err = client.registerDataSourceChoreography(ctx, client.configDefinition, dataSourceCode)
+	})
+
 	if err == nil {
 		client.configDefinition = configDefinition
 	}
@@ -198,6 +225,11 @@ func (client *Szconfig) UnregisterDataSource(ctx context.Context, dataSourceCode
 		result string
 	)
 
+	if !client.callGate.Enter() {
+		return result, wraperror.Errorf(errForPackage, "This SzConfig has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
 	if client.isTrace {
 		client.traceEntry(9, dataSourceCode)
 
@@ -205,11 +237,21 @@ func (client *Szconfig) UnregisterDataSource(ctx context.Context, dataSourceCode
 		defer func() { client.traceExit(10, dataSourceCode, err, time.Since(entryTime)) }()
 	}
 
-	configDefinition, result, err := client.unregisterDataSourceChoreography(
-		ctx,
-		client.configDefinition,
-		dataSourceCode,
-	)
+	err = client.checkInputs(4004, validation.Identifier("dataSourceCode", dataSourceCode))
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	var configDefinition string
+
+	client.executor.Call(func() {
+		configDefinition, result, err = client.unregisterDataSourceChoreography(
+			ctx,
+			client.configDefinition,
+			dataSourceCode,
+		)
+	})
+
 	if err == nil {
 		client.configDefinition = configDefinition
 	}
@@ -230,17 +272,90 @@ func (client *Szconfig) UnregisterDataSource(ctx context.Context, dataSourceCode
 // Public non-interface methods
 // ----------------------------------------------------------------------------
 
+/*
+Method Batch applies many operations to this configuration with a single load and export.
+
+Registering or unregistering data sources one call at a time loads and exports the whole
+configuration for each call. Batch loads it once, runs operations with a [ConfigTx] acting
+on that configuration, and exports it once at the end.
+
+The batch is atomic: if operations returns an error, or any ConfigTx operation failed,
+the in-memory configuration is left unchanged and the first error is returned.
+The ConfigTx must only be used within operations, which must not call other methods of this Szconfig.
+
+Input
+  - ctx: A context to control lifecycle.
+  - operations: A function applying the operations with the ConfigTx it is given.
+*/
+func (client *Szconfig) Batch(ctx context.Context, operations func(tx ConfigTx) error) error {
+	var (
+		err error
+		tx  *batchTx
+	)
+
+	if !client.callGate.Enter() {
+		return wraperror.Errorf(errForPackage, "This SzConfig has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
+	if client.isTrace {
+		client.traceEntry(27)
+
+		entryTime := time.Now()
+		defer func() { client.traceExit(28, tx.count(), err, time.Since(entryTime)) }()
+	}
+
+	tx = &batchTx{
+		callerErr:    nil,
+		client:       client,
+		configHandle: 0,
+		ctx:          ctx,
+		err:          nil,
+		isDone:       false,
+		operations:   0,
+	}
+	err = client.runBatch(ctx, tx, operations)
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"operations": strconv.Itoa(tx.count()),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8011, err, details)
+		}()
+	}
+
+	if tx.callerErr != nil {
+		return tx.callerErr // The error of operations is returned as is, for errors.Is() and errors.As().
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
 /*
 Method Destroy will destroy and perform cleanup for the Senzing Szconfig object.
 
 It should be called after all other calls are complete.
 
+New calls are rejected once Destroy is called.
+Calls already in flight are given until ctx is done to finish;
+if they do not, nothing is destroyed and an error is returned.
+
 Input
   - ctx: A context to control lifecycle.
 */
 func (client *Szconfig) Destroy(ctx context.Context) error {
 	var err error
 
+	err = client.callGate.Close(ctx)
+	if errors.Is(err, helper.ErrCallGateClosed) {
+		return wraperror.Errorf(errForPackage, "This SzConfig has been destroyed.")
+	}
+
+	if err != nil {
+		return wraperror.Errorf(errForPackage, "calls in flight did not finish: %v", err)
+	}
+
 	if client.isTrace {
 		client.traceEntry(11)
 
@@ -248,7 +363,7 @@ func (client *Szconfig) Destroy(ctx context.Context) error {
 		defer func() { client.traceExit(12, err, time.Since(entryTime)) }()
 	}
 
-	err = client.destroy(ctx)
+	client.executor.Call(func() { err = client.destroy(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -260,6 +375,72 @@ func (client *Szconfig) Destroy(ctx context.Context) error {
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+Method EnsureDataSources registers the data sources that are not registered yet.
+Unlike RegisterDataSource, a code that is already registered is not an error.
+
+The codes are registered in a single [Szconfig.Batch], so the configuration is loaded and exported once.
+Codes are compared without regard to case; a code given more than once is added once, then reported as present.
+If a code cannot be registered, none are and the error is returned.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCodes: Unique identifiers of the data sources (e.g. "TEST_DATASOURCE").
+
+Output
+  - The codes that were added and the codes that were already present.
+*/
+func (client *Szconfig) EnsureDataSources(
+	ctx context.Context,
+	dataSourceCodes ...string,
+) (EnsureDataSourcesResult, error) {
+	var result EnsureDataSourcesResult
+
+	err := client.Batch(ctx, func(tx ConfigTx) error {
+		result = EnsureDataSourcesResult{Added: []string{}, Present: []string{}}
+
+		registry, err := tx.GetDataSourceRegistry()
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		var document dataSourceRegistry
+
+		err = json.Unmarshal([]byte(registry), &document)
+		if err != nil {
+			return wraperror.Errorf(err, "GetDataSourceRegistry")
+		}
+
+		registered := map[string]bool{}
+		for _, dataSource := range document.DataSources {
+			registered[strings.ToUpper(dataSource.DataSourceCode)] = true
+		}
+
+		for _, dataSourceCode := range dataSourceCodes {
+			if registered[strings.ToUpper(dataSourceCode)] {
+				result.Present = append(result.Present, dataSourceCode)
+
+				continue
+			}
+
+			_, err = tx.RegisterDataSource(dataSourceCode)
+			if err != nil {
+				return err //nolint:wrapcheck
+			}
+
+			registered[strings.ToUpper(dataSourceCode)] = true
+			result.Added = append(result.Added, dataSourceCode)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return EnsureDataSourcesResult{}, err
+	}
+
+	return result, nil
+}
+
 /*
 Method GetObserverOrigin returns the "origin" value of past Observer messages.
 
@@ -292,7 +473,12 @@ func (client *Szconfig) Import(ctx context.Context, configDefinition string) err
 		defer func() { client.traceExit(22, configDefinition, err, time.Since(entryTime)) }()
 	}
 
-	err = client.importConfigDefinition(ctx, configDefinition)
+	err = client.checkInputs(4009, validation.Document("configDefinition", configDefinition))
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { err = client.importConfigDefinition(ctx, configDefinition) })
 
 	if client.observers != nil {
 		go func() {
@@ -322,6 +508,11 @@ func (client *Szconfig) ImportTemplate(ctx context.Context) error {
 		configDefinition string
 	)
 
+	if !client.callGate.Enter() {
+		return wraperror.Errorf(errForPackage, "This SzConfig has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
 	if client.isTrace {
 		client.traceEntry(7)
 
@@ -329,12 +520,13 @@ func (client *Szconfig) ImportTemplate(ctx context.Context) error {
 		defer func() { client.traceExit(8, configDefinition, err, time.Since(entryTime)) }()
 	}
 
-	configDefinition, err = client.importTemplateChoregraphy(ctx)
+	client.executor.Call(func() { configDefinition, err = client.importTemplateChoregraphy(ctx) })
+
 	if err != nil {
 		return wraperror.Errorf(err, "importTemplateChoregraphy")
 	}
 
-	err = client.importConfigDefinition(ctx, configDefinition)
+	client.executor.Call(func() { err = client.importConfigDefinition(ctx, configDefinition) })
 
 	if client.observers != nil {
 		go func() {
@@ -372,10 +564,19 @@ func (client *Szconfig) Initialize(
 		defer func() { client.traceExit(24, instanceName, settings, verboseLogging, err, time.Since(entryTime)) }()
 	}
 
+	err = client.checkInputs(
+		4007,
+		validation.Identifier("instanceName", instanceName),
+		validation.Document("settings", settings),
+	)
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
 	client.instanceName = instanceName
 	client.settings = settings
 	client.verboseLogging = verboseLogging
-	err = client.init(ctx, instanceName, settings, verboseLogging)
+	client.executor.Call(func() { err = client.init(ctx, instanceName, settings, verboseLogging) })
 
 	if client.observers != nil {
 		go func() {
@@ -426,6 +627,22 @@ func (client *Szconfig) RegisterObserver(ctx context.Context, observer observer.
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+Method SetExecutor routes calls into the Senzing C binary through an [helper.Executor].
+
+By default each call locks the calling goroutine to its OS thread.
+With an executor the calls run on its pool of threads, which stay locked.
+Set the executor before making calls; it is not changed while calls are in flight.
+
+Input
+  - ctx: A context to control lifecycle.
+  - executor: The executor to use. nil restores the default.
+*/
+func (client *Szconfig) SetExecutor(ctx context.Context, executor *helper.Executor) {
+	_ = ctx
+	client.executor = executor
+}
+
 /*
 Method SetLogLevel sets the level of logging.
 
@@ -522,6 +739,11 @@ Input
 func (client *Szconfig) VerifyConfigDefinition(ctx context.Context, configDefinition string) error {
 	var err error
 
+	if !client.callGate.Enter() {
+		return wraperror.Errorf(errForPackage, "This SzConfig has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
 	if client.isTrace {
 		client.traceEntry(25, configDefinition)
 
@@ -529,7 +751,12 @@ func (client *Szconfig) VerifyConfigDefinition(ctx context.Context, configDefini
 		defer func() { client.traceExit(26, configDefinition, err, time.Since(entryTime)) }()
 	}
 
-	err = client.verifyConfigDefinitionChoreography(ctx, configDefinition)
+	err = client.checkInputs(4009, validation.Document("configDefinition", configDefinition))
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { err = client.verifyConfigDefinitionChoreography(ctx, configDefinition) })
 
 	if client.observers != nil {
 		go func() {
@@ -541,10 +768,140 @@ func (client *Szconfig) VerifyConfigDefinition(ctx context.Context, configDefini
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+// ----------------------------------------------------------------------------
+// ConfigTx methods
+// ----------------------------------------------------------------------------
+
+/*
+Method GetDataSourceRegistry gets the data source registry of the configuration being changed by the batch.
+
+Output
+  - A JSON document listing data sources, including those registered earlier in the batch.
+*/
+func (tx *batchTx) GetDataSourceRegistry() (string, error) {
+	var (
+		err    error
+		result string
+	)
+
+	err = tx.begin()
+	if err == nil {
+		tx.client.executor.Call(func() { result, err = tx.client.getDataSourceRegistry(tx.ctx, tx.configHandle) })
+	}
+
+	return result, tx.end(err)
+}
+
+/*
+Method RegisterDataSource adds a data source to the configuration being changed by the batch.
+
+Input
+  - dataSourceCode: Unique identifier of the data source (e.g. "TEST_DATASOURCE").
+
+Output
+  - A JSON document listing the newly created data source.
+*/
+func (tx *batchTx) RegisterDataSource(dataSourceCode string) (string, error) {
+	var (
+		err    error
+		result string
+	)
+
+	err = tx.begin()
+	if err == nil {
+		err = tx.client.checkInputs(4001, validation.Identifier("dataSourceCode", dataSourceCode))
+	}
+
+	if err == nil {
+		tx.client.executor.Call(func() {
+			result, err = tx.client.registerDataSource(tx.ctx, tx.configHandle, dataSourceCode)
+		})
+	}
+
+	return result, tx.end(err)
+}
+
+/*
+Method UnregisterDataSource removes a data source from the configuration being changed by the batch.
+
+Input
+  - dataSourceCode: Unique identifier of the data source (e.g. "TEST_DATASOURCE").
+
+Output
+  - Currently an empty string.
+*/
+func (tx *batchTx) UnregisterDataSource(dataSourceCode string) (string, error) {
+	var (
+		err    error
+		result string
+	)
+
+	err = tx.begin()
+	if err == nil {
+		err = tx.client.checkInputs(4004, validation.Identifier("dataSourceCode", dataSourceCode))
+	}
+
+	if err == nil {
+		tx.client.executor.Call(func() {
+			err = tx.client.unregisterDataSource(tx.ctx, tx.configHandle, dataSourceCode)
+		})
+	}
+
+	return result, tx.end(err)
+}
+
 // ----------------------------------------------------------------------------
 // Private methods
 // ----------------------------------------------------------------------------
 
+// Load the configuration, run the operations of a batch, then export the configuration unless one failed.
+// The in-memory configuration is replaced only once the handle is closed without error.
+func (client *Szconfig) runBatch(ctx context.Context, tx *batchTx, operations func(tx ConfigTx) error) error {
+	var (
+		configDefinition string
+		err              error
+		isClosed         bool
+	)
+
+	client.executor.Call(func() { tx.configHandle, err = client.load(ctx, client.configDefinition) })
+	if err != nil {
+		return wraperror.Errorf(err, "load")
+	}
+
+	closeHandle := func() error {
+		var closeErr error
+
+		if !isClosed {
+			isClosed = true
+
+			client.executor.Call(func() { closeErr = client.close(ctx, tx.configHandle) })
+		}
+
+		return closeErr
+	}
+
+	defer func() { _ = closeHandle() }() // Only does something if operations panicked.
+
+	err = runOperations(tx, operations)
+	if err == nil {
+		client.executor.Call(func() { configDefinition, err = client.export(ctx, tx.configHandle) })
+		err = wraperror.Errorf(err, "save")
+	}
+
+	closeErr := closeHandle()
+	if err != nil {
+		return err
+	}
+
+	if closeErr != nil {
+		return closeErr
+	}
+
+	client.configDefinition = configDefinition
+
+	return nil
+}
+
 func (client *Szconfig) registerDataSourceChoreography(
 	ctx context.Context,
 	configDefinition string,
@@ -703,6 +1060,44 @@ func (client *Szconfig) verifyConfigDefinitionChoreography(
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+// Reject operations once the batch is over.
+func (tx *batchTx) begin() error {
+	if tx.isDone {
+		errorMessage := tx.client.getMessenger().NewJSON(4011)
+
+		return sdkerror.New(
+			ComponentID,
+			4011,
+			0,
+			"the operation was called outside of its batch",
+			nil,
+			errors.Join(szerror.ErrSzBadInput, szerror.ErrSz, errors.New(errorMessage)), //nolint:err113
+		)
+	}
+
+	tx.operations++
+
+	return nil
+}
+
+// The number of operations, for tracing and observers.
+func (tx *batchTx) count() int {
+	if tx == nil {
+		return 0
+	}
+
+	return tx.operations
+}
+
+// Remember the first failed operation; it makes the whole batch fail.
+func (tx *batchTx) end(err error) error {
+	if err != nil && tx.err == nil && !tx.isDone {
+		tx.err = err
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
 // ----------------------------------------------------------------------------
 // Private methods for calling the Senzing C API
 // ----------------------------------------------------------------------------
@@ -901,7 +1296,11 @@ func (client *Szconfig) init(ctx context.Context, instanceName string, settings
 // Get the Logger singleton.
 func (client *Szconfig) getLogger() logging.Logging {
 	if client.logger == nil {
-		client.logger = helper.GetLogger(ComponentID, szconfig.IDMessages, baseCallerSkip)
+		client.logger = helper.GetLogger(
+			ComponentID,
+			helper.MergeIDMessages(szconfig.IDMessages, idMessages),
+			baseCallerSkip,
+		)
 	}
 
 	return client.logger
@@ -910,7 +1309,11 @@ func (client *Szconfig) getLogger() logging.Logging {
 // Get the Messenger singleton.
 func (client *Szconfig) getMessenger() messenger.Messenger {
 	if client.messenger == nil {
-		client.messenger = helper.GetMessenger(ComponentID, szconfig.IDMessages, baseCallerSkip)
+		client.messenger = helper.GetMessenger(
+			ComponentID,
+			helper.MergeIDMessages(szconfig.IDMessages, idMessages),
+			baseCallerSkip,
+		)
 	}
 
 	return client.messenger
@@ -928,7 +1331,12 @@ func (client *Szconfig) traceExit(errorNumber int, details ...interface{}) {
 
 // --- Errors -----------------------------------------------------------------
 
-// Create a new error.
+// Check parameters before they are sent to the Senzing C binary.
+func (client *Szconfig) checkInputs(errorNumber int, inputs ...validation.Input) error {
+	return helper.CheckInputs(ComponentID, errorNumber, client.getMessenger(), inputs...)
+}
+
+// Create a new error.  The returned *sdkerror.Error can be retrieved using errors.As().
 func (client *Szconfig) newError(ctx context.Context, errorNumber int, details ...interface{}) error {
 	defer func() { client.panicOnError(client.clearLastException(ctx)) }()
 
@@ -939,12 +1347,20 @@ func (client *Szconfig) newError(ctx context.Context, errorNumber int, details .
 		lastException = err.Error()
 	}
 
+	arguments := details
 	details = append(details, messenger.MessageCode{Value: fmt.Sprintf(ExceptionCodeTemplate, lastExceptionCode)})
 	details = append(details, messenger.MessageReason{Value: lastException})
 	details = append(details, wraperror.Errorf(szerror.ErrSz, "exception: %s", lastException))
 	errorMessage := client.getMessenger().NewJSON(errorNumber, details...)
 
-	return szerror.New(lastExceptionCode, errorMessage) //nolint
+	return sdkerror.New(
+		ComponentID,
+		errorNumber,
+		lastExceptionCode,
+		lastException,
+		arguments,
+		szerror.New(lastExceptionCode, errorMessage),
+	)
 }
 
 /*
@@ -1008,9 +1424,11 @@ func (client *Szconfig) getLastException(ctx context.Context) (string, error) {
 		defer func() { client.traceExit(18, result, err, time.Since(entryTime)) }()
 	}
 
-	stringBuffer := client.getByteArray(initialByteArraySize)
-	C.SzConfig_getLastException((*C.char)(unsafe.Pointer(&stringBuffer[0])), C.size_t(len(stringBuffer)))
-	result = string(bytes.Trim(stringBuffer, "\x00"))
+	stringBuffer := helper.GetExceptionBuffer()
+	defer helper.PutExceptionBuffer(stringBuffer)
+
+	C.SzConfig_getLastException((*C.char)(unsafe.Pointer(&(*stringBuffer)[0])), C.size_t(len(*stringBuffer)))
+	result = helper.NulTerminatedString(*stringBuffer)
 
 	return result, err
 }
@@ -1046,15 +1464,16 @@ func (client *Szconfig) getLastExceptionCode(ctx context.Context) (int, error) {
 
 // --- Misc -------------------------------------------------------------------
 
-// Get space for an array of bytes of a given size.
-// func (client *Szconfig) getByteArrayC(size int) *C.char {
-// 	bytes := C.malloc(C.size_t(size))
-// 	return (*C.char)(bytes)
-// }
+// Call the operations of a batch. A failed ConfigTx operation fails the batch even if operations ignored it.
+func runOperations(tx *batchTx, operations func(tx ConfigTx) error) error {
+	defer func() { tx.isDone = true }()
+
+	tx.callerErr = operations(tx)
+	if tx.callerErr != nil {
+		return tx.callerErr
+	}
 
-// Make a byte array.
-func (client *Szconfig) getByteArray(size int) []byte {
-	return make([]byte, size)
+	return tx.err
 }
 
 // A hack: Only needed to import the "senzing" package for the godoc comments.
@@ -8,6 +8,7 @@ import (
 
 	"github.com/senzing-garage/go-helpers/jsonutil"
 	"github.com/senzing-garage/go-logging/logging"
+	"github.com/senzing-garage/sz-sdk-go-core/szconfig"
 )
 
 // ----------------------------------------------------------------------------
@@ -161,6 +162,71 @@ func ExampleSzconfig_GetDataSourceRegistry() {
 	// }
 }
 
+// ----------------------------------------------------------------------------
+// Public non-interface methods - Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func ExampleSzconfig_Batch() {
+	// For more information, visit
+	// https://github.com/senzing-garage/sz-sdk-go-core/blob/main/szconfig/szconfig_examples_test.go
+	ctx := context.TODO()
+	szConfig := getSzConfig(ctx)
+
+	err := szConfig.ImportTemplate(ctx)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	err = szConfig.Batch(ctx, func(tx szconfig.ConfigTx) error {
+		for _, dataSourceCode := range []string{"CUSTOMERS", "REFERENCE", "WATCHLIST"} {
+			_, err := tx.RegisterDataSource(dataSourceCode)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	result, err := szConfig.GetDataSourceRegistry(ctx)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	fmt.Println(jsonutil.PrettyPrint(result, jsonIndentation))
+	// Output:
+	// {
+	//     "DATA_SOURCES": [
+	//         {
+	//             "DSRC_ID": 1,
+	//             "DSRC_CODE": "TEST"
+	//         },
+	//         {
+	//             "DSRC_ID": 2,
+	//             "DSRC_CODE": "SEARCH"
+	//         },
+	//         {
+	//             "DSRC_ID": 1001,
+	//             "DSRC_CODE": "CUSTOMERS"
+	//         },
+	//         {
+	//             "DSRC_ID": 1002,
+	//             "DSRC_CODE": "REFERENCE"
+	//         },
+	//         {
+	//             "DSRC_ID": 1003,
+	//             "DSRC_CODE": "WATCHLIST"
+	//         }
+	//     ]
+	// }
+}
+
 // ----------------------------------------------------------------------------
 // Logging and observing
 // ----------------------------------------------------------------------------
@@ -2,6 +2,7 @@ package szconfig_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -12,6 +13,7 @@ import (
 	"github.com/senzing-garage/go-helpers/fileutil"
 	"github.com/senzing-garage/go-helpers/settings"
 	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/sz-sdk-go-core/sdkerror"
 	"github.com/senzing-garage/sz-sdk-go-core/szabstractfactory"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfig"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
@@ -82,6 +84,21 @@ func TestSzconfig_RegisterDataSource_badDataSourceCode(test *testing.T) {
 	require.JSONEq(test, expectedErr, err.Error())
 }
 
+func TestSzconfig_RegisterDataSource_badDataSourceCode_sdkError(test *testing.T) {
+	ctx := test.Context()
+	szConfig := getTestObject(test)
+	actual, err := szConfig.RegisterDataSource(ctx, badDataSourceCode)
+	printDebug(test, err, actual)
+
+	var sdkError *sdkerror.Error
+	require.ErrorAs(test, err, &sdkError)
+	assert.Equal(test, szconfig.ComponentID, sdkError.ComponentID)
+	assert.Equal(test, "SZSDK60014001", sdkError.MessageID)
+	assert.Equal(test, 3121, sdkError.ExceptionCode)
+	assert.Equal(test, "Szconfig.RegisterDataSource", sdkError.Method)
+	assert.Contains(test, sdkError.Reason, "JSON Parsing Failure")
+}
+
 func TestSzconfig_RegisterDataSource_nilDataSourceCode(test *testing.T) {
 	ctx := test.Context()
 	szConfig := getTestObject(test)
@@ -155,6 +172,116 @@ func TestSzconfig_GetDataSourceRegistry(test *testing.T) {
 // Public non-interface methods
 // ----------------------------------------------------------------------------
 
+func TestSzconfig_Batch(test *testing.T) {
+	ctx := test.Context()
+	szConfig := getTestObject(test)
+	dataSourceCodes := []string{"GO_TEST_BATCH_1", "GO_TEST_BATCH_2", "GO_TEST_BATCH_3"}
+
+	err := szConfig.Batch(ctx, func(tx szconfig.ConfigTx) error {
+		for _, code := range dataSourceCodes {
+			_, err := tx.RegisterDataSource(code)
+			if err != nil {
+				return err
+			}
+		}
+
+		registry, err := tx.GetDataSourceRegistry()
+		printDebug(test, err, registry)
+		assert.Contains(test, registry, dataSourceCodes[2])
+
+		_, err = tx.UnregisterDataSource(dataSourceCodes[2])
+
+		return err
+	})
+	require.NoError(test, err)
+
+	actual, err := szConfig.GetDataSourceRegistry(ctx)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	assert.Contains(test, actual, dataSourceCodes[0])
+	assert.Contains(test, actual, dataSourceCodes[1])
+	assert.NotContains(test, actual, dataSourceCodes[2])
+}
+
+func TestSzconfig_Batch_failedOperation(test *testing.T) {
+	ctx := test.Context()
+	szConfig := getTestObject(test)
+	before, err := szConfig.Export(ctx)
+	require.NoError(test, err)
+
+	err = szConfig.Batch(ctx, func(tx szconfig.ConfigTx) error {
+		_, _ = tx.RegisterDataSource("GO_TEST_BATCH_4")
+		_, _ = tx.RegisterDataSource(badDataSourceCode) // Ignored by the function, but still fails the batch.
+
+		return nil
+	})
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+
+	after, err := szConfig.Export(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, before, after)
+}
+
+func TestSzconfig_Batch_operationsError(test *testing.T) {
+	ctx := test.Context()
+	szConfig := getTestObject(test)
+	errStop := errors.New("stop")
+
+	var savedTx szconfig.ConfigTx
+
+	err := szConfig.Batch(ctx, func(tx szconfig.ConfigTx) error {
+		savedTx = tx
+		_, err := tx.RegisterDataSource("GO_TEST_BATCH_5")
+		require.NoError(test, err)
+
+		return errStop
+	})
+	require.ErrorIs(test, err, errStop)
+
+	actual, err := szConfig.GetDataSourceRegistry(ctx)
+	require.NoError(test, err)
+	assert.NotContains(test, actual, "GO_TEST_BATCH_5")
+
+	_, err = savedTx.RegisterDataSource("GO_TEST_BATCH_6")
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+}
+
+func TestSzconfig_EnsureDataSources(test *testing.T) {
+	ctx := test.Context()
+	szConfig := getTestObject(test)
+
+	_, err := szConfig.RegisterDataSource(ctx, "GO_TEST_ENSURE_1")
+	require.NoError(test, err)
+
+	actual, err := szConfig.EnsureDataSources(ctx, "GO_TEST_ENSURE_1", "GO_TEST_ENSURE_2", "go_test_ensure_2")
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	assert.Equal(test, []string{"GO_TEST_ENSURE_2"}, actual.Added)
+	assert.Equal(test, []string{"GO_TEST_ENSURE_1", "go_test_ensure_2"}, actual.Present)
+
+	actual, err = szConfig.EnsureDataSources(ctx, "GO_TEST_ENSURE_2")
+	require.NoError(test, err)
+	assert.Empty(test, actual.Added)
+	assert.Equal(test, []string{"GO_TEST_ENSURE_2"}, actual.Present)
+}
+
+func TestSzconfig_EnsureDataSources_badDataSourceCode(test *testing.T) {
+	ctx := test.Context()
+	szConfig := getTestObject(test)
+	before, err := szConfig.Export(ctx)
+	require.NoError(test, err)
+
+	actual, err := szConfig.EnsureDataSources(ctx, "GO_TEST_ENSURE_3", badDataSourceCode)
+	printDebug(test, err, actual)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	assert.Empty(test, actual.Added)
+
+	after, err := szConfig.Export(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, before, after)
+}
+
 func TestSzconfig_Import(test *testing.T) {
 	ctx := test.Context()
 	szConfig := getTestObject(test)
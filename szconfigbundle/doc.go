@@ -0,0 +1,26 @@
+/*
+Package szconfigbundle moves a Senzing configuration from one repository to another, e.g. from development to production.
+
+[Export] writes a registered configuration to a self-describing [Bundle] with its comment, its configuration ID,
+a fingerprint of the source repository, a checksum, and the versions of this SDK and of the Senzing library:
+
+	bundle, err := szconfigbundle.Export(ctx, szconfigbundle.Repository{
+		ConfigManager: devConfigManager,
+		Diagnostic:    devDiagnostic,
+		Product:       devProduct,
+	}, 0)
+	...
+	err = bundle.Write(file)
+
+[Import] verifies the checksum and that the configuration is compatible with the Senzing library of the target repository,
+finds out whether an identical configuration is registered there already, registers it otherwise,
+and optionally makes it the default configuration:
+
+	bundle, err := szconfigbundle.Read(file)
+	...
+	result, err := szconfigbundle.Import(ctx, szconfigbundle.Repository{
+		ConfigManager: prodConfigManager,
+		Product:       prodProduct,
+	}, bundle, szconfigbundle.ImportOptions{SetDefault: true})
+*/
+package szconfigbundle
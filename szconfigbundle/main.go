@@ -0,0 +1,102 @@
+package szconfigbundle
+
+import (
+	"context"
+	"errors"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type ConfigManager interface is the part of [senzing.SzConfigManager] used to export and import bundles.
+Any senzing.SzConfigManager satisfies it.
+
+[senzing.SzConfigManager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzConfigManager
+*/
+type ConfigManager interface {
+	CreateConfigFromConfigID(ctx context.Context, configID int64) (senzing.SzConfig, error)
+	GetConfigRegistry(ctx context.Context) (string, error)
+	GetDefaultConfigID(ctx context.Context) (int64, error)
+	RegisterConfig(ctx context.Context, configDefinition string, configComment string) (int64, error)
+	ReplaceDefaultConfigID(ctx context.Context, currentDefaultConfigID int64, newDefaultConfigID int64) error
+}
+
+/*
+Type Diagnostic interface is the part of [senzing.SzDiagnostic] used to fingerprint a repository.
+Any senzing.SzDiagnostic satisfies it.
+
+[senzing.SzDiagnostic]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzDiagnostic
+*/
+type Diagnostic interface {
+	GetRepositoryInfo(ctx context.Context) (string, error)
+}
+
+/*
+Type Product interface is the part of [senzing.SzProduct] used to check versions.
+Any senzing.SzProduct satisfies it.
+
+[senzing.SzProduct]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzProduct
+*/
+type Product interface {
+	GetVersion(ctx context.Context) (string, error)
+}
+
+// Fragment of a configuration definition.
+type configDocument struct {
+	Config struct {
+		BaseVersion struct {
+			CompatibilityVersion struct {
+				ConfigVersion string `json:"CONFIG_VERSION"`
+			} `json:"COMPATIBILITY_VERSION"`
+		} `json:"CONFIG_BASE_VERSION"`
+	} `json:"G2_CONFIG"`
+}
+
+// A GetRepositoryInfo() response.
+type repositoryDocument struct {
+	DataStores []struct {
+		ID       string `json:"id"`
+		Location string `json:"location"`
+		Type     string `json:"type"`
+	} `json:"dataStores"`
+}
+
+// Fragment of a GetVersion() response.
+type versionDocument struct {
+	CompatibilityVersion struct {
+		ConfigVersion string `json:"CONFIG_VERSION"`
+	} `json:"COMPATIBILITY_VERSION"`
+	Version string `json:"VERSION"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// FormatVersion is the version of the bundle format written by this package.
+const FormatVersion = 1
+
+const (
+	checksumPrefix = "sha256:"
+	modulePath     = "github.com/senzing-garage/sz-sdk-go-core"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+/*
+The Err* variables are wrapped by the errors of Import and Read. Use errors.Is to test for them.
+
+  - ErrChecksum: The configuration definition does not match the checksum of the bundle.
+  - ErrIncompatible: The bundle was written by a newer bundle format,
+    or its configuration is not compatible with the Senzing version of the repository.
+*/
+var (
+	ErrChecksum     = errors.New("bundle checksum mismatch")
+	ErrIncompatible = errors.New("bundle is incompatible")
+)
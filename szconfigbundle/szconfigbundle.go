@@ -0,0 +1,483 @@
+package szconfigbundle
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigregistry"
+)
+
+/*
+Type Bundle struct is a configuration exported from a repository, to import into another one.
+*/
+type Bundle struct {
+	Checksum                   string    `json:"CHECKSUM"`          // "sha256:" and the SHA-256 of ConfigDefinition.
+	ConfigCompatibilityVersion string    `json:"CONFIG_VERSION"`    // COMPATIBILITY_VERSION.CONFIG_VERSION of the configuration.
+	ConfigComment              string    `json:"CONFIG_COMMENT"`    // The comment of the configuration in the source repository.
+	ConfigDefinition           string    `json:"CONFIG_DEFINITION"` // The Senzing configuration JSON document, as exported.
+	CreatedAt                  time.Time `json:"CREATED_AT"`
+	FormatVersion              int       `json:"FORMAT_VERSION"`    // FormatVersion when written.
+	SdkVersion                 string    `json:"SDK_VERSION"`       // Version of this module, or "(devel)".
+	SenzingVersion             string    `json:"SENZING_VERSION"`   // VERSION of the Senzing library. Example: "4.1.1".
+	SourceConfigID             int64     `json:"SOURCE_CONFIG_ID"`  // The configuration ID in the source repository.
+	SourceRepository           string    `json:"SOURCE_REPOSITORY"` // RepositoryFingerprint() of the source repository.
+}
+
+/*
+Type ImportOptions struct controls Import.
+*/
+type ImportOptions struct {
+	Comment    string // The comment of the registered configuration. Empty generates one from the bundle.
+	SetDefault bool   // Make the configuration the default configuration.
+}
+
+/*
+Type ImportResult struct describes an import.
+*/
+type ImportResult struct {
+	AlreadyRegistered bool  // An identical configuration was registered; nothing was registered.
+	ConfigID          int64 // The configuration in the repository.
+	IsDefault         bool  // The configuration is the default configuration.
+}
+
+/*
+Type Repository struct holds the Senzing objects of a repository.
+*/
+type Repository struct {
+	ConfigManager ConfigManager
+	Diagnostic    Diagnostic
+	Product       Product
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Export function bundles a registered configuration.
+
+Input
+  - ctx: A context to control lifecycle.
+  - repository: The source repository.
+  - configID: The configuration to export. 0 for the default configuration.
+
+Output
+  - The bundle.
+*/
+func Export(ctx context.Context, repository Repository, configID int64) (*Bundle, error) {
+	configManager := repository.ConfigManager
+
+	defaultConfigID, err := configManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if configID == 0 {
+		configID = defaultConfigID
+	}
+
+	configComment, err := registeredComment(ctx, configManager, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	configDefinition, err := exportConfig(ctx, configManager, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	compatibilityVersion, err := configCompatibilityVersion(configDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := productVersion(ctx, repository.Product)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint, err := RepositoryFingerprint(ctx, repository.Diagnostic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bundle{
+		Checksum:                   checksum(configDefinition),
+		ConfigCompatibilityVersion: compatibilityVersion,
+		ConfigComment:              configComment,
+		ConfigDefinition:           configDefinition,
+		CreatedAt:                  time.Now().UTC(),
+		FormatVersion:              FormatVersion,
+		SdkVersion:                 sdkVersion(),
+		SenzingVersion:             version.Version,
+		SourceConfigID:             configID,
+		SourceRepository:           fingerprint,
+	}, nil
+}
+
+/*
+The Import function registers the configuration of a bundle, unless an identical configuration is registered,
+and optionally makes it the default configuration with ReplaceDefaultConfigID().
+
+The bundle is verified first, and the configuration must have the COMPATIBILITY_VERSION.CONFIG_VERSION
+of the Senzing library of the repository.
+Only the configuration with the source configuration ID is compared with the bundle;
+an identical configuration under another ID is found by RegisterConfig(), which returns its ID.
+
+Input
+  - ctx: A context to control lifecycle.
+  - repository: The target repository. Diagnostic is not used.
+  - bundle: The bundle to import.
+  - options: See ImportOptions.
+
+Output
+  - What was done.
+*/
+func Import(ctx context.Context, repository Repository, bundle *Bundle, options ImportOptions) (*ImportResult, error) {
+	configManager := repository.ConfigManager
+
+	err := bundle.Verify()
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := productVersion(ctx, repository.Product)
+	if err != nil {
+		return nil, err
+	}
+
+	compatibilityVersion, err := configCompatibilityVersion(bundle.ConfigDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	if compatibilityVersion != version.CompatibilityVersion.ConfigVersion {
+		return nil, fmt.Errorf("%w: CONFIG_VERSION %s, but Senzing %s requires %s", ErrIncompatible,
+			compatibilityVersion, version.Version, version.CompatibilityVersion.ConfigVersion)
+	}
+
+	configRegistry, err := configManager.GetConfigRegistry(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	entries, err := szconfigregistry.Parse(configRegistry, 0)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	result := &ImportResult{AlreadyRegistered: false, ConfigID: 0, IsDefault: false}
+
+	result.ConfigID, err = findIdentical(ctx, configManager, bundle, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.ConfigID != 0 {
+		result.AlreadyRegistered = true
+	} else {
+		result.ConfigID, err = configManager.RegisterConfig(ctx, bundle.ConfigDefinition, importComment(bundle, options))
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		result.AlreadyRegistered = slices.ContainsFunc(entries, func(entry szconfigregistry.Entry) bool {
+			return entry.ConfigID == result.ConfigID
+		})
+	}
+
+	defaultConfigID, err := configManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if options.SetDefault && defaultConfigID != result.ConfigID {
+		err = configManager.ReplaceDefaultConfigID(ctx, defaultConfigID, result.ConfigID)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		defaultConfigID = result.ConfigID
+	}
+
+	result.IsDefault = defaultConfigID == result.ConfigID
+
+	return result, nil
+}
+
+/*
+The Read function reads a bundle written by Bundle.Write and verifies it.
+
+Input
+  - reader: Where to read the bundle from.
+
+Output
+  - The bundle.
+*/
+func Read(reader io.Reader) (*Bundle, error) {
+	bundle := &Bundle{} //exhaustruct:ignore
+
+	err := json.NewDecoder(reader).Decode(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("reading configuration bundle: %w", err)
+	}
+
+	err = bundle.Verify()
+	if err != nil {
+		return nil, err
+	}
+
+	return bundle, nil
+}
+
+/*
+The RepositoryFingerprint function identifies a repository by its data stores.
+The locations of the data stores are hashed, so the fingerprint does not reveal them.
+
+Input
+  - ctx: A context to control lifecycle.
+  - diagnostic: A senzing.SzDiagnostic of the repository.
+
+Output
+  - A hexadecimal SHA-256 hash.
+*/
+func RepositoryFingerprint(ctx context.Context, diagnostic Diagnostic) (string, error) {
+	repositoryInfo, err := diagnostic.GetRepositoryInfo(ctx)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	var document repositoryDocument
+
+	err = json.Unmarshal([]byte(repositoryInfo), &document)
+	if err != nil {
+		return "", fmt.Errorf("parsing repository information: %w", err)
+	}
+
+	dataStores := make([]string, 0, len(document.DataStores))
+	for _, dataStore := range document.DataStores {
+		dataStores = append(dataStores, strings.Join([]string{dataStore.ID, dataStore.Type, dataStore.Location}, "\t"))
+	}
+
+	slices.Sort(dataStores)
+	hash := sha256.Sum256([]byte(strings.Join(dataStores, "\n")))
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Verify checks the format version and the checksum of the bundle.
+*/
+func (bundle *Bundle) Verify() error {
+	if bundle.FormatVersion < 1 || bundle.FormatVersion > FormatVersion {
+		return fmt.Errorf("%w: format version %d; this version reads up to %d",
+			ErrIncompatible, bundle.FormatVersion, FormatVersion)
+	}
+
+	if checksum(bundle.ConfigDefinition) != bundle.Checksum {
+		return fmt.Errorf("%w: configuration %d from repository %s", ErrChecksum, bundle.SourceConfigID,
+			bundle.SourceRepository)
+	}
+
+	return nil
+}
+
+/*
+Method Write writes the bundle as an indented JSON document.
+
+Input
+  - writer: Where to write the bundle.
+*/
+func (bundle *Bundle) Write(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(bundle)
+	if err != nil {
+		return fmt.Errorf("writing configuration bundle: %w", err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// A configuration definition in a canonical form: compact, with object keys sorted.
+func canonical(configDefinition string) (string, error) {
+	var value any
+
+	decoder := json.NewDecoder(strings.NewReader(configDefinition))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&value)
+	if err != nil {
+		return "", fmt.Errorf("parsing configuration: %w", err)
+	}
+
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	err = encoder.Encode(value)
+	if err != nil {
+		return "", fmt.Errorf("encoding configuration: %w", err)
+	}
+
+	return buffer.String(), nil
+}
+
+func checksum(configDefinition string) string {
+	hash := sha256.Sum256([]byte(configDefinition))
+
+	return checksumPrefix + hex.EncodeToString(hash[:])
+}
+
+func configCompatibilityVersion(configDefinition string) (string, error) {
+	var document configDocument
+
+	err := json.Unmarshal([]byte(configDefinition), &document)
+	if err != nil {
+		return "", fmt.Errorf("parsing configuration: %w", err)
+	}
+
+	return document.Config.BaseVersion.CompatibilityVersion.ConfigVersion, nil
+}
+
+func exportConfig(ctx context.Context, configManager ConfigManager, configID int64) (string, error) {
+	config, err := configManager.CreateConfigFromConfigID(ctx, configID)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	return config.Export(ctx) //nolint:wrapcheck
+}
+
+// The registered configuration with the identifier of the source configuration, if it has the same content
+// as the bundle, or 0. It is compared by checksum before it is compared in canonical form.
+// Other configurations are not compared: RegisterConfig() returns the identifier of an identical configuration
+// that is registered under another identifier.
+func findIdentical(
+	ctx context.Context,
+	configManager ConfigManager,
+	bundle *Bundle,
+	entries []szconfigregistry.Entry,
+) (int64, error) {
+	isRegistered := slices.ContainsFunc(entries, func(entry szconfigregistry.Entry) bool {
+		return entry.ConfigID == bundle.SourceConfigID
+	})
+	if !isRegistered {
+		return 0, nil
+	}
+
+	registered, err := exportConfig(ctx, configManager, bundle.SourceConfigID)
+	if err != nil {
+		return 0, err
+	}
+
+	if checksum(registered) == bundle.Checksum {
+		return bundle.SourceConfigID, nil
+	}
+
+	wanted, err := canonical(bundle.ConfigDefinition)
+	if err != nil {
+		return 0, err
+	}
+
+	candidate, err := canonical(registered)
+	if err != nil {
+		return 0, err
+	}
+
+	if candidate == wanted {
+		return bundle.SourceConfigID, nil
+	}
+
+	return 0, nil
+}
+
+// The comment of an imported configuration. Example: "Imported configuration 351539198 from 3f2a9c1b04d7: Add WATCHLIST".
+func importComment(bundle *Bundle, options ImportOptions) string {
+	result := options.Comment
+	if result == "" {
+		result = fmt.Sprintf("Imported configuration %d from %.12s", bundle.SourceConfigID, bundle.SourceRepository)
+		if bundle.ConfigComment != "" {
+			result += ": " + bundle.ConfigComment
+		}
+	}
+
+	return szconfigmanager.TruncateComment(result)
+}
+
+func productVersion(ctx context.Context, product Product) (*versionDocument, error) {
+	versionJSON, err := product.GetVersion(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	result := &versionDocument{} //exhaustruct:ignore
+
+	err = json.Unmarshal([]byte(versionJSON), result)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Senzing version: %w", err)
+	}
+
+	return result, nil
+}
+
+func registeredComment(ctx context.Context, configManager ConfigManager, configID int64) (string, error) {
+	configRegistry, err := configManager.GetConfigRegistry(ctx)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	entries, err := szconfigregistry.Parse(configRegistry, 0)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	for _, entry := range entries {
+		if entry.ConfigID == configID {
+			return entry.Comment, nil
+		}
+	}
+
+	return "", nil
+}
+
+// The version of this module, as recorded in the build information of the program.
+func sdkVersion() string {
+	buildInfo, isAvailable := debug.ReadBuildInfo()
+	if !isAvailable {
+		return "unknown"
+	}
+
+	if buildInfo.Main.Path == modulePath {
+		return buildInfo.Main.Version
+	}
+
+	for _, module := range buildInfo.Deps {
+		if module.Path == modulePath {
+			return module.Version
+		}
+	}
+
+	return "unknown"
+}
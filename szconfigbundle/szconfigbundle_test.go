@@ -0,0 +1,235 @@
+package szconfigbundle_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/internal/testhelper"
+	"github.com/senzing-garage/sz-sdk-go-core/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigbundle"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	configV11 = `{"G2_CONFIG": {"CFG_DSRC": [{"DSRC_ID": 1, "DSRC_CODE": "TEST"}],` +
+		` "CONFIG_BASE_VERSION": {"VERSION": "4.0.0", "COMPATIBILITY_VERSION": {"CONFIG_VERSION": "11"}}}}`
+	configV11Reformatted = `{"G2_CONFIG":{"CONFIG_BASE_VERSION":{"COMPATIBILITY_VERSION":{"CONFIG_VERSION":"11"},` +
+		`"VERSION":"4.0.0"},"CFG_DSRC":[{"DSRC_CODE":"TEST","DSRC_ID":1}]}}`
+	configV11Customers = `{"G2_CONFIG": {"CFG_DSRC": [{"DSRC_ID": 1001, "DSRC_CODE": "CUSTOMERS"}],` +
+		` "CONFIG_BASE_VERSION": {"VERSION": "4.0.0", "COMPATIBILITY_VERSION": {"CONFIG_VERSION": "11"}}}}`
+	configV12 = `{"G2_CONFIG": {"CFG_DSRC": [],` +
+		` "CONFIG_BASE_VERSION": {"VERSION": "5.0.0", "COMPATIBILITY_VERSION": {"CONFIG_VERSION": "12"}}}}`
+	productV11 = `{"COMPATIBILITY_VERSION": {"CONFIG_VERSION": "11"}, "PRODUCT_NAME": "Senzing SDK", "VERSION": "4.1.1"}`
+)
+
+type fakeDiagnostic struct {
+	repositoryInfo string
+}
+
+func (diagnostic *fakeDiagnostic) GetRepositoryInfo(ctx context.Context) (string, error) {
+	_ = ctx
+
+	return diagnostic.repositoryInfo, nil
+}
+
+type fakeProduct struct {
+	version string
+}
+
+func (product *fakeProduct) GetVersion(ctx context.Context) (string, error) {
+	_ = ctx
+
+	return product.version, nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestExport(test *testing.T) {
+	test.Parallel()
+
+	bundle := export(test, configV11Customers)
+	assert.Equal(test, int64(1), bundle.SourceConfigID)
+	assert.Equal(test, "Initial", bundle.ConfigComment)
+	assert.Equal(test, configV11Customers, bundle.ConfigDefinition)
+	assert.Equal(test, "11", bundle.ConfigCompatibilityVersion)
+	assert.Equal(test, "4.1.1", bundle.SenzingVersion)
+	assert.Equal(test, szconfigbundle.FormatVersion, bundle.FormatVersion)
+	assert.True(test, strings.HasPrefix(bundle.Checksum, "sha256:"))
+	assert.Len(test, bundle.SourceRepository, 64)
+	assert.NotContains(test, bundle.SourceRepository, "G2C")
+	require.NoError(test, bundle.Verify())
+}
+
+func TestBundle_Write(test *testing.T) {
+	test.Parallel()
+
+	bundle := export(test, configV11Customers)
+
+	var buffer bytes.Buffer
+	require.NoError(test, bundle.Write(&buffer))
+
+	actual, err := szconfigbundle.Read(&buffer)
+	require.NoError(test, err)
+	assert.Equal(test, bundle.ConfigDefinition, actual.ConfigDefinition)
+	assert.True(test, bundle.CreatedAt.Equal(actual.CreatedAt))
+}
+
+func TestRead_tampered(test *testing.T) {
+	test.Parallel()
+
+	bundle := export(test, configV11Customers)
+	bundle.ConfigDefinition = strings.Replace(bundle.ConfigDefinition, "CUSTOMERS", "HACKERS", 1)
+
+	var buffer bytes.Buffer
+	require.NoError(test, bundle.Write(&buffer))
+
+	_, err := szconfigbundle.Read(&buffer)
+	require.ErrorIs(test, err, szconfigbundle.ErrChecksum)
+
+	bundle = export(test, configV11Customers)
+	bundle.FormatVersion = szconfigbundle.FormatVersion + 1
+	require.ErrorIs(test, bundle.Verify(), szconfigbundle.ErrIncompatible)
+}
+
+func TestImport(test *testing.T) {
+	test.Parallel()
+
+	bundle := export(test, configV11Customers)
+	target := testhelper.NewConfigManager(configV11)
+
+	result, err := szconfigbundle.Import(test.Context(), repository(target), bundle, szconfigbundle.ImportOptions{})
+	require.NoError(test, err)
+	assert.Equal(test, &szconfigbundle.ImportResult{AlreadyRegistered: false, ConfigID: 2, IsDefault: false}, result)
+	assert.Equal(test, "Imported configuration 1 from "+bundle.SourceRepository[:12]+": Initial", target.Comments[2])
+	assert.Equal(test, int64(1), target.DefaultConfigID)
+
+	result, err = szconfigbundle.Import(test.Context(), repository(target), bundle,
+		szconfigbundle.ImportOptions{Comment: "Release 7", SetDefault: true})
+	require.NoError(test, err)
+	assert.Equal(test, &szconfigbundle.ImportResult{AlreadyRegistered: true, ConfigID: 2, IsDefault: true}, result)
+	assert.Equal(test, int64(2), target.DefaultConfigID)
+	assert.Equal(test, int64(3), target.NextConfigID)
+}
+
+func TestImport_identicalReformatted(test *testing.T) {
+	test.Parallel()
+
+	bundle := export(test, configV11)
+	target := testhelper.NewConfigManager(configV11Reformatted)
+
+	result, err := szconfigbundle.Import(test.Context(), repository(target), bundle, szconfigbundle.ImportOptions{})
+	require.NoError(test, err)
+	assert.True(test, result.AlreadyRegistered)
+	assert.True(test, result.IsDefault)
+	assert.Equal(test, int64(1), result.ConfigID)
+}
+
+func TestImport_sourceConfigIDFirst(test *testing.T) {
+	test.Parallel()
+
+	bundle := export(test, configV11Customers)
+	target := testhelper.NewConfigManager(configV11Customers, configV11, configV11Reformatted)
+	exports := 0
+	target.NewConfig = func(definition string) senzing.SzConfig {
+		exports++
+
+		return &testhelper.Config{Definition: definition}
+	}
+
+	result, err := szconfigbundle.Import(test.Context(), repository(target), bundle, szconfigbundle.ImportOptions{})
+	require.NoError(test, err)
+	assert.True(test, result.AlreadyRegistered)
+	assert.Equal(test, bundle.SourceConfigID, result.ConfigID)
+	assert.Equal(test, 1, exports, "only the configuration with the source identifier is exported")
+}
+
+func TestImport_otherConfigIDNotScanned(test *testing.T) {
+	test.Parallel()
+
+	bundle := export(test, configV11Customers)
+	target := testhelper.NewConfigManager(configV11, configV11Customers)
+	exports := 0
+	target.NewConfig = func(definition string) senzing.SzConfig {
+		exports++
+
+		return &testhelper.Config{Definition: definition}
+	}
+
+	result, err := szconfigbundle.Import(test.Context(), repository(target), bundle, szconfigbundle.ImportOptions{})
+	require.NoError(test, err)
+	assert.Equal(test, &szconfigbundle.ImportResult{AlreadyRegistered: true, ConfigID: 2, IsDefault: true}, result)
+	assert.Equal(test, 1, exports, "only the configuration with the source identifier is exported")
+	assert.Equal(test, int64(3), target.NextConfigID)
+}
+
+func TestImport_incompatible(test *testing.T) {
+	test.Parallel()
+
+	bundle := export(test, configV12)
+	target := testhelper.NewConfigManager(configV11)
+
+	_, err := szconfigbundle.Import(test.Context(), repository(target), bundle,
+		szconfigbundle.ImportOptions{SetDefault: true})
+	require.ErrorIs(test, err, szconfigbundle.ErrIncompatible)
+	assert.Equal(test, int64(2), target.NextConfigID)
+}
+
+func TestImport_szAbstractFactory(test *testing.T) {
+	ctx := test.Context()
+	szAbstractFactory := &szabstractfactory.Szabstractfactory{
+		ConfigID:       senzing.SzInitializeWithDefaultConfiguration,
+		InstanceName:   test.Name(),
+		Settings:       testhelper.NewRepository(test, "CUSTOMERS"),
+		VerboseLogging: senzing.SzNoLogging,
+	}
+
+	defer func() { require.NoError(test, szAbstractFactory.Close(context.Background())) }()
+
+	szConfigManager, err := szAbstractFactory.CreateConfigManager(ctx)
+	require.NoError(test, err)
+	szDiagnostic, err := szAbstractFactory.CreateDiagnostic(ctx)
+	require.NoError(test, err)
+	szProduct, err := szAbstractFactory.CreateProduct(ctx)
+	require.NoError(test, err)
+
+	repository := szconfigbundle.Repository{ConfigManager: szConfigManager, Diagnostic: szDiagnostic, Product: szProduct}
+
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+
+	bundle, err := szconfigbundle.Export(ctx, repository, 0)
+	require.NoError(test, err)
+	assert.Equal(test, defaultConfigID, bundle.SourceConfigID)
+	require.NoError(test, bundle.Verify())
+
+	result, err := szconfigbundle.Import(ctx, repository, bundle, szconfigbundle.ImportOptions{SetDefault: true})
+	require.NoError(test, err)
+	assert.Equal(test, &szconfigbundle.ImportResult{AlreadyRegistered: true, ConfigID: defaultConfigID, IsDefault: true}, result)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func export(test *testing.T, configDefinition string) *szconfigbundle.Bundle {
+	test.Helper()
+
+	bundle, err := szconfigbundle.Export(test.Context(), repository(testhelper.NewConfigManager(configDefinition)), 0)
+	require.NoError(test, err)
+
+	return bundle
+}
+
+func repository(configManager *testhelper.ConfigManager) szconfigbundle.Repository {
+	return szconfigbundle.Repository{
+		ConfigManager: configManager,
+		Diagnostic:    &fakeDiagnostic{repositoryInfo: `{"dataStores": [{"id": "CORE", "location": "/tmp/sqlite/G2C.db", "type": "sqlite3"}]}`},
+		Product:       &fakeProduct{version: productV11},
+	}
+}
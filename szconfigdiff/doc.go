@@ -0,0 +1,18 @@
+/*
+Package szconfigdiff reports what changed between two Senzing configurations.
+
+A configuration is a JSON document of several hundred kilobytes; a textual diff of two of them is hard to read.
+[Compare] matches the entries of each section of the two configurations by a stable key,
+such as DSRC_CODE for data sources or GPLAN, BEHAVIOR and FTYPE for generic thresholds, rather than by position.
+Function calls, such as CFG_CFCALL, are keyed by the feature type, feature element and function they call.
+References to other sections by ID, such as FTYPE_ID or CFCALL_ID, are shown as the key of the referenced entry:
+
+	diff, err := szconfigdiff.CompareConfigIDs(ctx, szConfigManager, oldConfigID, newConfigID)
+	...
+	err = diff.Write(os.Stdout, szconfigdiff.FormatMarkdown)
+
+The [Diff] lists added and removed data sources, and the added, removed and changed entries of every section,
+such as CFG_ATTR (attributes), CFG_FTYPE (feature types), CFG_GENERIC_THRESHOLD (generic thresholds)
+and CFG_GPLAN (generic plans). It can be written as text, JSON or markdown.
+*/
+package szconfigdiff
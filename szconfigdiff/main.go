@@ -0,0 +1,157 @@
+package szconfigdiff
+
+import (
+	"context"
+	"errors"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type ConfigManager interface is the part of [senzing.SzConfigManager] used to compare configurations by ID.
+Any senzing.SzConfigManager satisfies it.
+
+[senzing.SzConfigManager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzConfigManager
+*/
+type ConfigManager interface {
+	CreateConfigFromConfigID(ctx context.Context, configID int64) (senzing.SzConfig, error)
+}
+
+/*
+Type Format int selects how a Diff is written.
+*/
+type Format int
+
+/*
+Type Kind string is the kind of a Change.
+*/
+type Kind string
+
+// An entry of a section, with references resolved, and its key.
+type entry struct {
+	fields map[string]any
+	key    string
+}
+
+// How a section refers to another section by ID.
+type reference struct {
+	idField string
+	section string
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+The Format* constants are the supported output formats.
+
+  - FormatJSON: The Diff as a JSON document.
+  - FormatMarkdown: A heading and a table per section.
+  - FormatText: A line per change, "+" for added, "-" for removed and "~" for changed entries.
+*/
+const (
+	FormatText Format = iota
+	FormatJSON
+	FormatMarkdown
+)
+
+/*
+The Kind* constants are the kinds of changes.
+*/
+const (
+	KindAdded   Kind = "ADDED"
+	KindChanged Kind = "CHANGED"
+	KindRemoved Kind = "REMOVED"
+)
+
+const dataSourceSection = "CFG_DSRC"
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errNoConfig = errors.New(`not a Senzing configuration; "G2_CONFIG" is missing`)
+
+var formatNames = map[Format]string{
+	FormatJSON:     "JSON",
+	FormatMarkdown: "MARKDOWN",
+	FormatText:     "TEXT",
+}
+
+var kindSymbols = map[Kind]string{
+	KindAdded:   "+",
+	KindChanged: "~",
+	KindRemoved: "-",
+}
+
+// The fields identifying an entry of each section. Sections not listed are keyed by
+// their first *_CODE field or, without one, by their whole content.
+// Function calls are keyed by what they call, as their IDs differ between configurations.
+var keyFields = map[string][]string{
+	"CFG_ATTR":              {"ATTR_CODE"},
+	"CFG_CFBOM":             {"CFCALL_ID", "FTYPE_ID", "FELEM_ID"},
+	"CFG_CFCALL":            {"FTYPE_ID", "CFUNC_ID"},
+	"CFG_CFRTN":             {"CFUNC_ID", "FTYPE_ID", "CFUNC_RTNVAL"},
+	"CFG_CFUNC":             {"CFUNC_CODE"},
+	"CFG_DFBOM":             {"DFCALL_ID", "FTYPE_ID", "FELEM_ID"},
+	"CFG_DFCALL":            {"FTYPE_ID", "DFUNC_ID"},
+	"CFG_DFUNC":             {"DFUNC_CODE"},
+	"CFG_DSRC":              {"DSRC_CODE"},
+	"CFG_EFBOM":             {"EFCALL_ID", "FTYPE_ID", "FELEM_ID"},
+	"CFG_EFCALL":            {"FTYPE_ID", "FELEM_ID", "EFUNC_ID"},
+	"CFG_EFUNC":             {"EFUNC_CODE"},
+	"CFG_ERFRAG":            {"ERFRAG_CODE"},
+	"CFG_ERRULE":            {"ERRULE_CODE"},
+	"CFG_FBOM":              {"FTYPE_ID", "FELEM_ID"},
+	"CFG_FBOVR":             {"FTYPE_ID", "UTYPE_CODE"},
+	"CFG_FCLASS":            {"FCLASS_CODE"},
+	"CFG_FELEM":             {"FELEM_CODE"},
+	"CFG_FTYPE":             {"FTYPE_CODE"},
+	"CFG_GENERIC_THRESHOLD": {"GPLAN_ID", "BEHAVIOR", "FTYPE_ID"},
+	"CFG_GPLAN":             {"GPLAN_CODE"},
+	"CFG_RCLASS":            {"RCLASS_CODE"},
+	"CFG_RTYPE":             {"RTYPE_CODE"},
+	"CFG_SFCALL":            {"FTYPE_ID", "FELEM_ID", "SFUNC_ID"},
+	"CFG_SFUNC":             {"SFUNC_CODE"},
+	"SYS_OOM":               {"OOM_TYPE", "OOM_LEVEL", "FTYPE_ID"},
+}
+
+// ID fields that refer to an entry of another section, shown as that entry's key.
+var references = map[string]reference{
+	"CFCALL_ID":      {idField: "CFCALL_ID", section: "CFG_CFCALL"},
+	"CFUNC_ID":       {idField: "CFUNC_ID", section: "CFG_CFUNC"},
+	"DFCALL_ID":      {idField: "DFCALL_ID", section: "CFG_DFCALL"},
+	"DFUNC_ID":       {idField: "DFUNC_ID", section: "CFG_DFUNC"},
+	"DSRC_ID":        {idField: "DSRC_ID", section: "CFG_DSRC"},
+	"EFCALL_ID":      {idField: "EFCALL_ID", section: "CFG_EFCALL"},
+	"EFEAT_FTYPE_ID": {idField: "FTYPE_ID", section: "CFG_FTYPE"},
+	"EFUNC_ID":       {idField: "EFUNC_ID", section: "CFG_EFUNC"},
+	"ERRULE_ID":      {idField: "ERRULE_ID", section: "CFG_ERRULE"},
+	"FCLASS_ID":      {idField: "FCLASS_ID", section: "CFG_FCLASS"},
+	"FELEM_ID":       {idField: "FELEM_ID", section: "CFG_FELEM"},
+	"FTYPE_ID":       {idField: "FTYPE_ID", section: "CFG_FTYPE"},
+	"GPLAN_ID":       {idField: "GPLAN_ID", section: "CFG_GPLAN"},
+	"RCLASS_ID":      {idField: "RCLASS_ID", section: "CFG_RCLASS"},
+	"RTYPE_ID":       {idField: "RTYPE_ID", section: "CFG_RTYPE"},
+	"SFCALL_ID":      {idField: "SFCALL_ID", section: "CFG_SFCALL"},
+	"SFUNC_ID":       {idField: "SFUNC_ID", section: "CFG_SFUNC"},
+}
+
+// Descriptions of the best-known sections, for headings.
+var sectionTitles = map[string]string{
+	"CFG_ATTR":              "Attributes",
+	"CFG_DSRC":              "Data sources",
+	"CFG_ERRULE":            "Entity resolution rules",
+	"CFG_FBOM":              "Feature elements of feature types",
+	"CFG_FELEM":             "Feature elements",
+	"CFG_FTYPE":             "Feature types",
+	"CFG_GENERIC_THRESHOLD": "Generic thresholds",
+	"CFG_GPLAN":             "Generic plans",
+	"CONFIG_BASE_VERSION":   "Configuration version",
+	"SETTINGS":              "Settings",
+}
@@ -0,0 +1,509 @@
+package szconfigdiff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+/*
+Type Change struct is an entry that was added, removed or changed.
+*/
+type Change struct {
+	Fields  []FieldChange `json:"FIELDS,omitempty"` // The changed fields of a KindChanged entry.
+	Key     string        `json:"KEY"`              // Example: "NAME_FULL", or "GPLAN=INGEST BEHAVIOR=NAME FTYPE=0".
+	Kind    Kind          `json:"KIND"`             // Added, removed or changed.
+	Section string        `json:"SECTION"`          // Example: "CFG_ATTR".
+}
+
+/*
+Type Diff struct lists the differences between two configurations.
+*/
+type Diff struct {
+	AddedDataSources   []string `json:"ADDED_DATA_SOURCES"`   // Sorted.
+	Changes            []Change `json:"CHANGES"`              // Ordered by section, with CFG_DSRC first, then by key.
+	RemovedDataSources []string `json:"REMOVED_DATA_SOURCES"` // Sorted.
+}
+
+/*
+Type FieldChange struct is a field whose value changed. A missing field has a nil value.
+*/
+type FieldChange struct {
+	Field string `json:"FIELD"` // Example: "FELEM_REQ", or "COMPATIBILITY_VERSION.CONFIG_VERSION" in nested objects.
+	New   any    `json:"NEW"`
+	Old   any    `json:"OLD"`
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Compare function compares two configuration definitions.
+
+Input
+  - oldConfigDefinition: A Senzing configuration JSON document.
+  - newConfigDefinition: The Senzing configuration JSON document to compare it with.
+
+Output
+  - The differences.
+*/
+func Compare(oldConfigDefinition string, newConfigDefinition string) (*Diff, error) {
+	oldConfig, err := parseConfig(oldConfigDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("old configuration: %w", err)
+	}
+
+	newConfig, err := parseConfig(newConfigDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("new configuration: %w", err)
+	}
+
+	diff := &Diff{AddedDataSources: []string{}, Changes: []Change{}, RemovedDataSources: []string{}}
+
+	for _, section := range sectionNames(oldConfig, newConfig) {
+		oldEntries := sectionEntries(oldConfig, section)
+		newEntries := sectionEntries(newConfig, section)
+		diff.Changes = append(diff.Changes, compareEntries(section, oldEntries, newEntries)...)
+	}
+
+	for _, change := range diff.Changes {
+		if change.Section != dataSourceSection {
+			continue
+		}
+
+		switch change.Kind {
+		case KindAdded:
+			diff.AddedDataSources = append(diff.AddedDataSources, change.Key)
+		case KindRemoved:
+			diff.RemovedDataSources = append(diff.RemovedDataSources, change.Key)
+		case KindChanged:
+		}
+	}
+
+	return diff, nil
+}
+
+/*
+The CompareConfigIDs function compares two registered configurations.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configManager: A senzing.SzConfigManager.
+  - oldConfigID: The identifier of a registered configuration.
+  - newConfigID: The identifier of the registered configuration to compare it with.
+
+Output
+  - The differences.
+*/
+func CompareConfigIDs(
+	ctx context.Context,
+	configManager ConfigManager,
+	oldConfigID int64,
+	newConfigID int64,
+) (*Diff, error) {
+	definitions := make([]string, 0, 2) //nolint:mnd
+
+	for _, configID := range []int64{oldConfigID, newConfigID} {
+		config, err := configManager.CreateConfigFromConfigID(ctx, configID)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		definition, err := config.Export(ctx)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	return Compare(definitions[0], definitions[1])
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method IsEmpty reports whether the configurations are equivalent.
+*/
+func (diff *Diff) IsEmpty() bool {
+	return len(diff.Changes) == 0
+}
+
+/*
+Method String returns the differences as text.
+*/
+func (diff *Diff) String() string {
+	var builder strings.Builder
+
+	_ = diff.Write(&builder, FormatText)
+
+	return builder.String()
+}
+
+/*
+Method Write writes the differences.
+
+Input
+  - writer: Where to write.
+  - format: FormatText, FormatJSON or FormatMarkdown.
+*/
+func (diff *Diff) Write(writer io.Writer, format Format) error {
+	var buffer bytes.Buffer
+
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(&buffer)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+
+		err := encoder.Encode(diff)
+		if err != nil {
+			return fmt.Errorf("encoding configuration diff: %w", err)
+		}
+	case FormatMarkdown:
+		diff.writeMarkdown(&buffer)
+	case FormatText:
+		diff.writeText(&buffer)
+	default:
+		return fmt.Errorf("writing configuration diff: unknown format %s", format) //nolint:err113
+	}
+
+	_, err := writer.Write(buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("writing configuration diff: %w", err)
+	}
+
+	return nil
+}
+
+/*
+Method String returns the name of the format, e.g. "MARKDOWN".
+*/
+func (format Format) String() string {
+	if name, ok := formatNames[format]; ok {
+		return name
+	}
+
+	return "Format(" + strconv.Itoa(int(format)) + ")"
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (diff *Diff) writeMarkdown(buffer *bytes.Buffer) {
+	if diff.IsEmpty() {
+		buffer.WriteString("No changes.\n")
+
+		return
+	}
+
+	section := ""
+
+	for _, change := range diff.Changes {
+		if change.Section != section {
+			section = change.Section
+			buffer.WriteString("## " + sectionHeading(section) + "\n\n")
+			buffer.WriteString("| Change | Key | Field | Old | New |\n| --- | --- | --- | --- | --- |\n")
+		}
+
+		if len(change.Fields) == 0 {
+			fmt.Fprintf(buffer, "| %s | %s | | | |\n", kindSymbols[change.Kind], markdownCell(change.Key))
+		}
+
+		for _, field := range change.Fields {
+			fmt.Fprintf(buffer, "| %s | %s | %s | %s | %s |\n",
+				kindSymbols[change.Kind],
+				markdownCell(change.Key),
+				markdownCell(field.Field),
+				markdownCell(valueText(field.Old)),
+				markdownCell(valueText(field.New)),
+			)
+		}
+
+		if isLastOfSection(diff.Changes, change) {
+			buffer.WriteString("\n")
+		}
+	}
+}
+
+func (diff *Diff) writeText(buffer *bytes.Buffer) {
+	if diff.IsEmpty() {
+		buffer.WriteString("No changes.\n")
+
+		return
+	}
+
+	section := ""
+
+	for _, change := range diff.Changes {
+		if change.Section != section {
+			section = change.Section
+			buffer.WriteString(sectionHeading(section) + "\n")
+		}
+
+		buffer.WriteString("  " + kindSymbols[change.Kind] + " " + change.Key + "\n")
+
+		for _, field := range change.Fields {
+			fmt.Fprintf(buffer, "      %s: %s -> %s\n", field.Field, jsonText(field.Old), jsonText(field.New))
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Compare the entries of a section, matched by key.
+func compareEntries(section string, oldEntries map[string]entry, newEntries map[string]entry) []Change {
+	changes := []Change{}
+	keys := slices.Sorted(maps.Keys(oldEntries))
+	keys = append(keys, slices.Sorted(maps.Keys(newEntries))...)
+	slices.Sort(keys)
+
+	for _, key := range slices.Compact(keys) {
+		oldEntry, inOld := oldEntries[key]
+		newEntry, inNew := newEntries[key]
+
+		switch {
+		case !inOld:
+			changes = append(changes, Change{Fields: nil, Key: key, Kind: KindAdded, Section: section})
+		case !inNew:
+			changes = append(changes, Change{Fields: nil, Key: key, Kind: KindRemoved, Section: section})
+		default:
+			fields := compareFields(oldEntry.fields, newEntry.fields)
+			if len(fields) > 0 {
+				changes = append(changes, Change{Fields: fields, Key: key, Kind: KindChanged, Section: section})
+			}
+		}
+	}
+
+	return changes
+}
+
+func compareFields(oldFields map[string]any, newFields map[string]any) []FieldChange {
+	result := []FieldChange{}
+	names := slices.Sorted(maps.Keys(oldFields))
+	names = append(names, slices.Sorted(maps.Keys(newFields))...)
+	slices.Sort(names)
+
+	for _, name := range slices.Compact(names) {
+		if jsonText(oldFields[name]) != jsonText(newFields[name]) {
+			result = append(result, FieldChange{Field: name, New: newFields[name], Old: oldFields[name]})
+		}
+	}
+
+	return result
+}
+
+// Nested objects become fields with dotted names.
+func flatten(prefix string, object map[string]any, fields map[string]any) {
+	for name, value := range object {
+		if nested, isObject := value.(map[string]any); isObject {
+			flatten(prefix+name+".", nested, fields)
+		} else {
+			fields[prefix+name] = value
+		}
+	}
+}
+
+func isLastOfSection(changes []Change, change Change) bool {
+	index := slices.IndexFunc(changes, func(candidate Change) bool {
+		return candidate.Section == change.Section && candidate.Key == change.Key
+	})
+
+	return index == len(changes)-1 || changes[index+1].Section != change.Section
+}
+
+// The key of an entry, from its key fields with references already resolved.
+func entryKey(section string, fields map[string]any) string {
+	names, isKnown := keyFields[section]
+	if !isKnown {
+		for _, name := range slices.Sorted(maps.Keys(fields)) {
+			if strings.HasSuffix(name, "_CODE") {
+				return valueText(fields[name])
+			}
+		}
+
+		return jsonText(fields)
+	}
+
+	if len(names) == 1 {
+		return valueText(fields[names[0]])
+	}
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		label := strings.TrimSuffix(strings.TrimSuffix(name, "_ID"), "_CODE")
+
+		value := valueText(fields[name])
+		if strings.Contains(value, " ") {
+			value = "(" + value + ")"
+		}
+
+		parts = append(parts, label+"="+value)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Canonical JSON of a value, for comparisons and text output.
+func jsonText(value any) string {
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return strings.TrimSpace(buffer.String())
+}
+
+func markdownCell(text string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(text)
+}
+
+// The G2_CONFIG object of a configuration definition. Numbers are kept as written.
+func parseConfig(configDefinition string) (map[string]any, error) {
+	var document map[string]any
+
+	decoder := json.NewDecoder(strings.NewReader(configDefinition))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&document)
+	if err != nil {
+		return nil, fmt.Errorf("parsing: %w", err)
+	}
+
+	config, isObject := document["G2_CONFIG"].(map[string]any)
+	if !isObject {
+		return nil, errNoConfig
+	}
+
+	return config, nil
+}
+
+// Keys of the entries of a section by ID. Example: "CFG_FTYPE" gives {"1": "NAME", ...}
+// and "CFG_CFCALL" gives {"1": "FTYPE=NAME CFUNC=CMP_NAME", ...}.
+func referenceCodes(config map[string]any, target reference) map[string]string {
+	result := map[string]string{}
+
+	for key, entry := range sectionEntries(config, target.section) {
+		result[valueText(entry.fields[target.idField])] = key
+	}
+
+	return result
+}
+
+// The entries of a section by key. An object section is a single entry with an empty key.
+func sectionEntries(config map[string]any, section string) map[string]entry {
+	result := map[string]entry{}
+
+	switch value := config[section].(type) {
+	case nil:
+	case []any:
+		resolvers := map[string]map[string]string{}
+
+		for _, item := range value {
+			fields := map[string]any{}
+
+			object, isObject := item.(map[string]any)
+			if !isObject {
+				object = map[string]any{"VALUE": item}
+			}
+
+			for name, fieldValue := range object {
+				fields[name] = resolve(config, section, name, fieldValue, resolvers)
+			}
+
+			key := entryKey(section, fields)
+			for count := 2; ; count++ {
+				if _, isTaken := result[key]; !isTaken {
+					break
+				}
+
+				key = entryKey(section, fields) + " #" + strconv.Itoa(count)
+			}
+
+			result[key] = entry{fields: fields, key: key}
+		}
+	case map[string]any:
+		fields := map[string]any{}
+		flatten("", value, fields)
+		result[""] = entry{fields: fields, key: ""}
+	default:
+		result[""] = entry{fields: map[string]any{"VALUE": value}, key: ""}
+	}
+
+	return result
+}
+
+// The heading of a section. Example: "Data sources (CFG_DSRC)".
+func sectionHeading(section string) string {
+	if title, isKnown := sectionTitles[section]; isKnown {
+		return title + " (" + section + ")"
+	}
+
+	return section
+}
+
+// Sections of both configurations, with CFG_DSRC first.
+func sectionNames(oldConfig map[string]any, newConfig map[string]any) []string {
+	names := slices.Collect(maps.Keys(oldConfig))
+	names = append(names, slices.Collect(maps.Keys(newConfig))...)
+	slices.SortFunc(names, func(a string, b string) int {
+		switch {
+		case a == b:
+			return 0
+		case a == dataSourceSection:
+			return -1
+		case b == dataSourceSection:
+			return 1
+		default:
+			return strings.Compare(a, b)
+		}
+	})
+
+	return slices.Compact(names)
+}
+
+// Replace the ID of a referenced entry with its code, when the entry exists.
+func resolve(config map[string]any, section string, name string, value any, resolvers map[string]map[string]string) any {
+	target, isReference := references[name]
+	if !isReference || target.section == section {
+		return value
+	}
+
+	codes, isCached := resolvers[name]
+	if !isCached {
+		codes = referenceCodes(config, target)
+		resolvers[name] = codes
+	}
+
+	if code, isFound := codes[valueText(value)]; isFound {
+		return code
+	}
+
+	return value
+}
+
+// A value as text: strings without quotes, other values as JSON.
+func valueText(value any) string {
+	if text, isString := value.(string); isString {
+		return text
+	}
+
+	return jsonText(value)
+}
@@ -0,0 +1,228 @@
+package szconfigdiff_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigdiff"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const oldConfig = `{"G2_CONFIG": {
+	"CFG_ATTR": [
+		{"ATTR_ID": 1001, "ATTR_CODE": "NAME_FULL", "FTYPE_CODE": "NAME", "FELEM_REQ": "No"},
+		{"ATTR_ID": 1002, "ATTR_CODE": "NAME_ORG", "FTYPE_CODE": "NAME", "FELEM_REQ": "No"}
+	],
+	"CFG_DSRC": [
+		{"DSRC_ID": 1, "DSRC_CODE": "TEST"},
+		{"DSRC_ID": 2, "DSRC_CODE": "SEARCH"}
+	],
+	"CFG_FTYPE": [
+		{"FTYPE_ID": 1, "FTYPE_CODE": "NAME"},
+		{"FTYPE_ID": 5, "FTYPE_CODE": "DOB"}
+	],
+	"CFG_GENERIC_THRESHOLD": [
+		{"GPLAN_ID": 1, "BEHAVIOR": "NAME", "FTYPE_ID": 0, "CANDIDATE_CAP": 10},
+		{"GPLAN_ID": 1, "BEHAVIOR": "F1", "FTYPE_ID": 5, "CANDIDATE_CAP": 10}
+	],
+	"CFG_GPLAN": [
+		{"GPLAN_ID": 1, "GPLAN_CODE": "INGEST"}
+	],
+	"CONFIG_BASE_VERSION": {"VERSION": "4.0.0", "COMPATIBILITY_VERSION": {"CONFIG_VERSION": "10"}}
+}}`
+
+const newConfig = `{"G2_CONFIG": {
+	"CFG_ATTR": [
+		{"ATTR_ID": 1001, "ATTR_CODE": "NAME_FULL", "FTYPE_CODE": "NAME", "FELEM_REQ": "Any"}
+	],
+	"CFG_DSRC": [
+		{"DSRC_ID": 1, "DSRC_CODE": "TEST"},
+		{"DSRC_ID": 1001, "DSRC_CODE": "CUSTOMERS"}
+	],
+	"CFG_FTYPE": [
+		{"FTYPE_ID": 1, "FTYPE_CODE": "NAME"},
+		{"FTYPE_ID": 7, "FTYPE_CODE": "DOB"}
+	],
+	"CFG_GENERIC_THRESHOLD": [
+		{"GPLAN_ID": 1, "BEHAVIOR": "NAME", "FTYPE_ID": 0, "CANDIDATE_CAP": 10},
+		{"GPLAN_ID": 1, "BEHAVIOR": "F1", "FTYPE_ID": 7, "CANDIDATE_CAP": 20}
+	],
+	"CFG_GPLAN": [
+		{"GPLAN_ID": 1, "GPLAN_CODE": "INGEST"}
+	],
+	"CONFIG_BASE_VERSION": {"VERSION": "4.0.0", "COMPATIBILITY_VERSION": {"CONFIG_VERSION": "11"}}
+}}`
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestCompare(test *testing.T) {
+	test.Parallel()
+
+	diff, err := szconfigdiff.Compare(oldConfig, newConfig)
+	require.NoError(test, err)
+	assert.Equal(test, []string{"CUSTOMERS"}, diff.AddedDataSources)
+	assert.Equal(test, []string{"SEARCH"}, diff.RemovedDataSources)
+
+	expected := []szconfigdiff.Change{
+		{Key: "CUSTOMERS", Kind: szconfigdiff.KindAdded, Section: "CFG_DSRC"},
+		{Key: "SEARCH", Kind: szconfigdiff.KindRemoved, Section: "CFG_DSRC"},
+		{
+			Fields:  []szconfigdiff.FieldChange{{Field: "FELEM_REQ", New: "Any", Old: "No"}},
+			Key:     "NAME_FULL",
+			Kind:    szconfigdiff.KindChanged,
+			Section: "CFG_ATTR",
+		},
+		{Key: "NAME_ORG", Kind: szconfigdiff.KindRemoved, Section: "CFG_ATTR"},
+		{
+			Fields:  []szconfigdiff.FieldChange{{Field: "FTYPE_ID", New: json.Number("7"), Old: json.Number("5")}},
+			Key:     "DOB",
+			Kind:    szconfigdiff.KindChanged,
+			Section: "CFG_FTYPE",
+		},
+		{
+			Fields: []szconfigdiff.FieldChange{
+				{Field: "CANDIDATE_CAP", New: json.Number("20"), Old: json.Number("10")},
+			},
+			Key:     "GPLAN=INGEST BEHAVIOR=F1 FTYPE=DOB",
+			Kind:    szconfigdiff.KindChanged,
+			Section: "CFG_GENERIC_THRESHOLD",
+		},
+		{
+			Fields: []szconfigdiff.FieldChange{
+				{Field: "COMPATIBILITY_VERSION.CONFIG_VERSION", New: "11", Old: "10"},
+			},
+			Key:     "",
+			Kind:    szconfigdiff.KindChanged,
+			Section: "CONFIG_BASE_VERSION",
+		},
+	}
+	assert.Equal(test, expected, diff.Changes)
+}
+
+func TestCompare_functionCalls(test *testing.T) {
+	test.Parallel()
+
+	const calls = `{"G2_CONFIG": {
+		"CFG_CFBOM": [{"CFCALL_ID": %[1]d, "FTYPE_ID": 1, "FELEM_ID": 2, "EXEC_ORDER": %[2]d}],
+		"CFG_CFCALL": [{"CFCALL_ID": %[1]d, "FTYPE_ID": 1, "CFUNC_ID": 3, "EXEC_ORDER": 1}],
+		"CFG_CFUNC": [{"CFUNC_ID": 3, "CFUNC_CODE": "CMP_NAME"}],
+		"CFG_FELEM": [{"FELEM_ID": 2, "FELEM_CODE": "FULL_NAME"}],
+		"CFG_FTYPE": [{"FTYPE_ID": 1, "FTYPE_CODE": "NAME"}]
+	}}`
+
+	diff, err := szconfigdiff.Compare(fmt.Sprintf(calls, 1, 1), fmt.Sprintf(calls, 1001, 2))
+	require.NoError(test, err)
+
+	expected := []szconfigdiff.Change{
+		{
+			Fields:  []szconfigdiff.FieldChange{{Field: "EXEC_ORDER", New: json.Number("2"), Old: json.Number("1")}},
+			Key:     "CFCALL=(FTYPE=NAME CFUNC=CMP_NAME) FTYPE=NAME FELEM=FULL_NAME",
+			Kind:    szconfigdiff.KindChanged,
+			Section: "CFG_CFBOM",
+		},
+		{
+			Fields:  []szconfigdiff.FieldChange{{Field: "CFCALL_ID", New: json.Number("1001"), Old: json.Number("1")}},
+			Key:     "FTYPE=NAME CFUNC=CMP_NAME",
+			Kind:    szconfigdiff.KindChanged,
+			Section: "CFG_CFCALL",
+		},
+	}
+	assert.Equal(test, expected, diff.Changes, "calls are matched by what they call, not by ID")
+}
+
+func TestCompare_identical(test *testing.T) {
+	test.Parallel()
+
+	diff, err := szconfigdiff.Compare(oldConfig, oldConfig)
+	require.NoError(test, err)
+	assert.True(test, diff.IsEmpty())
+	assert.Equal(test, "No changes.\n", diff.String())
+}
+
+func TestCompare_badConfig(test *testing.T) {
+	test.Parallel()
+
+	_, err := szconfigdiff.Compare(oldConfig, `{"CFG_DSRC": []}`)
+	require.ErrorContains(test, err, "G2_CONFIG")
+
+	_, err = szconfigdiff.Compare("{", oldConfig)
+	require.ErrorContains(test, err, "old configuration")
+}
+
+func TestCompareConfigIDs(test *testing.T) {
+	test.Parallel()
+
+	manager := &fakeConfigManager{definitions: map[int64]string{1: oldConfig, 2: newConfig}}
+	diff, err := szconfigdiff.CompareConfigIDs(test.Context(), manager, 1, 2)
+	require.NoError(test, err)
+	assert.Equal(test, []string{"CUSTOMERS"}, diff.AddedDataSources)
+}
+
+func TestDiff_Write(test *testing.T) {
+	test.Parallel()
+
+	diff, err := szconfigdiff.Compare(oldConfig, newConfig)
+	require.NoError(test, err)
+
+	text := diff.String()
+	assert.Contains(test, text, "Data sources (CFG_DSRC)\n  + CUSTOMERS\n  - SEARCH\n")
+	assert.Contains(test, text, "  ~ NAME_FULL\n      FELEM_REQ: \"No\" -> \"Any\"\n")
+
+	var markdown bytes.Buffer
+	require.NoError(test, diff.Write(&markdown, szconfigdiff.FormatMarkdown))
+	assert.Contains(test, markdown.String(), "## Attributes (CFG_ATTR)\n\n| Change | Key | Field | Old | New |\n")
+	assert.Contains(test, markdown.String(), "| ~ | NAME_FULL | FELEM_REQ | No | Any |\n")
+	assert.Contains(test, markdown.String(), "| - | SEARCH | | | |\n\n")
+
+	var document bytes.Buffer
+	require.NoError(test, diff.Write(&document, szconfigdiff.FormatJSON))
+
+	var decoded szconfigdiff.Diff
+	require.NoError(test, json.Unmarshal(document.Bytes(), &decoded))
+	assert.Equal(test, diff.AddedDataSources, decoded.AddedDataSources)
+	assert.Len(test, decoded.Changes, len(diff.Changes))
+
+	require.Error(test, diff.Write(&document, szconfigdiff.Format(99)))
+}
+
+func TestFormat_String(test *testing.T) {
+	test.Parallel()
+	assert.Equal(test, "MARKDOWN", szconfigdiff.FormatMarkdown.String())
+	assert.Equal(test, "Format(99)", szconfigdiff.Format(99).String())
+}
+
+// ----------------------------------------------------------------------------
+// Fakes
+// ----------------------------------------------------------------------------
+
+type fakeConfig struct {
+	senzing.SzConfig
+
+	definition string
+}
+
+func (config *fakeConfig) Export(ctx context.Context) (string, error) {
+	_ = ctx
+
+	return config.definition, nil
+}
+
+type fakeConfigManager struct {
+	definitions map[int64]string
+}
+
+func (manager *fakeConfigManager) CreateConfigFromConfigID(
+	ctx context.Context,
+	configID int64,
+) (senzing.SzConfig, error) {
+	_ = ctx
+
+	return &fakeConfig{definition: manager.definitions[configID]}, nil
+}
@@ -0,0 +1,29 @@
+/*
+Package szconfigdoc edits a Senzing configuration JSON document without the Senzing library.
+
+The Senzing configuration API only registers and unregisters data sources.
+A [Document] adds typed accessors and mutators for other sections:
+CFG_ATTR ([Attribute]), CFG_DSRC ([DataSource]), CFG_FTYPE and CFG_FBOM ([FeatureType]),
+CFG_GPLAN ([GenericPlan]) and CFG_GENERIC_THRESHOLD ([GenericThreshold]).
+Sections and fields without accessors are kept as they are.
+
+Entries are identified by their codes. A mutator checks that the entries it refers to exist,
+and a delete is refused while other entries refer to the deleted one.
+[Document.Export] checks the referential integrity of the whole document,
+then verifies it with the Senzing library before returning it:
+
+	document, err := szconfigdoc.Load(ctx, szConfig)
+	...
+	_, err = document.SetAttribute(szconfigdoc.Attribute{
+		Class:       "IDENTIFIER",
+		Code:        "LOYALTY_NUMBER",
+		Element:     "ID_NUM",
+		FeatureType: "ACCOUNT",
+		Required:    "Yes",
+	})
+	...
+	configDefinition, err := document.Export(ctx, szConfig)
+	...
+	configID, err := szConfigManager.RegisterConfig(ctx, configDefinition, "Add LOYALTY_NUMBER")
+*/
+package szconfigdoc
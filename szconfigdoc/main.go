@@ -0,0 +1,156 @@
+package szconfigdoc
+
+import (
+	"context"
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Attribute struct is an entry of CFG_ATTR: a JSON attribute of a record definition
+and the feature element it is mapped to.
+*/
+type Attribute struct {
+	Class        string // ATTR_CLASS. Example: "IDENTIFIER".
+	Code         string // ATTR_CODE. Example: "NAME_FULL".
+	DefaultValue string // DEFAULT_VALUE. Empty for none.
+	Element      string // FELEM_CODE, in CFG_FELEM. Example: "FULL_NAME". Empty for none.
+	FeatureType  string // FTYPE_CODE, in CFG_FTYPE. Example: "NAME". Empty for none.
+	ID           int64  // ATTR_ID. Zero assigns the next free ID.
+	Internal     bool   // INTERNAL.
+	Required     string // FELEM_REQ: "Yes", "No" or "Any". Empty means "No".
+}
+
+/*
+Type DataSource struct is an entry of CFG_DSRC.
+*/
+type DataSource struct {
+	Code           string // DSRC_CODE. Example: "CUSTOMERS".
+	Description    string // DSRC_DESC. Empty means Code.
+	ID             int64  // DSRC_ID. Zero assigns the next free ID.
+	RetentionLevel string // RETENTION_LEVEL: "Remember" or "Forget". Empty means "Remember".
+}
+
+/*
+Type Document struct is a Senzing configuration JSON document being edited.
+Sections and fields without typed accessors are kept as they are.
+*/
+type Document struct {
+	config   map[string]any // The G2_CONFIG object.
+	document map[string]any
+}
+
+/*
+Type FeatureType struct is an entry of CFG_FTYPE and, in Elements, its entries of CFG_FBOM.
+*/
+type FeatureType struct {
+	Anonymize         bool     // ANONYMIZE.
+	Class             string   // FCLASS_CODE of FCLASS_ID, in CFG_FCLASS. Example: "ISSUED_ID".
+	Code              string   // FTYPE_CODE. Example: "PASSPORT".
+	Derived           bool     // DERIVED.
+	Description       string   // FTYPE_DESC. Empty means Code.
+	Elements          []string // FELEM_CODE of the CFG_FBOM entries, in CFG_FELEM, in EXEC_ORDER.
+	Exclusive         bool     // FTYPE_EXCL.
+	Frequency         string   // FTYPE_FREQ. Example: "F1".
+	ID                int64    // FTYPE_ID. Zero assigns the next free ID.
+	PersistHistory    bool     // PERSIST_HISTORY.
+	ShowInMatchKey    bool     // SHOW_IN_MATCH_KEY.
+	Stable            bool     // FTYPE_STAB.
+	UsedForCandidates bool     // USED_FOR_CAND.
+	Version           int64    // VERSION.
+}
+
+/*
+Type GenericPlan struct is an entry of CFG_GPLAN. Example: "INGEST" or "SEARCH".
+*/
+type GenericPlan struct {
+	Code        string // GPLAN_CODE.
+	Description string // GPLAN_DESC. Empty means Code.
+	ID          int64  // GPLAN_ID. Zero assigns the next free ID.
+}
+
+/*
+Type GenericThreshold struct is an entry of CFG_GENERIC_THRESHOLD, identified by Plan, Behavior and FeatureType.
+*/
+type GenericThreshold struct {
+	Behavior     string // BEHAVIOR. Example: "NAME" or "F1".
+	CandidateCap int64  // CANDIDATE_CAP.
+	FeatureType  string // FTYPE_CODE of FTYPE_ID, in CFG_FTYPE. Empty for all feature types (FTYPE_ID 0).
+	Plan         string // GPLAN_CODE of GPLAN_ID, in CFG_GPLAN.
+	ScoringCap   int64  // SCORING_CAP. -1 for no cap.
+	SendToRedo   bool   // SEND_TO_REDO.
+}
+
+/*
+Type Verifier interface checks a configuration definition with the Senzing library.
+Any *szconfig.Szconfig satisfies it.
+*/
+type Verifier interface {
+	VerifyConfigDefinition(ctx context.Context, configDefinition string) error
+}
+
+// A field holding the ID, or the code, of an entry of another section.
+type reference struct {
+	field        string // Example: "EFEAT_FTYPE_ID".
+	section      string // Example: "CFG_FTYPE".
+	sectionField string // Example: "FTYPE_ID".
+}
+
+// An entry of a section: field name to value, with numbers as json.Number.
+type row = map[string]any
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	attributeSection        = "CFG_ATTR"
+	dataSourceSection       = "CFG_DSRC"
+	elementSection          = "CFG_FELEM"
+	featureClassSection     = "CFG_FCLASS"
+	featureElementSection   = "CFG_FBOM"
+	featureTypeSection      = "CFG_FTYPE"
+	genericPlanSection      = "CFG_GPLAN"
+	genericThresholdSection = "CFG_GENERIC_THRESHOLD"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+/*
+The Err* variables are wrapped by the errors of a Document. Use errors.Is to test for them.
+
+  - ErrBadInput: A required field is missing or has a wrong value.
+  - ErrIntegrity: An entry refers to an entry that does not exist, or is referred to by an entry being deleted.
+  - ErrNotFound: The entry to delete does not exist.
+*/
+var (
+	ErrBadInput  = errors.New("bad input")
+	ErrIntegrity = errors.New("referential integrity")
+	ErrNotFound  = errors.New("not found")
+)
+
+var errNoConfig = errors.New(`not a Senzing configuration; "G2_CONFIG" is missing`)
+
+// Elements an attribute may be mapped to without a CFG_FELEM entry: when a feature was used, and how.
+var usageElements = map[string]bool{
+	"USAGE_TYPE":   true,
+	"USED_FROM_DT": true,
+	"USED_THRU_DT": true,
+}
+
+// Fields referring to another section. An ID of 0 or less refers to no entry, e.g. FTYPE_ID 0 for all feature types.
+var references = []reference{
+	{field: "DSRC_ID", section: dataSourceSection, sectionField: "DSRC_ID"},
+	{field: "EFEAT_FTYPE_ID", section: featureTypeSection, sectionField: "FTYPE_ID"},
+	{field: "FCLASS_ID", section: featureClassSection, sectionField: "FCLASS_ID"},
+	{field: "FELEM_CODE", section: elementSection, sectionField: "FELEM_CODE"},
+	{field: "FELEM_ID", section: elementSection, sectionField: "FELEM_ID"},
+	{field: "FTYPE_CODE", section: featureTypeSection, sectionField: "FTYPE_CODE"},
+	{field: "FTYPE_ID", section: featureTypeSection, sectionField: "FTYPE_ID"},
+	{field: "GPLAN_ID", section: genericPlanSection, sectionField: "GPLAN_ID"},
+}
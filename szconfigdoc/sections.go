@@ -0,0 +1,533 @@
+package szconfigdoc
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// ----------------------------------------------------------------------------
+// CFG_ATTR
+// ----------------------------------------------------------------------------
+
+/*
+Method Attribute returns the attribute with the code, if any.
+*/
+func (document *Document) Attribute(code string) (Attribute, bool) {
+	_, entry := document.find(attributeSection, "ATTR_CODE", normalize(code))
+	if entry == nil {
+		return Attribute{}, false
+	}
+
+	return attributeOf(entry), true
+}
+
+/*
+Method Attributes returns the attributes, in configuration order.
+*/
+func (document *Document) Attributes() []Attribute {
+	result := []Attribute{}
+	for _, entry := range document.rows(attributeSection) {
+		result = append(result, attributeOf(entry))
+	}
+
+	return result
+}
+
+/*
+Method DeleteAttribute deletes the attribute with the code.
+*/
+func (document *Document) DeleteAttribute(code string) error {
+	return document.remove(attributeSection, "ATTR_CODE", code)
+}
+
+/*
+Method SetAttribute adds the attribute or, if one with the same code exists, updates it.
+The feature type and element must exist.
+
+Output
+  - The ATTR_ID.
+*/
+func (document *Document) SetAttribute(attribute Attribute) (int64, error) {
+	code := normalize(attribute.Code)
+	featureType := normalize(attribute.FeatureType)
+	element := normalize(attribute.Element)
+	required := cmp.Or(attribute.Required, "No")
+
+	switch {
+	case code == "":
+		return 0, fmt.Errorf("%w: attribute code is empty", ErrBadInput)
+	case attribute.Class == "":
+		return 0, fmt.Errorf("%w: attribute %s has no class", ErrBadInput, code)
+	case element != "" && featureType == "":
+		return 0, fmt.Errorf("%w: attribute %s has an element but no feature type", ErrBadInput, code)
+	case !slices.Contains([]string{"Any", "No", "Yes"}, required):
+		return 0, fmt.Errorf("%w: attribute %s: FELEM_REQ %q is not Yes, No or Any", ErrBadInput, code, required)
+	}
+
+	if _, isFound := document.lookup(featureTypeSection, "FTYPE_CODE", featureType, "FTYPE_ID"); featureType != "" &&
+		!isFound {
+		return 0, fmt.Errorf("%w: attribute %s refers to unknown feature type %s", ErrIntegrity, code, featureType)
+	}
+
+	if _, isFound := document.lookup(elementSection, "FELEM_CODE", element, "FELEM_ID"); element != "" && !isFound &&
+		!usageElements[element] {
+		return 0, fmt.Errorf("%w: attribute %s refers to unknown element %s", ErrIntegrity, code, element)
+	}
+
+	return document.upsert(attributeSection, "ATTR_CODE", "ATTR_ID", attribute.ID, row{
+		"ATTR_CLASS":    normalize(attribute.Class),
+		"ATTR_CODE":     code,
+		"DEFAULT_VALUE": nullable(attribute.DefaultValue),
+		"FELEM_CODE":    nullable(element),
+		"FELEM_REQ":     required,
+		"FTYPE_CODE":    nullable(featureType),
+		"INTERNAL":      yesNo(attribute.Internal),
+	})
+}
+
+// ----------------------------------------------------------------------------
+// CFG_DSRC
+// ----------------------------------------------------------------------------
+
+/*
+Method DataSource returns the data source with the code, if any.
+*/
+func (document *Document) DataSource(code string) (DataSource, bool) {
+	_, entry := document.find(dataSourceSection, "DSRC_CODE", normalize(code))
+	if entry == nil {
+		return DataSource{}, false
+	}
+
+	return dataSourceOf(entry), true
+}
+
+/*
+Method DataSources returns the data sources, in configuration order.
+*/
+func (document *Document) DataSources() []DataSource {
+	result := []DataSource{}
+	for _, entry := range document.rows(dataSourceSection) {
+		result = append(result, dataSourceOf(entry))
+	}
+
+	return result
+}
+
+/*
+Method DeleteDataSource deletes the data source with the code, unless another section refers to it.
+*/
+func (document *Document) DeleteDataSource(code string) error {
+	return document.remove(dataSourceSection, "DSRC_CODE", code)
+}
+
+/*
+Method SetDataSource adds the data source or, if one with the same code exists, updates it.
+
+Output
+  - The DSRC_ID.
+*/
+func (document *Document) SetDataSource(dataSource DataSource) (int64, error) {
+	code := normalize(dataSource.Code)
+	retentionLevel := cmp.Or(dataSource.RetentionLevel, "Remember")
+
+	switch {
+	case code == "":
+		return 0, fmt.Errorf("%w: data source code is empty", ErrBadInput)
+	case retentionLevel != "Remember" && retentionLevel != "Forget":
+		return 0, fmt.Errorf("%w: data source %s: RETENTION_LEVEL %q is not Remember or Forget",
+			ErrBadInput, code, retentionLevel)
+	}
+
+	return document.upsert(dataSourceSection, "DSRC_CODE", "DSRC_ID", dataSource.ID, row{
+		"DSRC_CODE":       code,
+		"DSRC_DESC":       cmp.Or(dataSource.Description, code),
+		"RETENTION_LEVEL": retentionLevel,
+	})
+}
+
+// ----------------------------------------------------------------------------
+// CFG_FTYPE and CFG_FBOM
+// ----------------------------------------------------------------------------
+
+/*
+Method DeleteFeatureType deletes the feature type with the code and its CFG_FBOM entries,
+unless another section, such as CFG_ATTR, refers to it.
+*/
+func (document *Document) DeleteFeatureType(code string) error {
+	_, entry := document.find(featureTypeSection, "FTYPE_CODE", normalize(code))
+
+	err := document.remove(featureTypeSection, "FTYPE_CODE", code, featureElementSection)
+	if err != nil {
+		return err
+	}
+
+	document.setElements(text(entry, "FTYPE_ID"), nil)
+
+	return nil
+}
+
+/*
+Method FeatureType returns the feature type with the code, if any.
+*/
+func (document *Document) FeatureType(code string) (FeatureType, bool) {
+	_, entry := document.find(featureTypeSection, "FTYPE_CODE", normalize(code))
+	if entry == nil {
+		return FeatureType{}, false
+	}
+
+	return document.featureTypeOf(entry), true
+}
+
+/*
+Method FeatureTypes returns the feature types, in configuration order.
+*/
+func (document *Document) FeatureTypes() []FeatureType {
+	result := []FeatureType{}
+	for _, entry := range document.rows(featureTypeSection) {
+		result = append(result, document.featureTypeOf(entry))
+	}
+
+	return result
+}
+
+/*
+Method SetFeatureType adds the feature type or, if one with the same code exists, updates it.
+The class and elements must exist. CFG_FBOM entries of elements kept keep their display settings.
+
+Output
+  - The FTYPE_ID.
+*/
+func (document *Document) SetFeatureType(featureType FeatureType) (int64, error) {
+	code := normalize(featureType.Code)
+
+	switch {
+	case code == "":
+		return 0, fmt.Errorf("%w: feature type code is empty", ErrBadInput)
+	case featureType.Frequency == "":
+		return 0, fmt.Errorf("%w: feature type %s has no frequency", ErrBadInput, code)
+	case len(featureType.Elements) == 0:
+		return 0, fmt.Errorf("%w: feature type %s has no elements", ErrBadInput, code)
+	}
+
+	classID, isFound := document.lookup(featureClassSection, "FCLASS_CODE", normalize(featureType.Class), "FCLASS_ID")
+	if !isFound {
+		return 0, fmt.Errorf("%w: feature type %s refers to unknown class %q", ErrIntegrity, code, featureType.Class)
+	}
+
+	elementIDs := []string{}
+
+	for _, element := range featureType.Elements {
+		elementID, isFound := document.lookup(elementSection, "FELEM_CODE", normalize(element), "FELEM_ID")
+		if !isFound {
+			return 0, fmt.Errorf("%w: feature type %s refers to unknown element %s", ErrIntegrity, code, element)
+		}
+
+		if slices.Contains(elementIDs, elementID) {
+			return 0, fmt.Errorf("%w: feature type %s lists element %s twice", ErrBadInput, code, element)
+		}
+
+		elementIDs = append(elementIDs, elementID)
+	}
+
+	fields := row{
+		"ANONYMIZE":         yesNo(featureType.Anonymize),
+		"DERIVED":           yesNo(featureType.Derived),
+		"FCLASS_ID":         json.Number(classID),
+		"FTYPE_CODE":        code,
+		"FTYPE_DESC":        cmp.Or(featureType.Description, code),
+		"FTYPE_EXCL":        yesNo(featureType.Exclusive),
+		"FTYPE_FREQ":        featureType.Frequency,
+		"FTYPE_STAB":        yesNo(featureType.Stable),
+		"PERSIST_HISTORY":   yesNo(featureType.PersistHistory),
+		"SHOW_IN_MATCH_KEY": yesNo(featureType.ShowInMatchKey),
+		"USED_FOR_CAND":     yesNo(featureType.UsedForCandidates),
+		"VERSION":           jsonNumber(max(featureType.Version, 1)),
+	}
+
+	if _, entry := document.find(featureTypeSection, "FTYPE_CODE", code); entry == nil {
+		fields["RTYPE_ID"] = jsonNumber(0)
+	}
+
+	featureTypeID, err := document.upsert(featureTypeSection, "FTYPE_CODE", "FTYPE_ID", featureType.ID, fields)
+	if err != nil {
+		return 0, err
+	}
+
+	document.setElements(strconv.FormatInt(featureTypeID, 10), elementIDs)
+
+	return featureTypeID, nil
+}
+
+// ----------------------------------------------------------------------------
+// CFG_GPLAN
+// ----------------------------------------------------------------------------
+
+/*
+Method DeleteGenericPlan deletes the generic plan with the code, unless a generic threshold refers to it.
+*/
+func (document *Document) DeleteGenericPlan(code string) error {
+	return document.remove(genericPlanSection, "GPLAN_CODE", code)
+}
+
+/*
+Method GenericPlan returns the generic plan with the code, if any.
+*/
+func (document *Document) GenericPlan(code string) (GenericPlan, bool) {
+	_, entry := document.find(genericPlanSection, "GPLAN_CODE", normalize(code))
+	if entry == nil {
+		return GenericPlan{}, false
+	}
+
+	return genericPlanOf(entry), true
+}
+
+/*
+Method GenericPlans returns the generic plans, in configuration order.
+*/
+func (document *Document) GenericPlans() []GenericPlan {
+	result := []GenericPlan{}
+	for _, entry := range document.rows(genericPlanSection) {
+		result = append(result, genericPlanOf(entry))
+	}
+
+	return result
+}
+
+/*
+Method SetGenericPlan adds the generic plan or, if one with the same code exists, updates it.
+
+Output
+  - The GPLAN_ID.
+*/
+func (document *Document) SetGenericPlan(genericPlan GenericPlan) (int64, error) {
+	code := normalize(genericPlan.Code)
+	if code == "" {
+		return 0, fmt.Errorf("%w: generic plan code is empty", ErrBadInput)
+	}
+
+	return document.upsert(genericPlanSection, "GPLAN_CODE", "GPLAN_ID", genericPlan.ID, row{
+		"GPLAN_CODE": code,
+		"GPLAN_DESC": cmp.Or(genericPlan.Description, code),
+	})
+}
+
+// ----------------------------------------------------------------------------
+// CFG_GENERIC_THRESHOLD
+// ----------------------------------------------------------------------------
+
+/*
+Method DeleteGenericThreshold deletes the generic threshold of a plan, behavior and feature type.
+An empty feature type is the threshold for all feature types.
+*/
+func (document *Document) DeleteGenericThreshold(plan string, behavior string, featureType string) error {
+	index, err := document.findThreshold(plan, behavior, featureType)
+	if err != nil {
+		return err
+	}
+
+	if index < 0 {
+		return fmt.Errorf("%w: generic threshold %s %s %s", ErrNotFound, normalize(plan), normalize(behavior),
+			normalize(featureType))
+	}
+
+	document.setRows(genericThresholdSection, slices.Delete(document.rows(genericThresholdSection), index, index+1))
+
+	return nil
+}
+
+/*
+Method GenericThresholds returns the generic thresholds, in configuration order.
+*/
+func (document *Document) GenericThresholds() []GenericThreshold {
+	result := []GenericThreshold{}
+
+	for _, entry := range document.rows(genericThresholdSection) {
+		plan, _ := document.lookup(genericPlanSection, "GPLAN_ID", text(entry, "GPLAN_ID"), "GPLAN_CODE")
+		featureType, _ := document.lookup(featureTypeSection, "FTYPE_ID", text(entry, "FTYPE_ID"), "FTYPE_CODE")
+
+		result = append(result, GenericThreshold{
+			Behavior:     text(entry, "BEHAVIOR"),
+			CandidateCap: number(entry, "CANDIDATE_CAP"),
+			FeatureType:  featureType,
+			Plan:         plan,
+			ScoringCap:   number(entry, "SCORING_CAP"),
+			SendToRedo:   flag(entry, "SEND_TO_REDO"),
+		})
+	}
+
+	return result
+}
+
+/*
+Method SetGenericThreshold adds the generic threshold or, if one with the same plan, behavior
+and feature type exists, updates it. The plan and feature type must exist.
+*/
+func (document *Document) SetGenericThreshold(threshold GenericThreshold) error {
+	if normalize(threshold.Behavior) == "" {
+		return fmt.Errorf("%w: generic threshold of plan %s has no behavior", ErrBadInput, normalize(threshold.Plan))
+	}
+
+	index, err := document.findThreshold(threshold.Plan, threshold.Behavior, threshold.FeatureType)
+	if err != nil {
+		return err
+	}
+
+	fields := row{
+		"CANDIDATE_CAP": jsonNumber(threshold.CandidateCap),
+		"SCORING_CAP":   jsonNumber(threshold.ScoringCap),
+		"SEND_TO_REDO":  yesNo(threshold.SendToRedo),
+	}
+
+	if index >= 0 {
+		for field, value := range fields {
+			document.rows(genericThresholdSection)[index][field] = value
+		}
+
+		return nil
+	}
+
+	planID, _ := document.lookup(genericPlanSection, "GPLAN_CODE", normalize(threshold.Plan), "GPLAN_ID")
+	featureTypeID, _ := document.lookup(featureTypeSection, "FTYPE_CODE", normalize(threshold.FeatureType), "FTYPE_ID")
+	fields["BEHAVIOR"] = normalize(threshold.Behavior)
+	fields["FTYPE_ID"] = json.Number(cmp.Or(featureTypeID, "0"))
+	fields["GPLAN_ID"] = json.Number(planID)
+	document.setRows(genericThresholdSection, append(document.rows(genericThresholdSection), fields))
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (document *Document) featureTypeOf(entry row) FeatureType {
+	class, _ := document.lookup(featureClassSection, "FCLASS_ID", text(entry, "FCLASS_ID"), "FCLASS_CODE")
+
+	elements := []row{}
+	for _, element := range document.rows(featureElementSection) {
+		if text(element, "FTYPE_ID") == text(entry, "FTYPE_ID") {
+			elements = append(elements, element)
+		}
+	}
+
+	slices.SortStableFunc(elements, func(a row, b row) int {
+		return cmp.Compare(number(a, "EXEC_ORDER"), number(b, "EXEC_ORDER"))
+	})
+
+	elementCodes := []string{}
+
+	for _, element := range elements {
+		elementCode, _ := document.lookup(elementSection, "FELEM_ID", text(element, "FELEM_ID"), "FELEM_CODE")
+		elementCodes = append(elementCodes, elementCode)
+	}
+
+	return FeatureType{
+		Anonymize:         flag(entry, "ANONYMIZE"),
+		Class:             class,
+		Code:              text(entry, "FTYPE_CODE"),
+		Derived:           flag(entry, "DERIVED"),
+		Description:       text(entry, "FTYPE_DESC"),
+		Elements:          elementCodes,
+		Exclusive:         flag(entry, "FTYPE_EXCL"),
+		Frequency:         text(entry, "FTYPE_FREQ"),
+		ID:                number(entry, "FTYPE_ID"),
+		PersistHistory:    flag(entry, "PERSIST_HISTORY"),
+		ShowInMatchKey:    flag(entry, "SHOW_IN_MATCH_KEY"),
+		Stable:            flag(entry, "FTYPE_STAB"),
+		UsedForCandidates: flag(entry, "USED_FOR_CAND"),
+		Version:           number(entry, "VERSION"),
+	}
+}
+
+// The index of a generic threshold, or -1. The plan and feature type must exist.
+func (document *Document) findThreshold(plan string, behavior string, featureType string) (int, error) {
+	planID, isFound := document.lookup(genericPlanSection, "GPLAN_CODE", normalize(plan), "GPLAN_ID")
+	if !isFound {
+		return -1, fmt.Errorf("%w: generic threshold refers to unknown plan %q", ErrIntegrity, plan)
+	}
+
+	featureTypeID := "0"
+
+	if featureType != "" {
+		featureTypeID, isFound = document.lookup(featureTypeSection, "FTYPE_CODE", normalize(featureType), "FTYPE_ID")
+		if !isFound {
+			return -1, fmt.Errorf("%w: generic threshold refers to unknown feature type %s",
+				ErrIntegrity, normalize(featureType))
+		}
+	}
+
+	return slices.IndexFunc(document.rows(genericThresholdSection), func(entry row) bool {
+		return text(entry, "GPLAN_ID") == planID &&
+			text(entry, "BEHAVIOR") == normalize(behavior) &&
+			text(entry, "FTYPE_ID") == featureTypeID
+	}), nil
+}
+
+// Replace the CFG_FBOM entries of a feature type, keeping those of elements kept.
+func (document *Document) setElements(featureTypeID string, elementIDs []string) {
+	kept := []row{}
+	current := map[string]row{}
+
+	for _, entry := range document.rows(featureElementSection) {
+		if text(entry, "FTYPE_ID") == featureTypeID {
+			current[text(entry, "FELEM_ID")] = entry
+		} else {
+			kept = append(kept, entry)
+		}
+	}
+
+	for index, elementID := range elementIDs {
+		entry, isCurrent := current[elementID]
+		if !isCurrent {
+			entry = row{
+				"DERIVED":       "No",
+				"DISPLAY_DELIM": nil,
+				"DISPLAY_LEVEL": jsonNumber(1),
+				"FELEM_ID":      json.Number(elementID),
+				"FTYPE_ID":      json.Number(featureTypeID),
+			}
+		}
+
+		entry["EXEC_ORDER"] = jsonNumber(int64(index + 1))
+		kept = append(kept, entry)
+	}
+
+	document.setRows(featureElementSection, kept)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func attributeOf(entry row) Attribute {
+	return Attribute{
+		Class:        text(entry, "ATTR_CLASS"),
+		Code:         text(entry, "ATTR_CODE"),
+		DefaultValue: text(entry, "DEFAULT_VALUE"),
+		Element:      text(entry, "FELEM_CODE"),
+		FeatureType:  text(entry, "FTYPE_CODE"),
+		ID:           number(entry, "ATTR_ID"),
+		Internal:     flag(entry, "INTERNAL"),
+		Required:     text(entry, "FELEM_REQ"),
+	}
+}
+
+func dataSourceOf(entry row) DataSource {
+	return DataSource{
+		Code:           text(entry, "DSRC_CODE"),
+		Description:    text(entry, "DSRC_DESC"),
+		ID:             number(entry, "DSRC_ID"),
+		RetentionLevel: text(entry, "RETENTION_LEVEL"),
+	}
+}
+
+func genericPlanOf(entry row) GenericPlan {
+	return GenericPlan{
+		Code:        text(entry, "GPLAN_CODE"),
+		Description: text(entry, "GPLAN_DESC"),
+		ID:          number(entry, "GPLAN_ID"),
+	}
+}
@@ -0,0 +1,399 @@
+package szconfigdoc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Load function parses the configuration definition of a senzing.SzConfig.
+
+Input
+  - ctx: A context to control lifecycle.
+  - config: The configuration to edit.
+
+Output
+  - The configuration document.
+*/
+func Load(ctx context.Context, config senzing.SzConfig) (*Document, error) {
+	configDefinition, err := config.Export(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return Parse(configDefinition)
+}
+
+/*
+The Parse function parses a configuration definition. Numbers are kept as written.
+
+Input
+  - configDefinition: A Senzing configuration JSON document.
+
+Output
+  - The configuration document.
+*/
+func Parse(configDefinition string) (*Document, error) {
+	var document map[string]any
+
+	decoder := json.NewDecoder(strings.NewReader(configDefinition))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&document)
+	if err != nil {
+		return nil, fmt.Errorf("parsing configuration: %w", err)
+	}
+
+	config, isObject := document["G2_CONFIG"].(map[string]any)
+	if !isObject {
+		return nil, errNoConfig
+	}
+
+	return &Document{config: config, document: document}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Check verifies the referential integrity of the whole configuration:
+every ID or code referring to another section, such as FTYPE_ID or FELEM_CODE, must exist there.
+
+Output
+  - nil, or the joined errors, each wrapping ErrIntegrity.
+*/
+func (document *Document) Check() error {
+	var errs []error
+
+	known := map[reference]map[string]bool{}
+
+	for _, section := range document.sections() {
+		for index, entry := range document.rows(section) {
+			for _, target := range references {
+				value, isReference := referenceValue(section, entry, target)
+				if !isReference || (section == attributeSection && target.field == "FELEM_CODE" && usageElements[value]) {
+					continue
+				}
+
+				if _, isIndexed := known[target]; !isIndexed {
+					known[target] = document.values(target.section, target.sectionField)
+				}
+
+				if !known[target][value] {
+					errs = append(errs, fmt.Errorf("%w: %s refers to unknown %s %s",
+						ErrIntegrity, describe(section, index, entry), target.field, value))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+/*
+Method Export checks the document, then verifies it with the Senzing library.
+
+Input
+  - ctx: A context to control lifecycle.
+  - verifier: Usually the *szconfig.Szconfig the document was loaded from.
+
+Output
+  - The configuration definition, for szconfigmanager.RegisterConfig() or szconfig.Import().
+*/
+func (document *Document) Export(ctx context.Context, verifier Verifier) (string, error) {
+	err := document.Check()
+	if err != nil {
+		return "", err
+	}
+
+	configDefinition, err := document.JSON()
+	if err != nil {
+		return "", err
+	}
+
+	err = verifier.VerifyConfigDefinition(ctx, configDefinition)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	return configDefinition, nil
+}
+
+/*
+Method JSON returns the configuration definition, without checking it. Object keys are sorted.
+*/
+func (document *Document) JSON() (string, error) {
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(document.document)
+	if err != nil {
+		return "", fmt.Errorf("encoding configuration: %w", err)
+	}
+
+	return strings.TrimSpace(buffer.String()), nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// The index and entry of a section whose field has the value, or -1 and nil.
+func (document *Document) find(section string, field string, value string) (int, row) {
+	for index, entry := range document.rows(section) {
+		if text(entry, field) == value {
+			return index, entry
+		}
+	}
+
+	return -1, nil
+}
+
+// The value of a field of the entry whose other field has the value. Example: the FCLASS_ID of an FCLASS_CODE.
+func (document *Document) lookup(section string, field string, value string, resultField string) (string, bool) {
+	_, entry := document.find(section, field, value)
+	if entry == nil {
+		return "", false
+	}
+
+	return text(entry, resultField), true
+}
+
+// One more than the highest ID of a section.
+func (document *Document) nextID(section string, idField string) int64 {
+	var result int64
+
+	for _, entry := range document.rows(section) {
+		result = max(result, number(entry, idField))
+	}
+
+	return result + 1
+}
+
+// The entries of other sections referring to an entry. Example: the attributes of a feature type.
+func (document *Document) referrers(section string, entry row, except ...string) []string {
+	result := []string{}
+
+	for _, target := range references {
+		if target.section != section {
+			continue
+		}
+
+		value := text(entry, target.sectionField)
+
+		for _, referrer := range document.sections() {
+			if referrer == section || slices.Contains(except, referrer) {
+				continue
+			}
+
+			for index, candidate := range document.rows(referrer) {
+				if candidateValue, isReference := referenceValue(referrer, candidate, target); isReference &&
+					candidateValue == value {
+					result = append(result, describe(referrer, index, candidate))
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// Delete the entry of a section whose code field has the value, unless other entries refer to it.
+func (document *Document) remove(section string, codeField string, code string, except ...string) error {
+	index, entry := document.find(section, codeField, normalize(code))
+	if entry == nil {
+		return fmt.Errorf("%w: %s %s", ErrNotFound, section, normalize(code))
+	}
+
+	referrers := document.referrers(section, entry, except...)
+	if len(referrers) > 0 {
+		return fmt.Errorf("%w: %s is used by %s",
+			ErrIntegrity, describe(section, index, entry), strings.Join(referrers, ", "))
+	}
+
+	document.setRows(section, slices.Delete(document.rows(section), index, index+1))
+
+	return nil
+}
+
+// The entries of a section. The entries are shared with the document; changing one changes the document.
+func (document *Document) rows(section string) []row {
+	items, _ := document.config[section].([]any)
+	result := make([]row, 0, len(items))
+
+	for _, item := range items {
+		if entry, isObject := item.(row); isObject {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
+
+// The sections holding a list of entries, sorted.
+func (document *Document) sections() []string {
+	result := []string{}
+
+	for section, value := range document.config {
+		if _, isList := value.([]any); isList {
+			result = append(result, section)
+		}
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+func (document *Document) setRows(section string, entries []row) {
+	items := make([]any, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, entry)
+	}
+
+	document.config[section] = items
+}
+
+// Add an entry, or update the entry with the same code, keeping fields not listed.
+// A new entry gets the requested ID or the next free one; an existing entry keeps its ID.
+func (document *Document) upsert(section string, codeField string, idField string, requestedID int64, fields row) (
+	int64,
+	error,
+) {
+	code := text(fields, codeField)
+
+	_, entry := document.find(section, codeField, code)
+	if entry != nil {
+		currentID := number(entry, idField)
+		if requestedID != 0 && requestedID != currentID {
+			return 0, fmt.Errorf("%w: %s %s has %s %d, not %d",
+				ErrBadInput, section, code, idField, currentID, requestedID)
+		}
+
+		for field, value := range fields {
+			entry[field] = value
+		}
+
+		return currentID, nil
+	}
+
+	if requestedID == 0 {
+		requestedID = document.nextID(section, idField)
+	} else if _, used := document.find(section, idField, strconv.FormatInt(requestedID, 10)); used != nil {
+		return 0, fmt.Errorf("%w: %s %d is already used in %s", ErrBadInput, idField, requestedID, section)
+	}
+
+	fields[idField] = jsonNumber(requestedID)
+	document.setRows(section, append(document.rows(section), fields))
+
+	return requestedID, nil
+}
+
+// The codes or IDs of the entries of a section.
+func (document *Document) values(section string, field string) map[string]bool {
+	result := map[string]bool{}
+	for _, entry := range document.rows(section) {
+		result[text(entry, field)] = true
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// A description of an entry for messages. Example: "CFG_ATTR NAME_FULL", or "CFG_SFCALL #3" without a code.
+func describe(section string, index int, entry row) string {
+	if code := text(entry, strings.TrimPrefix(section, "CFG_")+"_CODE"); code != "" {
+		return section + " " + code
+	}
+
+	return section + " #" + strconv.Itoa(index+1)
+}
+
+func flag(entry row, field string) bool {
+	return text(entry, field) == "Yes"
+}
+
+func jsonNumber(value int64) json.Number {
+	return json.Number(strconv.FormatInt(value, 10))
+}
+
+// Codes are upper case.
+func normalize(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func nullable(value string) any {
+	if value == "" {
+		return nil
+	}
+
+	return value
+}
+
+func number(entry row, field string) int64 {
+	result, _ := strconv.ParseInt(text(entry, field), 10, 64)
+
+	return result
+}
+
+// The value of a field referring to another section, unless it refers to no entry.
+func referenceValue(section string, entry row, target reference) (string, bool) {
+	if section == target.section {
+		return "", false
+	}
+
+	value, isPresent := entry[target.field]
+	if !isPresent || value == nil {
+		return "", false
+	}
+
+	result := text(entry, target.field)
+	if strings.HasSuffix(target.field, "_ID") {
+		id, err := strconv.ParseInt(result, 10, 64)
+		if err == nil && id <= 0 {
+			return "", false
+		}
+	}
+
+	return result, result != ""
+}
+
+// A field as text: strings as they are, numbers as written, null as empty.
+func text(entry row, field string) string {
+	switch value := entry[field].(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "Yes"
+	}
+
+	return "No"
+}
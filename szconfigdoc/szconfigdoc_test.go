@@ -0,0 +1,343 @@
+package szconfigdoc_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigdoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const configDefinition = `{"G2_CONFIG": {
+	"CFG_ATTR": [
+		{"ATTR_ID": 1001, "ATTR_CODE": "DATA_SOURCE", "ATTR_CLASS": "OBSERVATION", "FTYPE_CODE": null,
+			"FELEM_CODE": null, "FELEM_REQ": "Yes", "DEFAULT_VALUE": null, "INTERNAL": "No"},
+		{"ATTR_ID": 1102, "ATTR_CODE": "NAME_FULL", "ATTR_CLASS": "NAME", "FTYPE_CODE": "NAME",
+			"FELEM_CODE": "FULL_NAME", "FELEM_REQ": "Any", "DEFAULT_VALUE": null, "INTERNAL": "No"}
+	],
+	"CFG_DSRC": [
+		{"DSRC_ID": 1, "DSRC_CODE": "TEST", "DSRC_DESC": "Test", "RETENTION_LEVEL": "Remember"}
+	],
+	"CFG_FBOM": [
+		{"FTYPE_ID": 1, "FELEM_ID": 2, "EXEC_ORDER": 1, "DISPLAY_LEVEL": 1, "DISPLAY_DELIM": null, "DERIVED": "No"},
+		{"FTYPE_ID": 1, "FELEM_ID": 3, "EXEC_ORDER": 2, "DISPLAY_LEVEL": 0, "DISPLAY_DELIM": null, "DERIVED": "No"}
+	],
+	"CFG_FCLASS": [
+		{"FCLASS_ID": 1, "FCLASS_CODE": "NAME", "FCLASS_DESC": "Name"},
+		{"FCLASS_ID": 4, "FCLASS_CODE": "ISSUED_ID", "FCLASS_DESC": "Issued ID"}
+	],
+	"CFG_FELEM": [
+		{"FELEM_ID": 2, "FELEM_CODE": "FULL_NAME", "FELEM_DESC": "Full name", "DATA_TYPE": "string"},
+		{"FELEM_ID": 3, "FELEM_CODE": "ORG_NAME", "FELEM_DESC": "Organization name", "DATA_TYPE": "string"},
+		{"FELEM_ID": 36, "FELEM_CODE": "ID_NUM", "FELEM_DESC": "ID number", "DATA_TYPE": "string"}
+	],
+	"CFG_FTYPE": [
+		{"FTYPE_ID": 1, "FTYPE_CODE": "NAME", "FTYPE_DESC": "Name", "FCLASS_ID": 1, "FTYPE_FREQ": "NAME",
+			"FTYPE_EXCL": "No", "FTYPE_STAB": "No", "PERSIST_HISTORY": "Yes", "USED_FOR_CAND": "No", "DERIVED": "No",
+			"RTYPE_ID": 0, "ANONYMIZE": "No", "VERSION": 2, "SHOW_IN_MATCH_KEY": "Yes"}
+	],
+	"CFG_GENERIC_THRESHOLD": [
+		{"GPLAN_ID": 1, "BEHAVIOR": "NAME", "FTYPE_ID": 0, "CANDIDATE_CAP": 10, "SCORING_CAP": -1, "SEND_TO_REDO": "Yes"}
+	],
+	"CFG_GPLAN": [
+		{"GPLAN_ID": 1, "GPLAN_CODE": "INGEST", "GPLAN_DESC": "Standard Ingestion"}
+	],
+	"CFG_SFCALL": [
+		{"SFCALL_ID": 1, "FTYPE_ID": 1, "FELEM_ID": -1, "SFUNC_ID": 1, "EXEC_ORDER": 1}
+	],
+	"SETTINGS": {"METAPHONE_VERSION": 3},
+	"CONFIG_BASE_VERSION": {"VERSION": "4.0.0", "BUILD_VERSION": "4.0.0.00000"}
+}}`
+
+var passport = szconfigdoc.FeatureType{
+	Class:             "issued_id",
+	Code:              "passport",
+	Elements:          []string{"ID_NUM"},
+	Frequency:         "F1",
+	PersistHistory:    true,
+	ShowInMatchKey:    true,
+	UsedForCandidates: true,
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestParse(test *testing.T) {
+	test.Parallel()
+
+	document := parse(test)
+	require.NoError(test, document.Check())
+
+	nameFull, isFound := document.Attribute("name_full")
+	require.True(test, isFound)
+	assert.Equal(test, szconfigdoc.Attribute{
+		Class:       "NAME",
+		Code:        "NAME_FULL",
+		Element:     "FULL_NAME",
+		FeatureType: "NAME",
+		ID:          1102,
+		Required:    "Any",
+	}, nameFull)
+
+	name, isFound := document.FeatureType("NAME")
+	require.True(test, isFound)
+	assert.Equal(test, []string{"FULL_NAME", "ORG_NAME"}, name.Elements)
+	assert.Equal(test, "NAME", name.Class)
+
+	assert.Equal(test, []szconfigdoc.GenericThreshold{
+		{Behavior: "NAME", CandidateCap: 10, Plan: "INGEST", ScoringCap: -1, SendToRedo: true},
+	}, document.GenericThresholds())
+
+	_, err := szconfigdoc.Parse(`{"CFG_DSRC": []}`)
+	require.Error(test, err)
+}
+
+func TestDocument_roundTrip(test *testing.T) {
+	test.Parallel()
+
+	document := parse(test)
+	configDefinition, err := document.JSON()
+	require.NoError(test, err)
+
+	var expected, actual any
+	require.NoError(test, json.Unmarshal([]byte(configDefinition), &expected))
+	require.NoError(test, json.Unmarshal([]byte(configDefinition), &actual))
+	assert.Equal(test, expected, actual)
+}
+
+func TestDocument_SetAttribute(test *testing.T) {
+	test.Parallel()
+
+	document := parse(test)
+	_, err := document.SetFeatureType(passport)
+	require.NoError(test, err)
+
+	attributeID, err := document.SetAttribute(szconfigdoc.Attribute{
+		Class:       "IDENTIFIER",
+		Code:        "passport_number",
+		Element:     "id_num",
+		FeatureType: "PASSPORT",
+		Required:    "Yes",
+	})
+	require.NoError(test, err)
+	assert.Equal(test, int64(1103), attributeID)
+
+	attributeID, err = document.SetAttribute(szconfigdoc.Attribute{
+		Class: "IDENTIFIER", Code: "PASSPORT_NUMBER", Element: "ID_NUM", FeatureType: "PASSPORT", Required: "Any",
+	})
+	require.NoError(test, err)
+	assert.Equal(test, int64(1103), attributeID)
+	assert.Len(test, document.Attributes(), 3)
+
+	attribute, _ := document.Attribute("PASSPORT_NUMBER")
+	assert.Equal(test, "Any", attribute.Required)
+	require.NoError(test, document.Check())
+}
+
+func TestDocument_SetAttribute_unknownFeatureType(test *testing.T) {
+	test.Parallel()
+
+	document := parse(test)
+	_, err := document.SetAttribute(szconfigdoc.Attribute{Class: "IDENTIFIER", Code: "X", FeatureType: "NOPE"})
+	require.ErrorIs(test, err, szconfigdoc.ErrIntegrity)
+
+	_, err = document.SetAttribute(szconfigdoc.Attribute{Class: "IDENTIFIER", Code: "X", Required: "Maybe"})
+	require.ErrorIs(test, err, szconfigdoc.ErrBadInput)
+
+	_, err = document.SetAttribute(szconfigdoc.Attribute{Class: "NAME", Code: "NAME_FULL", ID: 7})
+	require.ErrorIs(test, err, szconfigdoc.ErrBadInput)
+	assert.Len(test, document.Attributes(), 2)
+}
+
+func TestDocument_SetFeatureType(test *testing.T) {
+	test.Parallel()
+
+	document := parse(test)
+	featureTypeID, err := document.SetFeatureType(passport)
+	require.NoError(test, err)
+	assert.Equal(test, int64(2), featureTypeID)
+
+	actual, isFound := document.FeatureType("PASSPORT")
+	require.True(test, isFound)
+	assert.Equal(test, "ISSUED_ID", actual.Class)
+	assert.Equal(test, []string{"ID_NUM"}, actual.Elements)
+	assert.Equal(test, int64(1), actual.Version)
+
+	name, _ := document.FeatureType("NAME")
+	name.Elements = []string{"ORG_NAME", "FULL_NAME"}
+	_, err = document.SetFeatureType(name)
+	require.NoError(test, err)
+
+	name, _ = document.FeatureType("NAME")
+	assert.Equal(test, []string{"ORG_NAME", "FULL_NAME"}, name.Elements)
+
+	unknownElement := passport
+	unknownElement.Elements = []string{"NOPE"}
+	_, err = document.SetFeatureType(unknownElement)
+	require.ErrorIs(test, err, szconfigdoc.ErrIntegrity)
+}
+
+func TestDocument_DeleteFeatureType(test *testing.T) {
+	test.Parallel()
+
+	document := parse(test)
+	err := document.DeleteFeatureType("NAME")
+	require.ErrorIs(test, err, szconfigdoc.ErrIntegrity)
+	assert.Contains(test, err.Error(), "CFG_ATTR NAME_FULL")
+	assert.Contains(test, err.Error(), "CFG_SFCALL #1")
+
+	_, err = document.SetFeatureType(passport)
+	require.NoError(test, err)
+	require.NoError(test, document.DeleteFeatureType("passport"))
+
+	_, isFound := document.FeatureType("PASSPORT")
+	assert.False(test, isFound)
+	assert.Len(test, document.FeatureTypes(), 1)
+	require.NoError(test, document.Check())
+
+	require.ErrorIs(test, document.DeleteFeatureType("PASSPORT"), szconfigdoc.ErrNotFound)
+}
+
+func TestDocument_DataSources(test *testing.T) {
+	test.Parallel()
+
+	document := parse(test)
+	dataSourceID, err := document.SetDataSource(szconfigdoc.DataSource{Code: "customers"})
+	require.NoError(test, err)
+	assert.Equal(test, int64(2), dataSourceID)
+
+	assert.Equal(test, []szconfigdoc.DataSource{
+		{Code: "TEST", Description: "Test", ID: 1, RetentionLevel: "Remember"},
+		{Code: "CUSTOMERS", Description: "CUSTOMERS", ID: 2, RetentionLevel: "Remember"},
+	}, document.DataSources())
+
+	_, err = document.SetDataSource(szconfigdoc.DataSource{Code: "X", RetentionLevel: "Sometimes"})
+	require.ErrorIs(test, err, szconfigdoc.ErrBadInput)
+
+	require.NoError(test, document.DeleteDataSource("CUSTOMERS"))
+	assert.Len(test, document.DataSources(), 1)
+}
+
+func TestDocument_GenericPlans(test *testing.T) {
+	test.Parallel()
+
+	document := parse(test)
+	_, err := document.SetFeatureType(passport)
+	require.NoError(test, err)
+
+	planID, err := document.SetGenericPlan(szconfigdoc.GenericPlan{Code: "SEARCH", Description: "Standard Search"})
+	require.NoError(test, err)
+	assert.Equal(test, int64(2), planID)
+
+	threshold := szconfigdoc.GenericThreshold{
+		Behavior: "F1", CandidateCap: 10, FeatureType: "PASSPORT", Plan: "SEARCH", ScoringCap: -1,
+	}
+	require.NoError(test, document.SetGenericThreshold(threshold))
+
+	threshold.CandidateCap = 20
+	require.NoError(test, document.SetGenericThreshold(threshold))
+	assert.Equal(test, threshold, document.GenericThresholds()[1])
+	assert.Len(test, document.GenericThresholds(), 2)
+
+	err = document.DeleteGenericPlan("SEARCH")
+	require.ErrorIs(test, err, szconfigdoc.ErrIntegrity)
+	require.ErrorIs(test, document.DeleteFeatureType("PASSPORT"), szconfigdoc.ErrIntegrity)
+
+	require.NoError(test, document.DeleteGenericThreshold("SEARCH", "F1", "PASSPORT"))
+	require.NoError(test, document.DeleteGenericPlan("SEARCH"))
+	require.ErrorIs(test, document.DeleteGenericThreshold("INGEST", "F1", ""), szconfigdoc.ErrNotFound)
+	require.ErrorIs(test, document.SetGenericThreshold(threshold), szconfigdoc.ErrIntegrity)
+	require.NoError(test, document.Check())
+}
+
+func TestDocument_Check(test *testing.T) {
+	test.Parallel()
+
+	document, err := szconfigdoc.Parse(`{"G2_CONFIG": {
+		"CFG_ATTR": [{"ATTR_ID": 1, "ATTR_CODE": "X", "FTYPE_CODE": "NOPE", "FELEM_CODE": null}],
+		"CFG_FTYPE": [],
+		"CFG_GENERIC_THRESHOLD": [{"GPLAN_ID": 9, "BEHAVIOR": "F1", "FTYPE_ID": 0}],
+		"CFG_GPLAN": []
+	}}`)
+	require.NoError(test, err)
+
+	err = document.Check()
+	require.ErrorIs(test, err, szconfigdoc.ErrIntegrity)
+	assert.Contains(test, err.Error(), "CFG_ATTR X refers to unknown FTYPE_CODE NOPE")
+	assert.Contains(test, err.Error(), "CFG_GENERIC_THRESHOLD #1 refers to unknown GPLAN_ID 9")
+	assert.NotContains(test, err.Error(), "FTYPE_ID")
+
+	verifier := &fakeVerifier{}
+	_, err = document.Export(test.Context(), verifier)
+	require.ErrorIs(test, err, szconfigdoc.ErrIntegrity)
+	assert.Empty(test, verifier.configDefinition)
+}
+
+func TestDocument_Check_usageElements(test *testing.T) {
+	test.Parallel()
+
+	document := parse(test)
+	_, err := document.SetAttribute(szconfigdoc.Attribute{
+		Class: "NAME", Code: "NAME_TYPE", Element: "USAGE_TYPE", FeatureType: "NAME", Required: "No",
+	})
+	require.NoError(test, err)
+	require.NoError(test, document.Check())
+
+	_, err = document.SetAttribute(szconfigdoc.Attribute{
+		Class: "NAME", Code: "NAME_SINCE", Element: "USED_SINCE_DT", FeatureType: "NAME", Required: "No",
+	})
+	require.ErrorIs(test, err, szconfigdoc.ErrIntegrity)
+}
+
+func TestDocument_Export(test *testing.T) {
+	test.Parallel()
+
+	document := parse(test)
+	_, err := document.SetDataSource(szconfigdoc.DataSource{Code: "CUSTOMERS"})
+	require.NoError(test, err)
+
+	verifier := &fakeVerifier{}
+	configDefinition, err := document.Export(test.Context(), verifier)
+	require.NoError(test, err)
+	assert.Equal(test, configDefinition, verifier.configDefinition)
+	assert.Contains(test, configDefinition, `"DSRC_CODE":"CUSTOMERS"`)
+
+	verifier.err = errVerify
+	_, err = document.Export(test.Context(), verifier)
+	require.ErrorIs(test, err, errVerify)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func parse(test *testing.T) *szconfigdoc.Document {
+	test.Helper()
+
+	document, err := szconfigdoc.Parse(configDefinition)
+	require.NoError(test, err)
+
+	return document
+}
+
+// ----------------------------------------------------------------------------
+// Fakes
+// ----------------------------------------------------------------------------
+
+var errVerify = errors.New("verify failed")
+
+type fakeVerifier struct {
+	configDefinition string
+	err              error
+}
+
+func (verifier *fakeVerifier) VerifyConfigDefinition(ctx context.Context, configDefinition string) error {
+	_ = ctx
+	verifier.configDefinition = configDefinition
+
+	return verifier.err
+}
@@ -0,0 +1,27 @@
+/*
+Package szconfiglint checks a Senzing configuration JSON document without the Senzing library.
+
+[Lint] reports each problem as a [Finding] with a [Severity] and the [Rule] that found it:
+dangling references between sections, duplicate and near-duplicate data source codes,
+data source codes breaking the naming convention, data sources never referenced,
+and suspicious generic thresholds and comparison scores.
+
+	findings, err := szconfiglint.Lint(configDefinition, szconfiglint.Options{
+		UsedDataSources: []string{"CUSTOMERS", "WATCHLIST"},
+	})
+	...
+	for _, finding := range findings {
+		fmt.Println(finding)
+	}
+
+A [Gate] refuses configurations with findings at or above a severity.
+Given to szconfigmanager.Szconfigmanager.SetConfigGate(), it checks every configuration
+passed to SetDefaultConfig() before it is registered:
+
+	szConfigManager.SetConfigGate(ctx, szconfiglint.Gate{MinSeverity: szconfiglint.SeverityWarning})
+	_, err = szConfigManager.SetDefaultConfig(ctx, configDefinition, "Add CUSTOMERS")
+	if errors.Is(err, szconfiglint.ErrRejected) {
+		...
+	}
+*/
+package szconfiglint
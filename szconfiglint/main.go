@@ -0,0 +1,139 @@
+package szconfiglint
+
+import (
+	"errors"
+	"regexp"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Finding struct is a problem found in a configuration.
+*/
+type Finding struct {
+	Key      string   `json:"KEY,omitempty"` // The entry, e.g. a data source code. Empty when the finding is about the section.
+	Message  string   `json:"MESSAGE"`
+	Rule     Rule     `json:"RULE"`
+	Section  string   `json:"SECTION"` // Example: "CFG_DSRC".
+	Severity Severity `json:"SEVERITY"`
+}
+
+/*
+Type Gate struct refuses configurations with findings at or above MinSeverity.
+It can be given to szconfigmanager.Szconfigmanager.SetConfigGate() to check the
+configurations passed to SetDefaultConfig().
+*/
+type Gate struct {
+	MinSeverity Severity // Zero means SeverityError.
+	Options     Options
+}
+
+/*
+Type Options struct tunes Lint.
+*/
+type Options struct {
+	// Data sources known to be used, e.g. by record mappings or loaded records.
+	// Other data sources not referenced by the configuration are reported by RuleUnusedDataSource.
+	UsedDataSources []string
+}
+
+/*
+Type Rule string identifies the check that produced a Finding.
+*/
+type Rule string
+
+/*
+Type Severity int is how serious a Finding is. A higher Severity is more serious.
+*/
+type Severity int
+
+// The sections of a configuration the linter reads without szconfigdoc.
+type configDocument struct {
+	G2Config *struct {
+		ComparisonReturns []comparisonReturn `json:"CFG_CFRTN"`
+		Interests         []struct {
+			DataSourceID int64 `json:"DSRC_ID"`
+		} `json:"CFG_DSRC_INTEREST"`
+	} `json:"G2_CONFIG"`
+}
+
+// An entry of CFG_CFRTN: the scores of a comparison function return value.
+type comparisonReturn struct {
+	CloseScore     int64  `json:"CLOSE_SCORE"`
+	ID             int64  `json:"CFRTN_ID"`
+	LikelyScore    int64  `json:"LIKELY_SCORE"`
+	PlausibleScore int64  `json:"PLAUSIBLE_SCORE"`
+	ReturnValue    string `json:"CFUNC_RTNVAL"`
+	SameScore      int64  `json:"SAME_SCORE"`
+	UnlikelyScore  int64  `json:"UN_LIKELY_SCORE"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+The Rule* constants are the checks made by Lint.
+
+  - RuleDanglingReference: An entry refers to an entry of another section that does not exist.
+  - RuleDataSourceNaming: A data source code is not upper case letters, digits and single underscores, starting with a letter.
+  - RuleDuplicateDataSource: Data source codes differ only in case or surrounding spaces.
+  - RuleNearDuplicateDataSource: Data source codes differ only in punctuation, a plural "S" or a single character.
+  - RuleSuspiciousThreshold: A generic threshold cap or a comparison score is out of range or out of order.
+  - RuleUnusedDataSource: A data source is neither referenced by the configuration nor in Options.UsedDataSources.
+*/
+const (
+	RuleDanglingReference       Rule = "DANGLING_REFERENCE"
+	RuleDataSourceNaming        Rule = "DATA_SOURCE_NAMING"
+	RuleDuplicateDataSource     Rule = "DUPLICATE_DATA_SOURCE"
+	RuleNearDuplicateDataSource Rule = "NEAR_DUPLICATE_DATA_SOURCE"
+	RuleSuspiciousThreshold     Rule = "SUSPICIOUS_THRESHOLD"
+	RuleUnusedDataSource        Rule = "UNUSED_DATA_SOURCE"
+)
+
+/*
+The Severity* constants are the severities of findings.
+
+  - SeverityInfo: Worth a look; often intended.
+  - SeverityWarning: Probably a mistake.
+  - SeverityError: The configuration should not be used.
+*/
+const (
+	SeverityInfo Severity = iota + 1
+	SeverityWarning
+	SeverityError
+)
+
+const (
+	comparisonReturnSection = "CFG_CFRTN"
+	dataSourceSection       = "CFG_DSRC"
+	genericThresholdSection = "CFG_GENERIC_THRESHOLD"
+	maxScore                = 100
+	minNearDuplicateLength  = 5 // Shorter codes differing by one character are usually distinct, e.g. "CRM1" and "CRM2".
+	noScoringCap            = -1
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+/*
+ErrRejected is wrapped by the error of a Gate refusing a configuration. Use errors.Is to test for it.
+*/
+var ErrRejected = errors.New("configuration rejected by linter")
+
+var dataSourceCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`)
+
+// Data sources of the Senzing configuration template, used by Senzing itself.
+var templateDataSources = map[string]bool{
+	"SEARCH": true,
+	"TEST":   true,
+}
+
+var severityNames = map[Severity]string{
+	SeverityError:   "ERROR",
+	SeverityInfo:    "INFO",
+	SeverityWarning: "WARNING",
+}
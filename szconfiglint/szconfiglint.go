@@ -0,0 +1,375 @@
+package szconfiglint
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigdoc"
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Lint function checks a configuration definition without the Senzing library.
+
+Input
+  - configDefinition: A Senzing configuration JSON document, e.g. from szconfig.Export().
+  - options: Tuning of the checks.
+
+Output
+  - The findings, most severe first. Empty when nothing was found.
+*/
+func Lint(configDefinition string, options Options) ([]Finding, error) {
+	document, err := szconfigdoc.Parse(configDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("linting configuration: %w", err)
+	}
+
+	var sections configDocument
+
+	err = json.Unmarshal([]byte(configDefinition), &sections)
+	if err != nil {
+		return nil, fmt.Errorf("linting configuration: %w", err)
+	}
+
+	dataSources := document.DataSources()
+	result := []Finding{}
+	result = append(result, lintReferences(document)...)
+	result = append(result, lintDataSourceCodes(dataSources)...)
+	result = append(result, lintUnusedDataSources(dataSources, sections, options)...)
+	result = append(result, lintGenericThresholds(document.GenericThresholds())...)
+	result = append(result, lintComparisonReturns(sections)...)
+
+	slices.SortStableFunc(result, func(a Finding, b Finding) int {
+		return cmp.Or(
+			cmp.Compare(b.Severity, a.Severity),
+			cmp.Compare(a.Rule, b.Rule),
+			cmp.Compare(a.Section, b.Section),
+			cmp.Compare(a.Key, b.Key),
+			cmp.Compare(a.Message, b.Message),
+		)
+	})
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method String returns the finding as a line, e.g. "WARNING CFG_DSRC CUSTOMER: ...".
+*/
+func (finding Finding) String() string {
+	location := finding.Section
+	if finding.Key != "" {
+		location += " " + finding.Key
+	}
+
+	return fmt.Sprintf("%s %s: %s [%s]", finding.Severity, location, finding.Message, finding.Rule)
+}
+
+/*
+Method CheckConfigDefinition lints a configuration definition.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configDefinition: A Senzing configuration JSON document.
+
+Output
+  - nil, or an error wrapping ErrRejected and listing the findings at or above MinSeverity.
+*/
+func (gate Gate) CheckConfigDefinition(ctx context.Context, configDefinition string) error {
+	_ = ctx
+
+	findings, err := Lint(configDefinition, gate.Options)
+	if err != nil {
+		return err
+	}
+
+	minSeverity := cmp.Or(gate.MinSeverity, SeverityError)
+	rejections := []string{}
+
+	for _, finding := range findings {
+		if finding.Severity >= minSeverity {
+			rejections = append(rejections, finding.String())
+		}
+	}
+
+	if len(rejections) > 0 {
+		return fmt.Errorf("%w: %s", ErrRejected, strings.Join(rejections, "; "))
+	}
+
+	return nil
+}
+
+/*
+Method MarshalText writes the severity by name, e.g. "WARNING".
+*/
+func (severity Severity) MarshalText() ([]byte, error) {
+	return []byte(severity.String()), nil
+}
+
+/*
+Method String returns the name of the severity, e.g. "WARNING".
+*/
+func (severity Severity) String() string {
+	if name, ok := severityNames[severity]; ok {
+		return name
+	}
+
+	return "Severity(" + strconv.Itoa(int(severity)) + ")"
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Scores of CFG_CFRTN must be within 0 to 100 and must not increase from SAME_SCORE to UN_LIKELY_SCORE.
+func lintComparisonReturns(sections configDocument) []Finding {
+	result := []Finding{}
+
+	if sections.G2Config == nil {
+		return result
+	}
+
+	for _, comparisonReturn := range sections.G2Config.ComparisonReturns {
+		key := comparisonReturn.ReturnValue + " #" + strconv.FormatInt(comparisonReturn.ID, 10)
+		scores := []struct {
+			field string
+			value int64
+		}{
+			{field: "SAME_SCORE", value: comparisonReturn.SameScore},
+			{field: "CLOSE_SCORE", value: comparisonReturn.CloseScore},
+			{field: "LIKELY_SCORE", value: comparisonReturn.LikelyScore},
+			{field: "PLAUSIBLE_SCORE", value: comparisonReturn.PlausibleScore},
+			{field: "UN_LIKELY_SCORE", value: comparisonReturn.UnlikelyScore},
+		}
+
+		for index, score := range scores {
+			if score.value < 0 || score.value > maxScore {
+				result = append(result, Finding{
+					Key:      key,
+					Message:  fmt.Sprintf("%s %d is outside 0 to %d", score.field, score.value, maxScore),
+					Rule:     RuleSuspiciousThreshold,
+					Section:  comparisonReturnSection,
+					Severity: SeverityError,
+				})
+
+				continue
+			}
+
+			if index > 0 && score.value > scores[index-1].value {
+				result = append(result, Finding{
+					Key: key,
+					Message: fmt.Sprintf("%s %d is above %s %d",
+						score.field, score.value, scores[index-1].field, scores[index-1].value),
+					Rule:     RuleSuspiciousThreshold,
+					Section:  comparisonReturnSection,
+					Severity: SeverityWarning,
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+func lintDataSourceCodes(dataSources []szconfigdoc.DataSource) []Finding {
+	result := []Finding{}
+	byCode := map[string]string{}
+	byStem := map[string]string{}
+
+	for _, dataSource := range dataSources {
+		code := dataSource.Code
+
+		if !dataSourceCodePattern.MatchString(code) {
+			result = append(result, Finding{
+				Key:      code,
+				Message:  "should be upper case letters, digits and single underscores, starting with a letter",
+				Rule:     RuleDataSourceNaming,
+				Section:  dataSourceSection,
+				Severity: SeverityWarning,
+			})
+		}
+
+		normalizedCode := strings.ToUpper(strings.TrimSpace(code))
+		if other, isPresent := byCode[normalizedCode]; isPresent {
+			result = append(result, Finding{
+				Key:      code,
+				Message:  fmt.Sprintf("duplicates %q", other),
+				Rule:     RuleDuplicateDataSource,
+				Section:  dataSourceSection,
+				Severity: SeverityError,
+			})
+
+			continue
+		}
+
+		byCode[normalizedCode] = code
+		codeStem := stem(code)
+
+		for otherStem, other := range byStem {
+			if codeStem == otherStem ||
+				(min(len(codeStem), len(otherStem)) >= minNearDuplicateLength && isOneEditApart(codeStem, otherStem)) {
+				result = append(result, Finding{
+					Key:      code,
+					Message:  fmt.Sprintf("is nearly the same as %q", other),
+					Rule:     RuleNearDuplicateDataSource,
+					Section:  dataSourceSection,
+					Severity: SeverityWarning,
+				})
+			}
+		}
+
+		byStem[codeStem] = code
+	}
+
+	return result
+}
+
+func lintGenericThresholds(thresholds []szconfigdoc.GenericThreshold) []Finding {
+	result := []Finding{}
+
+	for _, threshold := range thresholds {
+		key := threshold.Plan + " " + threshold.Behavior
+		if threshold.FeatureType != "" {
+			key += " " + threshold.FeatureType
+		}
+
+		addFinding := func(severity Severity, message string) {
+			result = append(result, Finding{
+				Key:      key,
+				Message:  message,
+				Rule:     RuleSuspiciousThreshold,
+				Section:  genericThresholdSection,
+				Severity: severity,
+			})
+		}
+
+		switch {
+		case threshold.CandidateCap <= 0:
+			addFinding(SeverityWarning, fmt.Sprintf("CANDIDATE_CAP %d allows no candidates", threshold.CandidateCap))
+		case threshold.ScoringCap == 0 || threshold.ScoringCap < noScoringCap:
+			addFinding(SeverityWarning, fmt.Sprintf("SCORING_CAP %d allows no scoring; -1 is no cap", threshold.ScoringCap))
+		case threshold.ScoringCap != noScoringCap && threshold.ScoringCap < threshold.CandidateCap:
+			addFinding(SeverityInfo, fmt.Sprintf("SCORING_CAP %d is below CANDIDATE_CAP %d",
+				threshold.ScoringCap, threshold.CandidateCap))
+		}
+	}
+
+	return result
+}
+
+// The errors of szconfigdoc.Document.Check(), as findings.
+func lintReferences(document *szconfigdoc.Document) []Finding {
+	result := []Finding{}
+
+	err := document.Check()
+	if err == nil {
+		return result
+	}
+
+	errs := []error{err}
+	if joined, isJoined := err.(interface{ Unwrap() []error }); isJoined { //nolint:errorlint
+		errs = joined.Unwrap()
+	}
+
+	for _, err := range errs {
+		message := strings.TrimPrefix(err.Error(), szconfigdoc.ErrIntegrity.Error()+": ")
+		section, _, _ := strings.Cut(message, " ")
+		result = append(result, Finding{
+			Key:      "",
+			Message:  message,
+			Rule:     RuleDanglingReference,
+			Section:  section,
+			Severity: SeverityError,
+		})
+	}
+
+	return result
+}
+
+func lintUnusedDataSources(dataSources []szconfigdoc.DataSource, sections configDocument, options Options) []Finding {
+	result := []Finding{}
+	usedIDs := map[int64]bool{}
+	usedCodes := map[string]bool{}
+
+	if sections.G2Config != nil {
+		for _, interest := range sections.G2Config.Interests {
+			usedIDs[interest.DataSourceID] = true
+		}
+	}
+
+	for _, code := range options.UsedDataSources {
+		usedCodes[strings.ToUpper(strings.TrimSpace(code))] = true
+	}
+
+	for _, dataSource := range dataSources {
+		code := strings.ToUpper(strings.TrimSpace(dataSource.Code))
+		if templateDataSources[code] || usedIDs[dataSource.ID] || usedCodes[code] {
+			continue
+		}
+
+		result = append(result, Finding{
+			Key:      dataSource.Code,
+			Message:  "is registered but never referenced",
+			Rule:     RuleUnusedDataSource,
+			Section:  dataSourceSection,
+			Severity: SeverityInfo,
+		})
+	}
+
+	return result
+}
+
+// Whether a can be made b by inserting, deleting or replacing one character.
+func isOneEditApart(a string, b string) bool {
+	if a == b {
+		return true
+	}
+
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+
+	if len(b)-len(a) > 1 {
+		return false
+	}
+
+	prefix := 0
+	for prefix < len(a) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	if len(a) == len(b) {
+		return a[prefix+1:] == b[prefix+1:]
+	}
+
+	return a[prefix:] == b[prefix+1:]
+}
+
+// A data source code without case, punctuation or a plural "S". Example: "Customer-Records" becomes "CUSTOMERRECORD".
+func stem(code string) string {
+	result := strings.Map(func(character rune) rune {
+		if unicode.IsLetter(character) || unicode.IsDigit(character) {
+			return unicode.ToUpper(character)
+		}
+
+		return -1
+	}, code)
+
+	if len(result) > 1 {
+		result = strings.TrimSuffix(result, "S")
+	}
+
+	return result
+}
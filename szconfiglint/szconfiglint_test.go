@@ -0,0 +1,198 @@
+package szconfiglint_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szconfiglint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cleanConfig = `{"G2_CONFIG": {
+	"CFG_CFRTN": [
+		{"CFRTN_ID": 1, "CFUNC_ID": 1, "FTYPE_ID": 0, "CFUNC_RTNVAL": "FULL_SCORE",
+		 "SAME_SCORE": 100, "CLOSE_SCORE": 90, "LIKELY_SCORE": 80, "PLAUSIBLE_SCORE": 70, "UN_LIKELY_SCORE": 60}
+	],
+	"CFG_DSRC": [
+		{"DSRC_ID": 1, "DSRC_CODE": "TEST"},
+		{"DSRC_ID": 2, "DSRC_CODE": "SEARCH"},
+		{"DSRC_ID": 1001, "DSRC_CODE": "CUSTOMERS"}
+	],
+	"CFG_DSRC_INTEREST": [],
+	"CFG_FTYPE": [
+		{"FTYPE_ID": 1, "FTYPE_CODE": "NAME"}
+	],
+	"CFG_GENERIC_THRESHOLD": [
+		{"GPLAN_ID": 1, "BEHAVIOR": "NAME", "FTYPE_ID": 0, "CANDIDATE_CAP": 10, "SCORING_CAP": -1, "SEND_TO_REDO": "Yes"},
+		{"GPLAN_ID": 1, "BEHAVIOR": "F1", "FTYPE_ID": 1, "CANDIDATE_CAP": 5, "SCORING_CAP": 50, "SEND_TO_REDO": "Yes"}
+	],
+	"CFG_GPLAN": [
+		{"GPLAN_ID": 1, "GPLAN_CODE": "INGEST"}
+	]
+}}`
+
+const problemConfig = `{"G2_CONFIG": {
+	"CFG_ATTR": [
+		{"ATTR_ID": 1001, "ATTR_CODE": "LOYALTY_NUMBER", "FTYPE_CODE": "LOYALTY"}
+	],
+	"CFG_CFRTN": [
+		{"CFRTN_ID": 7, "CFUNC_ID": 1, "FTYPE_ID": 0, "CFUNC_RTNVAL": "FULL_SCORE",
+		 "SAME_SCORE": 100, "CLOSE_SCORE": 90, "LIKELY_SCORE": 95, "PLAUSIBLE_SCORE": 70, "UN_LIKELY_SCORE": 160}
+	],
+	"CFG_DSRC": [
+		{"DSRC_ID": 1, "DSRC_CODE": "TEST"},
+		{"DSRC_ID": 1001, "DSRC_CODE": "CUSTOMERS"},
+		{"DSRC_ID": 1002, "DSRC_CODE": "CUSTOMER"},
+		{"DSRC_ID": 1003, "DSRC_CODE": "watchlist"},
+		{"DSRC_ID": 1004, "DSRC_CODE": "WATCHLIST"},
+		{"DSRC_ID": 1005, "DSRC_CODE": "VENDOR"}
+	],
+	"CFG_DSRC_INTEREST": [
+		{"DSRC_ID": 1005}
+	],
+	"CFG_FTYPE": [
+		{"FTYPE_ID": 1, "FTYPE_CODE": "NAME"}
+	],
+	"CFG_GENERIC_THRESHOLD": [
+		{"GPLAN_ID": 1, "BEHAVIOR": "NAME", "FTYPE_ID": 0, "CANDIDATE_CAP": 0, "SCORING_CAP": -1, "SEND_TO_REDO": "Yes"},
+		{"GPLAN_ID": 1, "BEHAVIOR": "F1", "FTYPE_ID": 1, "CANDIDATE_CAP": 10, "SCORING_CAP": 5, "SEND_TO_REDO": "Yes"}
+	],
+	"CFG_GPLAN": [
+		{"GPLAN_ID": 1, "GPLAN_CODE": "INGEST"}
+	]
+}}`
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestLint(test *testing.T) {
+	test.Parallel()
+
+	findings, err := szconfiglint.Lint(problemConfig, szconfiglint.Options{UsedDataSources: []string{"customers"}})
+	require.NoError(test, err)
+
+	type summary struct {
+		key      string
+		rule     szconfiglint.Rule
+		severity szconfiglint.Severity
+	}
+
+	actual := []summary{}
+	for _, finding := range findings {
+		actual = append(actual, summary{key: finding.Key, rule: finding.Rule, severity: finding.Severity})
+	}
+
+	assert.Equal(test, []summary{
+		{key: "", rule: szconfiglint.RuleDanglingReference, severity: szconfiglint.SeverityError},
+		{key: "WATCHLIST", rule: szconfiglint.RuleDuplicateDataSource, severity: szconfiglint.SeverityError},
+		{key: "FULL_SCORE #7", rule: szconfiglint.RuleSuspiciousThreshold, severity: szconfiglint.SeverityError},
+		{key: "watchlist", rule: szconfiglint.RuleDataSourceNaming, severity: szconfiglint.SeverityWarning},
+		{key: "CUSTOMER", rule: szconfiglint.RuleNearDuplicateDataSource, severity: szconfiglint.SeverityWarning},
+		{key: "FULL_SCORE #7", rule: szconfiglint.RuleSuspiciousThreshold, severity: szconfiglint.SeverityWarning},
+		{key: "INGEST NAME", rule: szconfiglint.RuleSuspiciousThreshold, severity: szconfiglint.SeverityWarning},
+		{key: "INGEST F1 NAME", rule: szconfiglint.RuleSuspiciousThreshold, severity: szconfiglint.SeverityInfo},
+		{key: "CUSTOMER", rule: szconfiglint.RuleUnusedDataSource, severity: szconfiglint.SeverityInfo},
+		{key: "WATCHLIST", rule: szconfiglint.RuleUnusedDataSource, severity: szconfiglint.SeverityInfo},
+		{key: "watchlist", rule: szconfiglint.RuleUnusedDataSource, severity: szconfiglint.SeverityInfo},
+	}, actual)
+
+	assert.Equal(test, "CFG_ATTR", findings[0].Section)
+	assert.Contains(test, findings[0].Message, "LOYALTY")
+	assert.Equal(test, "UN_LIKELY_SCORE 160 is outside 0 to 100", findings[2].Message)
+	assert.Equal(test, "LIKELY_SCORE 95 is above CLOSE_SCORE 90", findings[5].Message)
+}
+
+func TestLint_clean(test *testing.T) {
+	test.Parallel()
+
+	findings, err := szconfiglint.Lint(cleanConfig, szconfiglint.Options{UsedDataSources: []string{"CUSTOMERS"}})
+	require.NoError(test, err)
+	assert.Empty(test, findings)
+
+	findings, err = szconfiglint.Lint(cleanConfig, szconfiglint.Options{})
+	require.NoError(test, err)
+	require.Len(test, findings, 1)
+	assert.Equal(test, "INFO CFG_DSRC CUSTOMERS: is registered but never referenced [UNUSED_DATA_SOURCE]", findings[0].String())
+}
+
+func TestLint_badConfigDefinition(test *testing.T) {
+	test.Parallel()
+
+	_, err := szconfiglint.Lint("}{", szconfiglint.Options{})
+	require.Error(test, err)
+
+	_, err = szconfiglint.Lint(`{"CFG_DSRC": []}`, szconfiglint.Options{})
+	require.Error(test, err)
+}
+
+func TestLint_nearDuplicates(test *testing.T) {
+	test.Parallel()
+
+	config := `{"G2_CONFIG": {"CFG_DSRC": [
+		{"DSRC_ID": 1001, "DSRC_CODE": "CRM1"},
+		{"DSRC_ID": 1002, "DSRC_CODE": "CRM2"},
+		{"DSRC_ID": 1003, "DSRC_CODE": "VENDORS_EU"},
+		{"DSRC_ID": 1004, "DSRC_CODE": "VENDOR_EU"},
+		{"DSRC_ID": 1005, "DSRC_CODE": "PAYROLL"},
+		{"DSRC_ID": 1006, "DSRC_CODE": "PAYROL"}
+	]}}`
+
+	findings, err := szconfiglint.Lint(config, szconfiglint.Options{
+		UsedDataSources: []string{"CRM1", "CRM2", "VENDORS_EU", "VENDOR_EU", "PAYROLL", "PAYROL"},
+	})
+	require.NoError(test, err)
+
+	keys := []string{}
+	for _, finding := range findings {
+		assert.Equal(test, szconfiglint.RuleNearDuplicateDataSource, finding.Rule)
+
+		keys = append(keys, finding.Key)
+	}
+
+	assert.Equal(test, []string{"PAYROL", "VENDOR_EU"}, keys)
+}
+
+func TestGate_CheckConfigDefinition(test *testing.T) {
+	test.Parallel()
+
+	ctx := test.Context()
+
+	require.NoError(test, szconfiglint.Gate{}.CheckConfigDefinition(ctx, cleanConfig))
+
+	err := szconfiglint.Gate{}.CheckConfigDefinition(ctx, problemConfig)
+	require.ErrorIs(test, err, szconfiglint.ErrRejected)
+	assert.Contains(test, err.Error(), "DUPLICATE_DATA_SOURCE")
+	assert.NotContains(test, err.Error(), "NEAR_DUPLICATE_DATA_SOURCE")
+
+	gate := szconfiglint.Gate{MinSeverity: szconfiglint.SeverityInfo}
+	err = gate.CheckConfigDefinition(ctx, cleanConfig)
+	require.ErrorIs(test, err, szconfiglint.ErrRejected)
+
+	gate.Options.UsedDataSources = []string{"CUSTOMERS"}
+	require.NoError(test, gate.CheckConfigDefinition(ctx, cleanConfig))
+
+	err = gate.CheckConfigDefinition(ctx, "}{")
+	require.Error(test, err)
+	require.NotErrorIs(test, err, szconfiglint.ErrRejected)
+}
+
+func TestSeverity_String(test *testing.T) {
+	test.Parallel()
+
+	assert.Equal(test, "WARNING", szconfiglint.SeverityWarning.String())
+	assert.Equal(test, "Severity(9)", szconfiglint.Severity(9).String())
+
+	actual, err := json.Marshal(szconfiglint.Finding{
+		Key:      "CUSTOMERS",
+		Message:  "is registered but never referenced",
+		Rule:     szconfiglint.RuleUnusedDataSource,
+		Section:  "CFG_DSRC",
+		Severity: szconfiglint.SeverityInfo,
+	})
+	require.NoError(test, err)
+	assert.JSONEq(test,
+		`{"KEY": "CUSTOMERS", "MESSAGE": "is registered but never referenced", "RULE": "UNUSED_DATA_SOURCE", "SECTION": "CFG_DSRC", "SEVERITY": "INFO"}`,
+		string(actual))
+}
@@ -1,6 +1,32 @@
 package szconfigmanager
 
-import "errors"
+import (
+	"context"
+	"errors"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szconfig"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type ConfigGate interface checks a configuration definition before SetDefaultConfig() registers it.
+A szconfiglint.Gate satisfies it.
+*/
+type ConfigGate interface {
+	CheckConfigDefinition(ctx context.Context, configDefinition string) error
+}
+
+/*
+Type EnsureDataSourcesResult struct reports what [Szconfigmanager.EnsureDataSources] did.
+*/
+type EnsureDataSourcesResult struct {
+	szconfig.EnsureDataSourcesResult
+
+	ConfigID int64 // The default configuration ID afterwards.
+}
 
 // ----------------------------------------------------------------------------
 // Constants
@@ -17,4 +43,18 @@ const (
 	ExceptionCodeTemplate = "SENZ%04d"
 )
 
+// MaxCommentLength is the length, in characters, of the longest configuration comment the repository keeps.
+// See TruncateComment.
+const MaxCommentLength = 200
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
 var errForPackage = errors.New("szconfigmanager")
+
+// Messages of this implementation, in addition to those of the sz-sdk-go szconfigmanager package.
+var idMessages = map[int]string{
+	4009: "szconfigmanager.CreateConfigFromString: the configuration definition was rejected.",
+	8012: "szconfigmanager.EnsureDataSources",
+}
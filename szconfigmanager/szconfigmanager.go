@@ -14,11 +14,12 @@ package szconfigmanager
 import "C"
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"runtime"
 	"strconv"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -29,7 +30,9 @@ import (
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/go-observing/subject"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/sdkerror"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfig"
+	"github.com/senzing-garage/sz-sdk-go-core/validation"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-go/szconfigmanager"
 	"github.com/senzing-garage/sz-sdk-go/szerror"
@@ -40,6 +43,9 @@ Type Szconfigmanager struct implements the [senzing.SzConfigManager] interface
 for communicating with the Senzing C binaries.
 */
 type Szconfigmanager struct {
+	callGate       helper.CallGate
+	configGate     ConfigGate
+	executor       *helper.Executor
 	instanceName   string
 	isDestroyed    bool
 	isTrace        bool
@@ -52,11 +58,10 @@ type Szconfigmanager struct {
 }
 
 const (
-	baseCallerSkip       = 4
-	baseTen              = 10
-	initialByteArraySize = 65535
-	noError              = 0
-	uninitializedError   = -1
+	baseCallerSkip     = 4
+	baseTen            = 10
+	noError            = 0
+	uninitializedError = -1
 )
 
 // ----------------------------------------------------------------------------
@@ -79,9 +84,10 @@ func (client *Szconfigmanager) CreateConfigFromConfigID(ctx context.Context, con
 		result senzing.SzConfig
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzConfigManger has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(7, configID)
@@ -90,7 +96,7 @@ func (client *Szconfigmanager) CreateConfigFromConfigID(ctx context.Context, con
 		defer func() { client.traceExit(8, configID, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.createConfigFromConfigIDChoreography(ctx, configID)
+	client.executor.Call(func() { result, err = client.createConfigFromConfigIDChoreography(ctx, configID) })
 
 	if client.observers != nil {
 		go func() {
@@ -121,9 +127,10 @@ func (client *Szconfigmanager) CreateConfigFromString(
 		result senzing.SzConfig
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzConfigManger has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(23, configDefinition)
@@ -132,7 +139,12 @@ func (client *Szconfigmanager) CreateConfigFromString(
 		defer func() { client.traceExit(24, configDefinition, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.CreateConfigFromStringChoreography(ctx, configDefinition)
+	err = client.checkInputs(4009, validation.Document("configDefinition", configDefinition))
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { result, err = client.CreateConfigFromStringChoreography(ctx, configDefinition) })
 
 	if client.observers != nil {
 		go func() {
@@ -159,9 +171,10 @@ func (client *Szconfigmanager) CreateConfigFromTemplate(ctx context.Context) (se
 		result senzing.SzConfig
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzConfigManger has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(25)
@@ -170,7 +183,7 @@ func (client *Szconfigmanager) CreateConfigFromTemplate(ctx context.Context) (se
 		defer func() { client.traceExit(26, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.createConfigFromTemplateChoreography(ctx)
+	client.executor.Call(func() { result, err = client.createConfigFromTemplateChoreography(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -187,6 +200,10 @@ Method Destroy will destroy and perform cleanup for the Senzing SzConfigMgr obje
 
 It should be called after all other calls are complete.
 
+New calls are rejected once Destroy is called.
+Calls already in flight are given until ctx is done to finish;
+if they do not, nothing is destroyed and an error is returned.
+
 Input
   - ctx: A context to control lifecycle.
 */
@@ -197,6 +214,11 @@ func (client *Szconfigmanager) Destroy(ctx context.Context) error {
 		return wraperror.Errorf(errForPackage, "This SzConfigManger has been destroyed.")
 	}
 
+	err = client.Drain(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
 	if client.isTrace {
 		client.traceEntry(5)
 
@@ -204,7 +226,8 @@ func (client *Szconfigmanager) Destroy(ctx context.Context) error {
 		defer func() { client.traceExit(6, err, time.Since(entryTime)) }()
 	}
 
-	err = client.destroy(ctx)
+	client.executor.Call(func() { err = client.destroy(ctx) })
+
 	if err != nil {
 		return wraperror.Errorf(err, "destroy")
 	}
@@ -241,9 +264,10 @@ func (client *Szconfigmanager) GetConfigRegistry(ctx context.Context) (string, e
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzConfigManger has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(9)
@@ -252,7 +276,7 @@ func (client *Szconfigmanager) GetConfigRegistry(ctx context.Context) (string, e
 		defer func() { client.traceExit(10, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.getConfigRegistry(ctx)
+	client.executor.Call(func() { result, err = client.getConfigRegistry(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -283,9 +307,10 @@ func (client *Szconfigmanager) GetDefaultConfigID(ctx context.Context) (int64, e
 		result int64
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzConfigManger has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(11)
@@ -294,7 +319,7 @@ func (client *Szconfigmanager) GetDefaultConfigID(ctx context.Context) (int64, e
 		defer func() { client.traceExit(12, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.getDefaultConfigID(ctx)
+	client.executor.Call(func() { result, err = client.getDefaultConfigID(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -331,9 +356,10 @@ func (client *Szconfigmanager) RegisterConfig(
 		result int64
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzConfigManger has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(1, configDefinition, configComment)
@@ -344,7 +370,16 @@ func (client *Szconfigmanager) RegisterConfig(
 		}()
 	}
 
-	result, err = client.registerConfig(ctx, configDefinition, configComment)
+	err = client.checkInputs(
+		4001,
+		validation.Document("configDefinition", configDefinition),
+		validation.Document("configComment", configComment),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { result, err = client.registerConfig(ctx, configDefinition, configComment) })
 
 	if client.observers != nil {
 		go func() {
@@ -377,9 +412,10 @@ func (client *Szconfigmanager) ReplaceDefaultConfigID(
 ) error {
 	var err error
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return wraperror.Errorf(errForPackage, "This SzConfigManger has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(19, currentDefaultConfigID, newDefaultConfigID)
@@ -388,7 +424,9 @@ func (client *Szconfigmanager) ReplaceDefaultConfigID(
 		defer func() { client.traceExit(20, currentDefaultConfigID, newDefaultConfigID, err, time.Since(entryTime)) }()
 	}
 
-	err = client.replaceDefaultConfigID(ctx, currentDefaultConfigID, newDefaultConfigID)
+	client.executor.Call(func() {
+		err = client.replaceDefaultConfigID(ctx, currentDefaultConfigID, newDefaultConfigID)
+	})
 
 	if client.observers != nil {
 		go func() {
@@ -406,6 +444,7 @@ func (client *Szconfigmanager) ReplaceDefaultConfigID(
 Method SetDefaultConfig registers a configuration in the repository and sets its ID as the default for the repository.
 
 Convenience method for registerConfig() followed by setDefaultConfigId().
+If a gate was set with SetConfigGate(), the configuration must pass it first.
 
 Input
   - ctx: A context to control lifecycle.
@@ -422,9 +461,10 @@ func (client *Szconfigmanager) SetDefaultConfig(
 		result int64
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzConfigManger has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(27, configDefinition, configComment)
@@ -433,7 +473,25 @@ func (client *Szconfigmanager) SetDefaultConfig(
 		defer func() { client.traceExit(28, configDefinition, configComment, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.setDefaultConfigChoreography(ctx, configDefinition, configComment)
+	err = client.checkInputs(
+		4001,
+		validation.Document("configDefinition", configDefinition),
+		validation.Document("configComment", configComment),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	if client.configGate != nil {
+		err = client.configGate.CheckConfigDefinition(ctx, configDefinition)
+		if err != nil {
+			return result, wraperror.Errorf(err, "configGate.CheckConfigDefinition")
+		}
+	}
+
+	client.executor.Call(func() {
+		result, err = client.setDefaultConfigChoreography(ctx, configDefinition, configComment)
+	})
 
 	if client.observers != nil {
 		go func() {
@@ -463,9 +521,10 @@ Input
 func (client *Szconfigmanager) SetDefaultConfigID(ctx context.Context, configID int64) error {
 	var err error
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return wraperror.Errorf(errForPackage, "This SzConfigManger has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(21, configID)
@@ -474,7 +533,7 @@ func (client *Szconfigmanager) SetDefaultConfigID(ctx context.Context, configID
 		defer func() { client.traceExit(22, configID, err, time.Since(entryTime)) }()
 	}
 
-	err = client.setDefaultConfigID(ctx, configID)
+	client.executor.Call(func() { err = client.setDefaultConfigID(ctx, configID) })
 
 	if client.observers != nil {
 		go func() {
@@ -488,10 +547,50 @@ func (client *Szconfigmanager) SetDefaultConfigID(ctx context.Context, configID
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The TruncateComment function cuts a configuration comment to MaxCommentLength characters.
+
+Input
+  - configComment: A free-form description.
+
+Output
+  - The comment, at most MaxCommentLength characters long.
+*/
+func TruncateComment(configComment string) string {
+	if runes := []rune(configComment); len(runes) > MaxCommentLength {
+		return string(runes[:MaxCommentLength])
+	}
+
+	return configComment
+}
+
 // ----------------------------------------------------------------------------
 // Public non-interface methods
 // ----------------------------------------------------------------------------
 
+/*
+Method Drain stops accepting new calls and waits for calls in flight to finish.
+
+Calls made after Drain are rejected. Destroy() may still be called.
+Destroy() drains the object itself, so Drain is only needed to quiesce an object without destroying it.
+
+Input
+  - ctx: A context to bound the wait.
+    If ctx is done before the calls finish, new calls are accepted again and an error is returned.
+*/
+func (client *Szconfigmanager) Drain(ctx context.Context) error {
+	err := client.callGate.Close(ctx)
+	if err != nil && !errors.Is(err, helper.ErrCallGateClosed) {
+		return wraperror.Errorf(errForPackage, "calls in flight did not finish: %v", err)
+	}
+
+	return nil
+}
+
 func (client *Szconfigmanager) CreateConfigFromStringChoreography(
 	ctx context.Context,
 	configDefinition string,
@@ -518,6 +617,54 @@ func (client *Szconfigmanager) CreateConfigFromStringChoreography(
 	return result, wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+Method EnsureDataSources makes sure the default configuration has the data sources.
+Missing data sources are registered with [szconfig.Szconfig.EnsureDataSources];
+if any were missing, the configuration is registered and made the default.
+Otherwise nothing is registered and the default configuration is unchanged.
+
+The default configuration is replaced with ReplaceDefaultConfigID(), so an error is returned,
+and the default left as it is, if another process changed it in the meantime.
+If a gate was set with SetConfigGate(), the new configuration must pass it first.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCodes: Unique identifiers of the data sources (e.g. "TEST_DATASOURCE").
+
+Output
+  - The codes that were added and the codes that were already present,
+    and the default configuration ID afterwards.
+*/
+func (client *Szconfigmanager) EnsureDataSources(
+	ctx context.Context,
+	dataSourceCodes ...string,
+) (EnsureDataSourcesResult, error) {
+	var (
+		err    error
+		result EnsureDataSourcesResult
+	)
+
+	if !client.callGate.Enter() {
+		return result, wraperror.Errorf(errForPackage, "This SzConfigManger has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
+	client.executor.Call(func() { result, err = client.ensureDataSourcesChoreography(ctx, dataSourceCodes) })
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"added":           strings.Join(result.Added, ","),
+				"defaultConfigID": strconv.FormatInt(result.ConfigID, baseTen),
+				"present":         strings.Join(result.Present, ","),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8012, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
 /*
 Method GetObserverOrigin returns the "origin" value of past Observer messages.
 
@@ -533,6 +680,23 @@ func (client *Szconfigmanager) GetObserverOrigin(ctx context.Context) string {
 	return client.observerOrigin
 }
 
+/*
+Method Hold makes new calls wait, then waits for calls in flight to finish,
+so that the Senzing configuration can be swapped safely. Release() lets the waiting calls proceed.
+
+Input
+  - ctx: A context to bound the wait.
+    If ctx is done before the calls finish, the waiting calls proceed and an error is returned.
+*/
+func (client *Szconfigmanager) Hold(ctx context.Context) error {
+	err := client.callGate.Hold(ctx)
+	if err != nil {
+		return wraperror.Errorf(errForPackage, "cannot hold calls: %v", err)
+	}
+
+	return nil
+}
+
 /*
 Method Initialize initializes the Senzing SzConfigMgr object.
 
@@ -559,10 +723,19 @@ func (client *Szconfigmanager) Initialize(
 		defer func() { client.traceExit(18, instanceName, settings, verboseLogging, err, time.Since(entryTime)) }()
 	}
 
+	err = client.checkInputs(
+		4006,
+		validation.Identifier("instanceName", instanceName),
+		validation.Document("settings", settings),
+	)
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
 	client.instanceName = instanceName
 	client.settings = settings
 	client.verboseLogging = verboseLogging
-	err = client.init(ctx, instanceName, settings, verboseLogging)
+	client.executor.Call(func() { err = client.init(ctx, instanceName, settings, verboseLogging) })
 
 	if client.observers != nil {
 		go func() {
@@ -578,6 +751,18 @@ func (client *Szconfigmanager) Initialize(
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+Method IsDestroyed reports whether Destroy() has been called.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szconfigmanager) IsDestroyed(ctx context.Context) bool {
+	_ = ctx
+
+	return client.isDestroyed
+}
+
 /*
 Method IsInitialized inspects C binary to see if it is initialized.
 
@@ -628,6 +813,48 @@ func (client *Szconfigmanager) RegisterObserver(ctx context.Context, observer ob
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+Method Release lets the calls held by Hold() proceed.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szconfigmanager) Release(ctx context.Context) {
+	_ = ctx
+
+	client.callGate.Release()
+}
+
+/*
+Method SetConfigGate sets a check made by SetDefaultConfig() before the configuration is registered.
+If the check returns an error, SetDefaultConfig() returns it and registers nothing.
+Set the gate before making calls; it is not changed while calls are in flight.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configGate: The check to make, e.g. a szconfiglint.Gate. nil removes the check.
+*/
+func (client *Szconfigmanager) SetConfigGate(ctx context.Context, configGate ConfigGate) {
+	_ = ctx
+	client.configGate = configGate
+}
+
+/*
+Method SetExecutor routes calls into the Senzing C binary through an [helper.Executor].
+
+By default each call locks the calling goroutine to its OS thread.
+With an executor the calls run on its pool of threads, which stay locked.
+Set the executor before making calls; it is not changed while calls are in flight.
+
+Input
+  - ctx: A context to control lifecycle.
+  - executor: The executor to use. nil restores the default.
+*/
+func (client *Szconfigmanager) SetExecutor(ctx context.Context, executor *helper.Executor) {
+	_ = ctx
+	client.executor = executor
+}
+
 /*
 Method SetLogLevel sets the level of logging.
 
@@ -752,6 +979,66 @@ func (client *Szconfigmanager) createConfigFromTemplateChoreography(ctx context.
 	return result, wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+func (client *Szconfigmanager) ensureDataSourcesChoreography(
+	ctx context.Context,
+	dataSourceCodes []string,
+) (EnsureDataSourcesResult, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var result EnsureDataSourcesResult
+
+	defaultConfigID, err := client.getDefaultConfigID(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "getDefaultConfigID")
+	}
+
+	configDefinition, err := client.getConfig(ctx, defaultConfigID)
+	if err != nil {
+		return result, wraperror.Errorf(err, "getConfig(%d)", defaultConfigID)
+	}
+
+	config, err := client.CreateConfigFromStringChoreography(ctx, configDefinition)
+	if err != nil {
+		return result, wraperror.Errorf(err, "CreateConfigFromStringChoreography")
+	}
+
+	ensured, err := config.EnsureDataSources(ctx, dataSourceCodes...)
+	if err != nil {
+		return result, wraperror.Errorf(err, "EnsureDataSources")
+	}
+
+	if len(ensured.Added) == 0 {
+		return EnsureDataSourcesResult{EnsureDataSourcesResult: ensured, ConfigID: defaultConfigID}, nil
+	}
+
+	configDefinition, err = config.Export(ctx)
+	if err != nil {
+		return result, wraperror.Errorf(err, "Export")
+	}
+
+	if client.configGate != nil {
+		err = client.configGate.CheckConfigDefinition(ctx, configDefinition)
+		if err != nil {
+			return result, wraperror.Errorf(err, "configGate.CheckConfigDefinition")
+		}
+	}
+
+	configComment := TruncateComment("Added data sources " + strings.Join(ensured.Added, ", "))
+
+	configID, err := client.registerConfig(ctx, configDefinition, configComment)
+	if err != nil {
+		return result, wraperror.Errorf(err, "registerConfig")
+	}
+
+	err = client.replaceDefaultConfigID(ctx, defaultConfigID, configID)
+	if err != nil {
+		return result, wraperror.Errorf(err, "replaceDefaultConfigID(%d, %d)", defaultConfigID, configID)
+	}
+
+	return EnsureDataSourcesResult{EnsureDataSourcesResult: ensured, ConfigID: configID}, nil
+}
+
 func (client *Szconfigmanager) setDefaultConfigChoreography(
 	ctx context.Context,
 	configDefinition string,
@@ -953,7 +1240,11 @@ func (client *Szconfigmanager) setDefaultConfigID(ctx context.Context, configID
 // Get the Logger singleton.
 func (client *Szconfigmanager) getLogger() logging.Logging {
 	if client.logger == nil {
-		client.logger = helper.GetLogger(ComponentID, szconfigmanager.IDMessages, baseCallerSkip)
+		client.logger = helper.GetLogger(
+			ComponentID,
+			helper.MergeIDMessages(szconfigmanager.IDMessages, idMessages),
+			baseCallerSkip,
+		)
 	}
 
 	return client.logger
@@ -962,7 +1253,11 @@ func (client *Szconfigmanager) getLogger() logging.Logging {
 // Get the Messenger singleton.
 func (client *Szconfigmanager) getMessenger() messenger.Messenger {
 	if client.messenger == nil {
-		client.messenger = helper.GetMessenger(ComponentID, szconfigmanager.IDMessages, baseCallerSkip)
+		client.messenger = helper.GetMessenger(
+			ComponentID,
+			helper.MergeIDMessages(szconfigmanager.IDMessages, idMessages),
+			baseCallerSkip,
+		)
 	}
 
 	return client.messenger
@@ -980,7 +1275,12 @@ func (client *Szconfigmanager) traceExit(errorNumber int, details ...interface{}
 
 // --- Errors -----------------------------------------------------------------
 
-// Create a new error.
+// Check parameters before they are sent to the Senzing C binary.
+func (client *Szconfigmanager) checkInputs(errorNumber int, inputs ...validation.Input) error {
+	return helper.CheckInputs(ComponentID, errorNumber, client.getMessenger(), inputs...)
+}
+
+// Create a new error.  The returned *sdkerror.Error can be retrieved using errors.As().
 func (client *Szconfigmanager) newError(ctx context.Context, errorNumber int, details ...interface{}) error {
 	defer func() { client.panicOnError(client.clearLastException(ctx)) }()
 
@@ -991,12 +1291,20 @@ func (client *Szconfigmanager) newError(ctx context.Context, errorNumber int, de
 		lastException = err.Error()
 	}
 
+	arguments := details
 	details = append(details, messenger.MessageCode{Value: fmt.Sprintf(ExceptionCodeTemplate, lastExceptionCode)})
 	details = append(details, messenger.MessageReason{Value: lastException})
 	details = append(details, wraperror.Errorf(szerror.ErrSz, "exception: %s", lastException))
 	errorMessage := client.getMessenger().NewJSON(errorNumber, details...)
 
-	return szerror.New(lastExceptionCode, errorMessage) //nolint
+	return sdkerror.New(
+		ComponentID,
+		errorNumber,
+		lastExceptionCode,
+		lastException,
+		arguments,
+		szerror.New(lastExceptionCode, errorMessage),
+	)
 }
 
 /*
@@ -1060,9 +1368,11 @@ func (client *Szconfigmanager) getLastException(ctx context.Context) (string, er
 		defer func() { client.traceExit(14, result, err, time.Since(entryTime)) }()
 	}
 
-	stringBuffer := client.getByteArray(initialByteArraySize)
-	C.SzConfigMgr_getLastException((*C.char)(unsafe.Pointer(&stringBuffer[0])), C.size_t(len(stringBuffer)))
-	result = string(bytes.Trim(stringBuffer, "\x00"))
+	stringBuffer := helper.GetExceptionBuffer()
+	defer helper.PutExceptionBuffer(stringBuffer)
+
+	C.SzConfigMgr_getLastException((*C.char)(unsafe.Pointer(&(*stringBuffer)[0])), C.size_t(len(*stringBuffer)))
+	result = helper.NulTerminatedString(*stringBuffer)
 
 	return result, err
 }
@@ -1098,17 +1408,6 @@ func (client *Szconfigmanager) getLastExceptionCode(ctx context.Context) (int, e
 
 // --- Misc -------------------------------------------------------------------
 
-// Get space for an array of bytes of a given size.
-// func (client *Szconfigmanager) getByteArrayC(size int) *C.char {
-// 	bytes := C.malloc(C.size_t(size))
-// 	return (*C.char)(bytes)
-// }
-
-// Make a byte array.
-func (client *Szconfigmanager) getByteArray(size int) []byte {
-	return make([]byte, size)
-}
-
 // A hack: Only needed to import the "senzing" package for the godoc comments.
 // func junk() {
 // 	fmt.Printf(senzing.SzNoAttributes)
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/sz-sdk-go-core/szabstractfactory"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfig"
+	"github.com/senzing-garage/sz-sdk-go-core/szconfiglint"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-go/szerror"
@@ -134,6 +136,17 @@ func TestSzconfigmanager_CreateConfigFromString_badConfigDefinition(test *testin
 	require.JSONEq(test, expectedErr, err.Error())
 }
 
+func TestSzconfigmanager_CreateConfigFromString_nulByte(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := getTestObject(test)
+	actual, err := szConfigManager.CreateConfigFromString(ctx, "{\"G2_CONFIG\": {}}\x00")
+	printDebug(test, err, actual)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+
+	expectedErr := `{"function":"szconfigmanager.(*Szconfigmanager).CreateConfigFromString","error":{"id":"SZSDK60024009","reason":"configDefinition contains a NUL byte at offset 17"}}`
+	require.JSONEq(test, expectedErr, err.Error())
+}
+
 func TestSzconfigmanager_CreateConfigFromTemplate(test *testing.T) {
 	ctx := test.Context()
 	szConfigManager := getTestObject(test)
@@ -143,6 +156,52 @@ func TestSzconfigmanager_CreateConfigFromTemplate(test *testing.T) {
 	assert.NotEmpty(test, actual)
 }
 
+func TestSzconfigmanager_EnsureDataSources(test *testing.T) {
+	ctx := test.Context()
+	now := time.Now()
+	szConfigManager := getTestObject(test)
+	dataSourceCode := "GO_TEST_ENSURE_" + strconv.FormatInt(now.Unix(), baseTen)
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+
+	actual, err := szConfigManager.EnsureDataSources(ctx, "CUSTOMERS", dataSourceCode)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	assert.Equal(test, []string{dataSourceCode}, actual.Added)
+	assert.Equal(test, []string{"CUSTOMERS"}, actual.Present)
+	assert.NotEqual(test, defaultConfigID, actual.ConfigID)
+
+	newDefaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, actual.ConfigID, newDefaultConfigID)
+
+	// Nothing missing: nothing registered.
+
+	actual, err = szConfigManager.EnsureDataSources(ctx, dataSourceCode)
+	require.NoError(test, err)
+	assert.Empty(test, actual.Added)
+	assert.Equal(test, newDefaultConfigID, actual.ConfigID)
+}
+
+func TestSzconfigmanager_EnsureDataSources_otherSzConfig(test *testing.T) {
+	ctx := test.Context()
+	now := time.Now()
+	szConfigManager := getTestObject(test)
+	dataSourceCode := "GO_TEST_ENSURE_OTHER_" + strconv.FormatInt(now.Unix(), baseTen)
+	szConfig, err := szConfigManager.CreateConfigFromTemplate(ctx)
+	require.NoError(test, err)
+
+	_, err = szConfigManager.EnsureDataSources(ctx, dataSourceCode)
+	require.NoError(test, err)
+
+	// The SzConfig created before EnsureDataSources() still works.
+
+	actual, err := szConfig.GetDataSourceRegistry(ctx)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+	assert.Contains(test, actual, "TEST")
+}
+
 func TestSzconfigmanager_GetConfigRegistry(test *testing.T) {
 	ctx := test.Context()
 	szConfigManager := getTestObject(test)
@@ -319,6 +378,34 @@ func TestSzconfigmanager_SetDefaultConfig(test *testing.T) {
 	require.NotZero(test, configID)
 }
 
+func TestSzconfigmanager_SetDefaultConfig_configGate(test *testing.T) {
+	ctx := test.Context()
+	now := time.Now()
+	szConfigManager := getTestObject(test)
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, defaultConfigID)
+	require.NoError(test, err)
+
+	dataSourceCode := "GO_TEST_" + strconv.FormatInt(now.Unix(), baseTen)
+	_, err = szConfig.RegisterDataSource(ctx, dataSourceCode)
+	require.NoError(test, err)
+	configDefinition, err := szConfig.Export(ctx)
+	require.NoError(test, err)
+
+	szConfigManager.SetConfigGate(ctx, szconfiglint.Gate{MinSeverity: szconfiglint.SeverityInfo})
+	defer szConfigManager.SetConfigGate(ctx, nil)
+
+	configID, err := szConfigManager.SetDefaultConfig(ctx, configDefinition, "Added "+dataSourceCode)
+	printDebug(test, err, configID)
+	require.ErrorIs(test, err, szconfiglint.ErrRejected)
+	require.Zero(test, configID)
+
+	actual, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, defaultConfigID, actual)
+}
+
 func TestSzconfigmanager_SetDefaultConfigID(test *testing.T) {
 	ctx := test.Context()
 	szConfigManager := getTestObject(test)
@@ -352,6 +439,15 @@ func TestSzconfigmanager_SetDefaultConfigID_nilConfigID(test *testing.T) {
 	require.JSONEq(test, expectedErr, err.Error())
 }
 
+func TestTruncateComment(test *testing.T) {
+	test.Parallel()
+
+	assert.Equal(test, "Add WATCHLIST", szconfigmanager.TruncateComment("Add WATCHLIST"))
+
+	long := strings.Repeat("é", szconfigmanager.MaxCommentLength+1)
+	assert.Equal(test, long[:2*szconfigmanager.MaxCommentLength], szconfigmanager.TruncateComment(long))
+}
+
 // ----------------------------------------------------------------------------
 // Logging and observing
 // ----------------------------------------------------------------------------
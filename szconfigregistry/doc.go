@@ -0,0 +1,23 @@
+/*
+Package szconfigregistry lists the registered Senzing configurations and rolls the default configuration back.
+
+GetConfigRegistry() of [senzing.SzConfigManager] returns a JSON document.
+A [Registry] returns it as [Entry] values, oldest first, with the default configuration marked,
+and selects entries by creation time or comment with a [Filter]:
+
+	registry := szconfigregistry.New(szConfigManager)
+	entries, err := registry.Find(ctx, szconfigregistry.Filter{
+		CommentContains: "watchlist",
+		Since:           time.Now().AddDate(0, -1, 0),
+	})
+
+[Registry.Rollback] makes an earlier configuration the default again, registering it with a comment
+giving the reason and replacing the default configuration only if nobody changed it meanwhile.
+As configuration IDs are derived from the definition, the definition is marked with a top-level
+"ROLLBACK" member so that the comment gets a configuration of its own:
+
+	result, err := registry.Rollback(ctx, entries[0].ConfigID, "WATCHLIST matching too loose")
+
+[senzing.SzConfigManager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzConfigManager
+*/
+package szconfigregistry
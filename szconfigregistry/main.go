@@ -0,0 +1,65 @@
+package szconfigregistry
+
+import (
+	"context"
+	"errors"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type ConfigManager interface is the part of [senzing.SzConfigManager] used for the registry.
+Any senzing.SzConfigManager satisfies it.
+
+[senzing.SzConfigManager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzConfigManager
+*/
+type ConfigManager interface {
+	CreateConfigFromConfigID(ctx context.Context, configID int64) (senzing.SzConfig, error)
+	GetConfigRegistry(ctx context.Context) (string, error)
+	GetDefaultConfigID(ctx context.Context) (int64, error)
+	RegisterConfig(ctx context.Context, configDefinition string, configComment string) (int64, error)
+	ReplaceDefaultConfigID(ctx context.Context, currentDefaultConfigID int64, newDefaultConfigID int64) error
+}
+
+// A GetConfigRegistry() response.
+type registryDocument struct {
+	Configs []struct {
+		Comment       string `json:"CONFIG_COMMENTS"`
+		ConfigID      int64  `json:"CONFIG_ID"`
+		SysCreateDate string `json:"SYS_CREATE_DT"`
+	} `json:"CONFIGS"`
+}
+
+// The rollbackKey member of a configuration registered by Registry.Rollback().
+type rollbackMarker struct {
+	Comment      string `json:"COMMENT"`
+	FromConfigID int64  `json:"FROM_CONFIG_ID"`
+	ToConfigID   int64  `json:"TO_CONFIG_ID"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// The top-level member marking a configuration registered by Registry.Rollback(). Senzing ignores it.
+const rollbackKey = "ROLLBACK"
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var (
+	errCommentNotRecorded = errors.New("the repository kept the configuration and its comment")
+	errUnknownConfigID    = errors.New("configuration is not registered")
+)
+
+// Layouts of SYS_CREATE_DT, which depend on the database.
+var sysCreateDateLayouts = []string{
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+}
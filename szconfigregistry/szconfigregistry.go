@@ -0,0 +1,313 @@
+package szconfigregistry
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
+)
+
+/*
+Type Entry struct is a registered configuration.
+*/
+type Entry struct {
+	Comment   string    `json:"CONFIG_COMMENTS"`
+	ConfigID  int64     `json:"CONFIG_ID"`
+	CreatedAt time.Time `json:"SYS_CREATE_DT"`
+	IsDefault bool      `json:"IS_DEFAULT"`
+}
+
+/*
+Type Filter struct selects registered configurations. Zero values select everything.
+*/
+type Filter struct {
+	Before          time.Time // Created before this time.
+	CommentContains string    // Text in the comment, ignoring case.
+	Since           time.Time // Created at or after this time.
+}
+
+/*
+Type Registry struct lists the registered configurations and rolls the default configuration back.
+*/
+type Registry struct {
+	configManager ConfigManager
+}
+
+/*
+Type RollbackResult struct describes a rollback.
+*/
+type RollbackResult struct {
+	Comment      string // The comment of the registered configuration.
+	ConfigID     int64  // The new default configuration.
+	FromConfigID int64  // The previous default configuration.
+	ToConfigID   int64  // The configuration rolled back to.
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The New function returns a Registry of the configurations of a senzing.SzConfigManager.
+
+Input
+  - configManager: A senzing.SzConfigManager.
+*/
+func New(configManager ConfigManager) *Registry {
+	return &Registry{configManager: configManager}
+}
+
+/*
+The Parse function parses a GetConfigRegistry() response.
+
+Input
+  - configRegistry: The JSON document returned by GetConfigRegistry().
+  - defaultConfigID: The configuration to mark as the default, or 0.
+
+Output
+  - The registered configurations, oldest first.
+*/
+func Parse(configRegistry string, defaultConfigID int64) ([]Entry, error) {
+	var document registryDocument
+
+	err := json.Unmarshal([]byte(configRegistry), &document)
+	if err != nil {
+		return nil, fmt.Errorf("parsing configuration registry: %w", err)
+	}
+
+	result := make([]Entry, 0, len(document.Configs))
+
+	for _, config := range document.Configs {
+		createdAt, err := parseSysCreateDate(config.SysCreateDate)
+		if err != nil {
+			return nil, fmt.Errorf("configuration %d: %w", config.ConfigID, err)
+		}
+
+		result = append(result, Entry{
+			Comment:   config.Comment,
+			ConfigID:  config.ConfigID,
+			CreatedAt: createdAt,
+			IsDefault: config.ConfigID == defaultConfigID,
+		})
+	}
+
+	slices.SortStableFunc(result, func(a Entry, b Entry) int {
+		if order := a.CreatedAt.Compare(b.CreatedAt); order != 0 {
+			return order
+		}
+
+		return cmp.Compare(a.ConfigID, b.ConfigID)
+	})
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Matches reports whether a registered configuration is selected by the filter.
+*/
+func (filter Filter) Matches(entry Entry) bool {
+	switch {
+	case !filter.Since.IsZero() && entry.CreatedAt.Before(filter.Since):
+		return false
+	case !filter.Before.IsZero() && !entry.CreatedAt.Before(filter.Before):
+		return false
+	case filter.CommentContains != "" &&
+		!strings.Contains(strings.ToLower(entry.Comment), strings.ToLower(filter.CommentContains)):
+		return false
+	default:
+		return true
+	}
+}
+
+/*
+Method Find lists the registered configurations selected by a filter.
+
+Input
+  - ctx: A context to control lifecycle.
+  - filter: The selection.
+
+Output
+  - The registered configurations, oldest first.
+*/
+func (registry *Registry) Find(ctx context.Context, filter Filter) ([]Entry, error) {
+	entries, err := registry.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(entries, func(entry Entry) bool { return !filter.Matches(entry) }), nil
+}
+
+/*
+Method List lists the registered configurations, marking the default configuration.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The registered configurations, oldest first.
+*/
+func (registry *Registry) List(ctx context.Context) ([]Entry, error) {
+	defaultConfigID, err := registry.configManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	configRegistry, err := registry.configManager.GetConfigRegistry(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return Parse(configRegistry, defaultConfigID)
+}
+
+/*
+Method Rollback makes an earlier configuration the default again.
+
+The configuration is registered again with a comment giving the reason,
+e.g. "Rollback from 2276722953 to 351539198: bad matching", and made the default
+with ReplaceDefaultConfigID(), which fails with szerror.ErrSzReplaceConflict
+if the default configuration changed meanwhile.
+Configuration IDs are derived from the configuration definition,
+so a top-level "ROLLBACK" member describing the rollback is added to the definition
+to get a new configuration ID for the comment.
+Senzing ignores the member; the data sources, features and rules are those of toConfigID.
+If the repository still returns toConfigID, an error is returned and the default is not changed.
+Rolling back to the default configuration changes nothing.
+
+Input
+  - ctx: A context to control lifecycle.
+  - toConfigID: The registered configuration to roll back to.
+  - reason: Why, for the comment.
+
+Output
+  - What was done.
+*/
+func (registry *Registry) Rollback(ctx context.Context, toConfigID int64, reason string) (*RollbackResult, error) {
+	entries, err := registry.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fromConfigID := int64(0)
+	isRegistered := false
+
+	for _, entry := range entries {
+		if entry.IsDefault {
+			fromConfigID = entry.ConfigID
+		}
+
+		isRegistered = isRegistered || entry.ConfigID == toConfigID
+	}
+
+	if !isRegistered {
+		return nil, fmt.Errorf("rollback to %d: %w", toConfigID, errUnknownConfigID)
+	}
+
+	result := &RollbackResult{
+		Comment:      "",
+		ConfigID:     fromConfigID,
+		FromConfigID: fromConfigID,
+		ToConfigID:   toConfigID,
+	}
+	if fromConfigID == toConfigID {
+		return result, nil
+	}
+
+	config, err := registry.configManager.CreateConfigFromConfigID(ctx, toConfigID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	configDefinition, err := config.Export(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	result.Comment = rollbackComment(fromConfigID, toConfigID, reason)
+
+	configDefinition, err = markRollback(configDefinition, rollbackMarker{
+		Comment:      result.Comment,
+		FromConfigID: fromConfigID,
+		ToConfigID:   toConfigID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rollback to %d: %w", toConfigID, err)
+	}
+
+	result.ConfigID, err = registry.configManager.RegisterConfig(ctx, configDefinition, result.Comment)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if result.ConfigID == toConfigID {
+		return nil, fmt.Errorf("rollback to %d: %w", toConfigID, errCommentNotRecorded)
+	}
+
+	if result.ConfigID != fromConfigID {
+		err = registry.configManager.ReplaceDefaultConfigID(ctx, fromConfigID, result.ConfigID)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// The configuration definition with the rollbackKey member set to the marker.
+func markRollback(configDefinition string, marker rollbackMarker) (string, error) {
+	var document map[string]json.RawMessage
+
+	err := json.Unmarshal([]byte(configDefinition), &document)
+	if err != nil {
+		return "", fmt.Errorf("configuration definition: %w", err)
+	}
+
+	if document == nil {
+		document = map[string]json.RawMessage{}
+	}
+
+	document[rollbackKey], err = json.Marshal(marker)
+	if err != nil {
+		return "", fmt.Errorf("rollback marker: %w", err)
+	}
+
+	result, err := json.Marshal(document)
+
+	return string(result), err //nolint:wrapcheck
+}
+
+func parseSysCreateDate(sysCreateDate string) (time.Time, error) {
+	for _, layout := range sysCreateDateLayouts {
+		result, err := time.Parse(layout, sysCreateDate)
+		if err == nil {
+			return result, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unknown SYS_CREATE_DT format %s", strconv.Quote(sysCreateDate)) //nolint:err113
+}
+
+// The comment of a rollback, cut with szconfigmanager.TruncateComment.
+func rollbackComment(fromConfigID int64, toConfigID int64, reason string) string {
+	result := fmt.Sprintf("Rollback from %d to %d", fromConfigID, toConfigID)
+	if reason = strings.TrimSpace(reason); reason != "" {
+		result += ": " + reason
+	}
+
+	return szconfigmanager.TruncateComment(result)
+}
@@ -0,0 +1,219 @@
+package szconfigregistry_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/internal/testhelper"
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigregistry"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const configRegistry = `{"CONFIGS": [
+	{"CONFIG_COMMENTS": "Add WATCHLIST", "CONFIG_ID": 351539198, "SYS_CREATE_DT": "2025-09-22T22:00:29Z"},
+	{"CONFIG_COMMENTS": "Created by init-database", "CONFIG_ID": 2276722953, "SYS_CREATE_DT": "2025-09-08T17:40:22Z"},
+	{"CONFIG_COMMENTS": "Add CUSTOMERS", "CONFIG_ID": 4016704640, "SYS_CREATE_DT": "2025-10-05 15:45:18.847"}
+]}`
+
+// A fake SzConfigManager with the configurations of configRegistry.
+func newFakeConfigManager(defaultConfigID int64) *testhelper.ConfigManager {
+	result := testhelper.NewConfigManager()
+	result.AddConfig(351539198, configDefinition("WATCHLIST"), "Add WATCHLIST", time.Date(2025, 9, 22, 22, 0, 29, 0, time.UTC))
+	result.AddConfig(2276722953, configDefinition(), "Created by init-database", time.Date(2025, 9, 8, 17, 40, 22, 0, time.UTC))
+	result.AddConfig(4016704640, configDefinition("CUSTOMERS"), "Add CUSTOMERS", time.Date(2025, 10, 5, 15, 45, 18, 0, time.UTC))
+	result.DefaultConfigID = defaultConfigID
+
+	return result
+}
+
+// A configuration definition with the data sources.
+func configDefinition(dataSourceCodes ...string) string {
+	dataSources := []string{}
+	for _, dataSourceCode := range dataSourceCodes {
+		dataSources = append(dataSources, `{"DSRC_CODE": "`+dataSourceCode+`"}`)
+	}
+
+	return `{"G2_CONFIG": {"CFG_DSRC": [` + strings.Join(dataSources, ", ") + `]}}`
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestParse(test *testing.T) {
+	test.Parallel()
+
+	entries, err := szconfigregistry.Parse(configRegistry, 351539198)
+	require.NoError(test, err)
+	require.Len(test, entries, 3)
+	assert.Equal(test, szconfigregistry.Entry{
+		Comment:   "Created by init-database",
+		ConfigID:  2276722953,
+		CreatedAt: time.Date(2025, 9, 8, 17, 40, 22, 0, time.UTC),
+		IsDefault: false,
+	}, entries[0])
+	assert.Equal(test, int64(351539198), entries[1].ConfigID)
+	assert.True(test, entries[1].IsDefault)
+	assert.Equal(test, time.Date(2025, 10, 5, 15, 45, 18, 847000000, time.UTC), entries[2].CreatedAt)
+
+	_, err = szconfigregistry.Parse(`{"CONFIGS": [{"CONFIG_ID": 1, "SYS_CREATE_DT": "yesterday"}]}`, 0)
+	require.ErrorContains(test, err, "yesterday")
+}
+
+func TestFilter_Matches(test *testing.T) {
+	test.Parallel()
+
+	entry := szconfigregistry.Entry{
+		Comment:   "Add WATCHLIST",
+		CreatedAt: time.Date(2025, 9, 22, 22, 0, 29, 0, time.UTC),
+	}
+	assert.True(test, szconfigregistry.Filter{}.Matches(entry))
+	assert.True(test, szconfigregistry.Filter{CommentContains: "watchlist"}.Matches(entry))
+	assert.False(test, szconfigregistry.Filter{CommentContains: "customers"}.Matches(entry))
+	assert.True(test, szconfigregistry.Filter{Since: entry.CreatedAt}.Matches(entry))
+	assert.False(test, szconfigregistry.Filter{Before: entry.CreatedAt}.Matches(entry))
+	assert.False(test, szconfigregistry.Filter{Since: entry.CreatedAt.Add(time.Second)}.Matches(entry))
+}
+
+func TestRegistry_Find(test *testing.T) {
+	test.Parallel()
+
+	manager := newFakeConfigManager(4016704640)
+	entries, err := szconfigregistry.New(manager).Find(test.Context(), szconfigregistry.Filter{
+		CommentContains: "add",
+		Since:           time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(test, err)
+	require.Len(test, entries, 1)
+	assert.Equal(test, int64(4016704640), entries[0].ConfigID)
+	assert.True(test, entries[0].IsDefault)
+}
+
+func TestRegistry_Rollback(test *testing.T) {
+	test.Parallel()
+
+	manager := newFakeConfigManager(4016704640)
+	result, err := szconfigregistry.New(manager).Rollback(test.Context(), 351539198, "CUSTOMERS mapping broken")
+	require.NoError(test, err)
+	assert.Equal(test, &szconfigregistry.RollbackResult{
+		Comment:      "Rollback from 4016704640 to 351539198: CUSTOMERS mapping broken",
+		ConfigID:     4016704641,
+		FromConfigID: 4016704640,
+		ToConfigID:   351539198,
+	}, result)
+	assert.Equal(test, result.Comment, manager.Comments[result.ConfigID])
+	assert.JSONEq(test, `{
+		"G2_CONFIG": {"CFG_DSRC": [{"DSRC_CODE": "WATCHLIST"}]},
+		"ROLLBACK": {
+			"COMMENT": "Rollback from 4016704640 to 351539198: CUSTOMERS mapping broken",
+			"FROM_CONFIG_ID": 4016704640,
+			"TO_CONFIG_ID": 351539198
+		}
+	}`, manager.Definitions[result.ConfigID])
+	assert.Equal(test, result.ConfigID, manager.DefaultConfigID)
+	assert.Len(test, manager.Definitions, 4)
+}
+
+func TestRegistry_Rollback_commentNotRecorded(test *testing.T) {
+	test.Parallel()
+
+	// The marked definition is already registered, as if the repository ignored the marker.
+	manager := newFakeConfigManager(4016704640)
+	manager.AddConfig(42, `{"ROLLBACK":{"COMMENT":"Rollback from 4016704640 to 42","FROM_CONFIG_ID":4016704640,"TO_CONFIG_ID":42}}`,
+		"Before", time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC))
+
+	_, err := szconfigregistry.New(manager).Rollback(test.Context(), 42, "")
+	require.ErrorContains(test, err, "comment")
+	assert.Equal(test, int64(4016704640), manager.DefaultConfigID)
+}
+
+func TestRegistry_Rollback_longReason(test *testing.T) {
+	test.Parallel()
+
+	manager := newFakeConfigManager(4016704640)
+	result, err := szconfigregistry.New(manager).Rollback(test.Context(), 351539198, strings.Repeat("é", 500))
+	require.NoError(test, err)
+	assert.Len(test, []rune(result.Comment), szconfigmanager.MaxCommentLength)
+}
+
+func TestRegistry_Rollback_toDefault(test *testing.T) {
+	test.Parallel()
+
+	manager := newFakeConfigManager(351539198)
+	result, err := szconfigregistry.New(manager).Rollback(test.Context(), 351539198, "")
+	require.NoError(test, err)
+	assert.Equal(test, int64(351539198), result.ConfigID)
+	assert.Len(test, manager.Definitions, 3)
+}
+
+func TestRegistry_Rollback_unknownConfigID(test *testing.T) {
+	test.Parallel()
+
+	manager := newFakeConfigManager(351539198)
+	_, err := szconfigregistry.New(manager).Rollback(test.Context(), 42, "")
+	require.ErrorContains(test, err, "42")
+	assert.Len(test, manager.Definitions, 3)
+}
+
+func TestRegistry_Rollback_conflict(test *testing.T) {
+	test.Parallel()
+
+	manager := newFakeConfigManager(4016704640)
+	manager.Conflicts = 1
+	manager.Rival = func(string) string { return configDefinition("REFERENCE") }
+	_, err := szconfigregistry.New(manager).Rollback(test.Context(), 351539198, "")
+	require.ErrorIs(test, err, szerror.ErrSzReplaceConflict)
+	assert.Equal(test, "Rival", manager.Comments[manager.DefaultConfigID], "the configuration of the process that won the race")
+}
+
+func TestRegistry_Rollback_szConfigManager(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := testhelper.NewSzConfigManager(test, "CUSTOMERS")
+
+	goodConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+
+	szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, goodConfigID)
+	require.NoError(test, err)
+	_, err = szConfig.RegisterDataSource(ctx, "WATCHLIST")
+	require.NoError(test, err)
+	configDefinition, err := szConfig.Export(ctx)
+	require.NoError(test, err)
+	badConfigID, err := szConfigManager.SetDefaultConfig(ctx, configDefinition, "Add WATCHLIST")
+	require.NoError(test, err)
+
+	registry := szconfigregistry.New(szConfigManager)
+	result, err := registry.Rollback(ctx, goodConfigID, "WATCHLIST mapping broken")
+	require.NoError(test, err)
+	assert.Equal(test, badConfigID, result.FromConfigID)
+
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, result.ConfigID, defaultConfigID)
+
+	szConfig, err = szConfigManager.CreateConfigFromConfigID(ctx, defaultConfigID)
+	require.NoError(test, err)
+	dataSourceRegistry, err := szConfig.GetDataSourceRegistry(ctx)
+	require.NoError(test, err)
+	assert.Contains(test, dataSourceRegistry, "CUSTOMERS")
+	assert.NotContains(test, dataSourceRegistry, "WATCHLIST")
+
+	// The reason is recorded as the comment of a new configuration.
+
+	assert.NotEqual(test, goodConfigID, result.ConfigID)
+
+	entries, err := registry.List(ctx)
+	require.NoError(test, err)
+
+	comments := map[int64]string{}
+	for _, entry := range entries {
+		comments[entry.ConfigID] = entry.Comment
+	}
+
+	assert.Equal(test, result.Comment, comments[result.ConfigID])
+	assert.Equal(test, "Created by "+test.Name(), comments[goodConfigID])
+}
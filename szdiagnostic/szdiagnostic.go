@@ -15,8 +15,8 @@ package szdiagnostic
 import "C"
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"runtime"
 	"strconv"
@@ -30,6 +30,8 @@ import (
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/go-observing/subject"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/sdkerror"
+	"github.com/senzing-garage/sz-sdk-go-core/validation"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-go/szdiagnostic"
 	"github.com/senzing-garage/sz-sdk-go/szerror"
@@ -40,6 +42,8 @@ Type Szdiagnostic struct implements the [senzing.SzDiagnostic] interface
 for communicating with the Senzing C binaries.
 */
 type Szdiagnostic struct {
+	callGate       helper.CallGate
+	executor       *helper.Executor
 	instanceName   string
 	isDestroyed    bool
 	isTrace        bool
@@ -52,11 +56,10 @@ type Szdiagnostic struct {
 }
 
 const (
-	baseCallerSkip       = 4
-	baseTen              = 10
-	initialByteArraySize = 65535
-	noError              = 0
-	uninitializedError   = -1
+	baseCallerSkip     = 4
+	baseTen            = 10
+	noError            = 0
+	uninitializedError = -1
 )
 
 // ----------------------------------------------------------------------------
@@ -85,9 +88,10 @@ func (client *Szdiagnostic) CheckRepositoryPerformance(ctx context.Context, seco
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzDiagnostic has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(1, secondsToRun)
@@ -96,7 +100,7 @@ func (client *Szdiagnostic) CheckRepositoryPerformance(ctx context.Context, seco
 		defer func() { client.traceExit(2, secondsToRun, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.checkRepositoryPerformance(ctx, secondsToRun)
+	client.executor.Call(func() { result, err = client.checkRepositoryPerformance(ctx, secondsToRun) })
 
 	if client.observers != nil {
 		go func() {
@@ -113,6 +117,10 @@ Method Destroy will destroy and perform cleanup for the Senzing SzDiagnostic obj
 
 It should be called after all other calls are complete.
 
+New calls are rejected once Destroy is called.
+Calls already in flight are given until ctx is done to finish;
+if they do not, nothing is destroyed and an error is returned.
+
 Input
   - ctx: A context to control lifecycle.
 */
@@ -123,6 +131,11 @@ func (client *Szdiagnostic) Destroy(ctx context.Context) error {
 		return wraperror.Errorf(errForPackage, "This SzDiagnostic has been destroyed.")
 	}
 
+	err = client.Drain(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
 	if client.isTrace {
 		client.traceEntry(5)
 
@@ -130,7 +143,7 @@ func (client *Szdiagnostic) Destroy(ctx context.Context) error {
 		defer func() { client.traceExit(6, err, time.Since(entryTime)) }()
 	}
 
-	err = client.destroy(ctx)
+	client.executor.Call(func() { err = client.destroy(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -161,9 +174,10 @@ func (client *Szdiagnostic) GetFeature(ctx context.Context, featureID int64) (st
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzDiagnostic has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(9, featureID)
@@ -172,7 +186,7 @@ func (client *Szdiagnostic) GetFeature(ctx context.Context, featureID int64) (st
 		defer func() { client.traceExit(10, featureID, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.getFeature(ctx, featureID)
+	client.executor.Call(func() { result, err = client.getFeature(ctx, featureID) })
 
 	if client.observers != nil {
 		go func() {
@@ -202,9 +216,10 @@ func (client *Szdiagnostic) GetRepositoryInfo(ctx context.Context) (string, erro
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzDiagnostic has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(7)
@@ -213,7 +228,7 @@ func (client *Szdiagnostic) GetRepositoryInfo(ctx context.Context) (string, erro
 		defer func() { client.traceExit(8, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.getRepositoryInfo(ctx)
+	client.executor.Call(func() { result, err = client.getRepositoryInfo(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -240,9 +255,10 @@ Input
 func (client *Szdiagnostic) PurgeRepository(ctx context.Context) error {
 	var err error
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return wraperror.Errorf(errForPackage, "This SzDiagnostic has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(17)
@@ -251,7 +267,7 @@ func (client *Szdiagnostic) PurgeRepository(ctx context.Context) error {
 		defer func() { client.traceExit(18, err, time.Since(entryTime)) }()
 	}
 
-	err = client.purgeRepository(ctx)
+	client.executor.Call(func() { err = client.purgeRepository(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -267,6 +283,25 @@ func (client *Szdiagnostic) PurgeRepository(ctx context.Context) error {
 // Public non-interface methods
 // ----------------------------------------------------------------------------
 
+/*
+Method Drain stops accepting new calls and waits for calls in flight to finish.
+
+Calls made after Drain are rejected. Destroy() may still be called.
+Destroy() drains the object itself, so Drain is only needed to quiesce an object without destroying it.
+
+Input
+  - ctx: A context to bound the wait.
+    If ctx is done before the calls finish, new calls are accepted again and an error is returned.
+*/
+func (client *Szdiagnostic) Drain(ctx context.Context) error {
+	err := client.callGate.Close(ctx)
+	if err != nil && !errors.Is(err, helper.ErrCallGateClosed) {
+		return wraperror.Errorf(errForPackage, "calls in flight did not finish: %v", err)
+	}
+
+	return nil
+}
+
 /*
 Method GetObserverOrigin returns the "origin" value of past Observer messages.
 
@@ -282,6 +317,23 @@ func (client *Szdiagnostic) GetObserverOrigin(ctx context.Context) string {
 	return client.observerOrigin
 }
 
+/*
+Method Hold makes new calls wait, then waits for calls in flight to finish,
+so that the Senzing configuration can be swapped safely. Release() lets the waiting calls proceed.
+
+Input
+  - ctx: A context to bound the wait.
+    If ctx is done before the calls finish, the waiting calls proceed and an error is returned.
+*/
+func (client *Szdiagnostic) Hold(ctx context.Context) error {
+	err := client.callGate.Hold(ctx)
+	if err != nil {
+		return wraperror.Errorf(errForPackage, "cannot hold calls: %v", err)
+	}
+
+	return nil
+}
+
 /*
 Method Initialize initializes the SzDiagnostic object.
 
@@ -312,14 +364,31 @@ func (client *Szdiagnostic) Initialize(
 		}()
 	}
 
+	errorNumber := 4005 // See init() and initWithConfigID().
+
+	if configID != senzing.SzInitializeWithDefaultConfiguration {
+		errorNumber = 4006
+	}
+
+	err = client.checkInputs(
+		errorNumber,
+		validation.Identifier("instanceName", instanceName),
+		validation.Document("settings", settings),
+	)
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
 	client.instanceName = instanceName
 	client.settings = settings
 	client.verboseLogging = verboseLogging
 
 	if configID == senzing.SzInitializeWithDefaultConfiguration {
-		err = client.init(ctx, instanceName, settings, verboseLogging)
+		client.executor.Call(func() { err = client.init(ctx, instanceName, settings, verboseLogging) })
 	} else {
-		err = client.initWithConfigID(ctx, instanceName, settings, configID, verboseLogging)
+		client.executor.Call(func() {
+			err = client.initWithConfigID(ctx, instanceName, settings, configID, verboseLogging)
+		})
 	}
 
 	if client.observers != nil {
@@ -337,6 +406,18 @@ func (client *Szdiagnostic) Initialize(
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+Method IsDestroyed reports whether Destroy() has been called.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szdiagnostic) IsDestroyed(ctx context.Context) bool {
+	_ = ctx
+
+	return client.isDestroyed
+}
+
 /*
 Method IsInitialized inspects C binary to see if it is initialized.
 
@@ -397,6 +478,11 @@ Input
 func (client *Szdiagnostic) Reinitialize(ctx context.Context, configID int64) error {
 	var err error
 
+	if !client.callGate.Enter() {
+		return wraperror.Errorf(errForPackage, "This SzDiagnostic has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
 	if client.isTrace {
 		client.traceEntry(19, configID)
 
@@ -404,7 +490,7 @@ func (client *Szdiagnostic) Reinitialize(ctx context.Context, configID int64) er
 		defer func() { client.traceExit(20, configID, err, time.Since(entryTime)) }()
 	}
 
-	err = client.reinit(ctx, configID)
+	client.executor.Call(func() { err = client.reinit(ctx, configID) })
 
 	if client.observers != nil {
 		go func() {
@@ -418,6 +504,34 @@ func (client *Szdiagnostic) Reinitialize(ctx context.Context, configID int64) er
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+Method Release lets the calls held by Hold() proceed.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szdiagnostic) Release(ctx context.Context) {
+	_ = ctx
+
+	client.callGate.Release()
+}
+
+/*
+Method SetExecutor routes calls into the Senzing C binary through an [helper.Executor].
+
+By default each call locks the calling goroutine to its OS thread.
+With an executor the calls run on its pool of threads, which stay locked.
+Set the executor before making calls; it is not changed while calls are in flight.
+
+Input
+  - ctx: A context to control lifecycle.
+  - executor: The executor to use. nil restores the default.
+*/
+func (client *Szdiagnostic) SetExecutor(ctx context.Context, executor *helper.Executor) {
+	_ = ctx
+	client.executor = executor
+}
+
 /*
 Method SetLogLevel sets the level of logging.
 
@@ -729,7 +843,12 @@ func (client *Szdiagnostic) traceExit(errorNumber int, details ...interface{}) {
 
 // --- Errors -----------------------------------------------------------------
 
-// Create a new error.
+// Check parameters before they are sent to the Senzing C binary.
+func (client *Szdiagnostic) checkInputs(errorNumber int, inputs ...validation.Input) error {
+	return helper.CheckInputs(ComponentID, errorNumber, client.getMessenger(), inputs...)
+}
+
+// Create a new error.  The returned *sdkerror.Error can be retrieved using errors.As().
 func (client *Szdiagnostic) newError(ctx context.Context, errorNumber int, details ...interface{}) error {
 	defer func() { client.panicOnError(client.clearLastException(ctx)) }()
 
@@ -740,12 +859,20 @@ func (client *Szdiagnostic) newError(ctx context.Context, errorNumber int, detai
 		lastException = err.Error()
 	}
 
+	arguments := details
 	details = append(details, messenger.MessageCode{Value: fmt.Sprintf(ExceptionCodeTemplate, lastExceptionCode)})
 	details = append(details, messenger.MessageReason{Value: lastException})
 	details = append(details, wraperror.Errorf(szerror.ErrSz, "exception %s", lastException))
 	errorMessage := client.getMessenger().NewJSON(errorNumber, details...)
 
-	return szerror.New(lastExceptionCode, errorMessage) //nolint
+	return sdkerror.New(
+		ComponentID,
+		errorNumber,
+		lastExceptionCode,
+		lastException,
+		arguments,
+		szerror.New(lastExceptionCode, errorMessage),
+	)
 }
 
 /*
@@ -809,9 +936,11 @@ func (client *Szdiagnostic) getLastException(ctx context.Context) (string, error
 		defer func() { client.traceExit(12, result, err, time.Since(entryTime)) }()
 	}
 
-	stringBuffer := client.getByteArray(initialByteArraySize)
-	C.SzDiagnostic_getLastException((*C.char)(unsafe.Pointer(&stringBuffer[0])), C.size_t(len(stringBuffer)))
-	result = string(bytes.Trim(stringBuffer, "\x00"))
+	stringBuffer := helper.GetExceptionBuffer()
+	defer helper.PutExceptionBuffer(stringBuffer)
+
+	C.SzDiagnostic_getLastException((*C.char)(unsafe.Pointer(&(*stringBuffer)[0])), C.size_t(len(*stringBuffer)))
+	result = helper.NulTerminatedString(*stringBuffer)
 
 	return result, err
 }
@@ -847,17 +976,6 @@ func (client *Szdiagnostic) getLastExceptionCode(ctx context.Context) (int, erro
 
 // --- Misc -------------------------------------------------------------------
 
-// Get space for an array of bytes of a given size.
-// func (client *Szdiagnostic) getByteArrayC(size int) *C.char {
-// 	bytes := C.malloc(C.size_t(size))
-// 	return (*C.char)(bytes)
-// }
-
-// Make a byte array.
-func (client *Szdiagnostic) getByteArray(size int) []byte {
-	return make([]byte, size)
-}
-
 // A hack: Only needed to import the "senzing" package for the godoc comments.
 // func junk() {
 // 	fmt.Printf(senzing.SzNoAttributes)
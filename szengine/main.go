@@ -11,10 +11,53 @@ ComponentID is the identifier of the szengine package.
 Package szengine messages will have the format "SZSDK6004eeee" where "eeee" is the error identifier.
 
 ExceptionCodeTemplate is a template for the error code returned by the Senzing C binary.
+
+ExportReportCsv and ExportReportJSON identify the kind of report of an [ExportHandleInfo].
 */
 const (
 	ComponentID           = 6004
 	ExceptionCodeTemplate = "SENZ%04d"
+	ExportReportCsv       = "CSV"
+	ExportReportJSON      = "JSON"
 )
 
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
 var errForPackage = errors.New("szengine")
+
+// Messages of this implementation, in addition to those of the sz-sdk-go szengine package.
+var idMessages = map[int]string{
+	81:   "Enter szengine.PatchRecord(%s, %s, %s, %d).",
+	82:   "Exit  szengine.PatchRecord(%s, %s, %s, %d) returned (%s, %s, %v).",
+	83:   "Enter szengine.FindNetworkByEntityIDToWriter(%s, %d, %d, %d, %d).",
+	84:   "Exit  szengine.FindNetworkByEntityIDToWriter(%s, %d, %d, %d, %d) returned (%d, %v).",
+	85:   "Enter szengine.FindNetworkByRecordIDToWriter(%s, %d, %d, %d, %d).",
+	86:   "Exit  szengine.FindNetworkByRecordIDToWriter(%s, %d, %d, %d, %d) returned (%d, %v).",
+	87:   "Enter szengine.GetEntityByEntityIDBytes(%d, %d).",
+	88:   "Exit  szengine.GetEntityByEntityIDBytes(%d, %d) returned (%s, %v).",
+	89:   "Enter szengine.GetEntityByEntityIDToWriter(%d, %d).",
+	90:   "Exit  szengine.GetEntityByEntityIDToWriter(%d, %d) returned (%d, %v).",
+	91:   "Enter szengine.GetEntityByRecordIDBytes(%s, %s, %d).",
+	92:   "Exit  szengine.GetEntityByRecordIDBytes(%s, %s, %d) returned (%s, %v).",
+	93:   "Enter szengine.GetEntityByRecordIDToWriter(%s, %s, %d).",
+	94:   "Exit  szengine.GetEntityByRecordIDToWriter(%s, %s, %d) returned (%d, %v).",
+	95:   "Enter szengine.GetRecordBytes(%s, %s, %d).",
+	96:   "Exit  szengine.GetRecordBytes(%s, %s, %d) returned (%s, %v).",
+	97:   "Enter szengine.SearchByAttributesBytes(%s, %s, %d).",
+	98:   "Exit  szengine.SearchByAttributesBytes(%s, %s, %d) returned (%s, %v).",
+	3001: "Export handle %d has been open for %s. Created by: %s",
+	3002: "Destroy closed export handle %d which had been open for %s. Created by: %s",
+	3003: "%s was called with flags that have no effect on it: %s",
+	4065: "szengine.PatchRecord: the patch cannot be applied.",
+	8037: "szengine.PatchRecord",
+	8038: "szengine.FindNetworkByEntityIDToWriter",
+	8039: "szengine.FindNetworkByRecordIDToWriter",
+	8040: "szengine.GetEntityByEntityIDBytes",
+	8041: "szengine.GetEntityByEntityIDToWriter",
+	8042: "szengine.GetEntityByRecordIDBytes",
+	8043: "szengine.GetEntityByRecordIDToWriter",
+	8044: "szengine.GetRecordBytes",
+	8045: "szengine.SearchByAttributesBytes",
+}
@@ -6,6 +6,7 @@ package szengine
 
 /*
 #include <stdlib.h>
+#include <string.h>
 #include "libSz.h"
 #include "szhelpers/SzLang_helpers.h"
 #cgo linux CFLAGS: -g -I/opt/senzing/er/sdk/c
@@ -16,9 +17,17 @@ import "C"
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"io"
 	"runtime"
+	"runtime/debug"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 	"unsafe"
 
@@ -29,6 +38,9 @@ import (
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/go-observing/subject"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/sdkerror"
+	"github.com/senzing-garage/sz-sdk-go-core/szflags"
+	"github.com/senzing-garage/sz-sdk-go-core/validation"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-go/szengine"
 	"github.com/senzing-garage/sz-sdk-go/szerror"
@@ -39,24 +51,49 @@ Type Szengine struct implements the [senzing.SzEngine] interface
 for communicating with the Senzing C binaries.
 */
 type Szengine struct {
-	instanceName   string
-	isDestroyed    bool
-	isTrace        bool
-	logger         logging.Logging
-	messenger      messenger.Messenger
-	observerOrigin string
-	observers      subject.Subject
-	settings       string
-	verboseLogging int64
+	callGate                helper.CallGate
+	executor                *helper.Executor
+	exportHandleMonitorStop chan struct{}
+	exportHandles           map[uintptr]*exportHandleEntry
+	exportHandlesMutex      sync.Mutex
+	instanceName            string
+	irrelevantFlagsWarned   sync.Map
+	isDestroyed             bool
+	isTrace                 bool
+	logger                  logging.Logging
+	messenger               messenger.Messenger
+	observerOrigin          string
+	observers               subject.Subject
+	patchRecordLocks        [patchRecordLockStripes]sync.Mutex
+	settings                string
+	verboseLogging          int64
+}
+
+/*
+Type ExportHandleInfo struct describes an export handle that has not been closed
+with [Szengine.CloseExportReport].
+*/
+type ExportHandleInfo struct {
+	CreatedAt     time.Time // When the export report was started.
+	CreationStack string    // Stack trace of the goroutine that started the export report.
+	Flags         int64     // Flags passed to the Export*EntityReport() method.
+	Handle        uintptr   // The export handle.
+	Report        string    // ExportReportCsv or ExportReportJSON.
+}
+
+type exportHandleEntry struct {
+	info       ExportHandleInfo
+	isReported bool
 }
 
 const (
-	baseCallerSkip       = 4
-	baseTen              = 10
-	initialByteArraySize = 65535
-	noError              = 0
-	uninitializedError   = -1
-	withoutInfo          = ""
+	baseCallerSkip         = 4
+	exportHandleChecks     = 2
+	baseTen                = 10
+	noError                = 0
+	patchRecordLockStripes = 64
+	uninitializedError     = -1
+	withoutInfo            = ""
 )
 
 // ----------------------------------------------------------------------------
@@ -95,24 +132,39 @@ func (client *Szengine) AddRecord(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(1, dataSourceCode, recordID, recordDefinition, flags)
+		client.traceEntry(1, dataSourceCode, recordID, recordDefinition, szflags.Mask(flags))
 
 		entryTime := time.Now()
 		defer func() {
-			client.traceExit(2, dataSourceCode, recordID, recordDefinition, flags, result, err, time.Since(entryTime))
+			client.traceExit(2, dataSourceCode, recordID, recordDefinition, szflags.Mask(flags), result, err, time.Since(entryTime))
 		}()
 	}
 
+	client.warnIrrelevantFlags(szflags.FamilyWithInfo, "AddRecord", flags)
+
+	err = client.checkInputs(
+		errorNumberForFlags(flags, 4001, 4002),
+		validation.Identifier("dataSourceCode", dataSourceCode),
+		validation.Identifier("recordID", recordID),
+		validation.JSON("recordDefinition", recordDefinition),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
 	if (flags & senzing.SzWithInfo) == senzing.SzNoFlags {
-		result, err = client.addRecord(ctx, dataSourceCode, recordID, recordDefinition)
+		client.executor.Call(func() { result, err = client.addRecord(ctx, dataSourceCode, recordID, recordDefinition) })
 	} else {
 		finalFlags := flags & ^senzing.SzWithInfo
-		result, err = client.addRecordWithInfo(ctx, dataSourceCode, recordID, recordDefinition, finalFlags)
+		client.executor.Call(func() {
+			result, err = client.addRecordWithInfo(ctx, dataSourceCode, recordID, recordDefinition, finalFlags)
+		})
 	}
 
 	if client.observers != nil {
@@ -120,7 +172,7 @@ func (client *Szengine) AddRecord(
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
-				"flags":          strconv.FormatInt(flags, baseTen),
+				"flags":          szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8001, err, details)
 		}()
@@ -142,9 +194,10 @@ Input
 func (client *Szengine) CloseExportReport(ctx context.Context, exportHandle uintptr) error {
 	var err error
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(5, exportHandle)
@@ -153,7 +206,11 @@ func (client *Szengine) CloseExportReport(ctx context.Context, exportHandle uint
 		defer func() { client.traceExit(6, exportHandle, err, time.Since(entryTime)) }()
 	}
 
-	err = client.closeExportReport(ctx, exportHandle)
+	client.executor.Call(func() { err = client.closeExportReport(ctx, exportHandle) })
+
+	if err == nil {
+		client.untrackExportHandle(exportHandle)
+	}
 
 	if client.observers != nil {
 		go func() {
@@ -183,9 +240,10 @@ func (client *Szengine) CountRedoRecords(ctx context.Context) (int64, error) {
 		result int64
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(7)
@@ -194,7 +252,7 @@ func (client *Szengine) CountRedoRecords(ctx context.Context) (int64, error) {
 		defer func() { client.traceExit(8, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.countRedoRecords(ctx)
+	client.executor.Call(func() { result, err = client.countRedoRecords(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -233,22 +291,38 @@ func (client *Szengine) DeleteRecord(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(9, dataSourceCode, recordID, flags)
+		client.traceEntry(9, dataSourceCode, recordID, szflags.Mask(flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(10, dataSourceCode, recordID, flags, result, err, time.Since(entryTime)) }()
+		defer func() {
+			client.traceExit(10, dataSourceCode, recordID, szflags.Mask(flags), result, err, time.Since(entryTime))
+		}()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilyWithInfo, "DeleteRecord", flags)
+
+	err = client.checkInputs(
+		errorNumberForFlags(flags, 4004, 4005),
+		validation.Identifier("dataSourceCode", dataSourceCode),
+		validation.Identifier("recordID", recordID),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
 	}
 
 	if (flags & senzing.SzWithInfo) == senzing.SzNoFlags {
-		result, err = client.deleteRecord(ctx, dataSourceCode, recordID)
+		client.executor.Call(func() { result, err = client.deleteRecord(ctx, dataSourceCode, recordID) })
 	} else {
 		finalFlags := flags & ^senzing.SzWithInfo
-		result, err = client.deleteRecordWithInfo(ctx, dataSourceCode, recordID, finalFlags)
+		client.executor.Call(func() {
+			result, err = client.deleteRecordWithInfo(ctx, dataSourceCode, recordID, finalFlags)
+		})
 	}
 
 	if client.observers != nil {
@@ -256,7 +330,7 @@ func (client *Szengine) DeleteRecord(
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
-				"flags":          strconv.FormatInt(flags, baseTen),
+				"flags":          szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8004, err, details)
 		}()
@@ -270,6 +344,13 @@ Method Destroy will destroy and perform cleanup for the Senzing Sz object.
 
 It should be called after all other calls are complete.
 
+New calls are rejected once Destroy is called.
+Calls already in flight and export iterators are given until ctx is done to finish;
+if they do not, nothing is destroyed and an error is returned.
+
+Export handles that were not closed with [Szengine.CloseExportReport] are closed
+and logged at WARN level with the stack trace that created them.
+
 Input
   - ctx: A context to control lifecycle.
 */
@@ -280,6 +361,11 @@ func (client *Szengine) Destroy(ctx context.Context) error {
 		return wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
 
+	err = client.Drain(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
 	if client.isTrace {
 		client.traceEntry(11)
 
@@ -287,11 +373,15 @@ func (client *Szengine) Destroy(ctx context.Context) error {
 		defer func() { client.traceExit(12, err, time.Since(entryTime)) }()
 	}
 
-	err = client.destroy(ctx)
+	client.SetExportHandleLeakThreshold(ctx, 0)
+	leftoverExportHandles := client.closeLeftoverExportHandles(ctx)
+	client.executor.Call(func() { err = client.destroy(ctx) })
 
 	if client.observers != nil {
 		go func() {
-			details := map[string]string{}
+			details := map[string]string{
+				"leftoverExportHandles": strconv.Itoa(leftoverExportHandles),
+			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8005, err, details)
 		}()
 	}
@@ -327,23 +417,35 @@ func (client *Szengine) ExportCsvEntityReport(ctx context.Context, csvColumnList
 		result uintptr
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(13, csvColumnList, flags)
+		client.traceEntry(13, csvColumnList, szflags.Mask(flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(14, csvColumnList, flags, result, err, time.Since(entryTime)) }()
+		defer func() { client.traceExit(14, csvColumnList, szflags.Mask(flags), result, err, time.Since(entryTime)) }()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilyExport, "ExportCsvEntityReport", flags)
+
+	err = client.checkInputs(4007, validation.Identifier("csvColumnList", csvColumnList))
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
 	}
 
-	result, err = client.exportCsvEntityReport(ctx, csvColumnList, flags)
+	client.executor.Call(func() { result, err = client.exportCsvEntityReport(ctx, csvColumnList, flags) })
+
+	if err == nil {
+		client.trackExportHandle(result, ExportReportCsv, flags)
+	}
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
-				"flags": strconv.FormatInt(flags, baseTen),
+				"flags": szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8006, err, details)
 		}()
@@ -375,22 +477,23 @@ func (client *Szengine) ExportCsvEntityReportIterator(
 ) chan senzing.StringFragment {
 	stringFragmentChannel := make(chan senzing.StringFragment)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return stringFragmentChannel
 	}
 
 	go func() {
 		runtime.LockOSThread()
 		defer runtime.UnlockOSThread()
+		defer client.callGate.Exit()
 		defer close(stringFragmentChannel)
 
 		var err error
 
 		if client.isTrace {
-			client.traceEntry(15, csvColumnList, flags)
+			client.traceEntry(15, csvColumnList, szflags.Mask(flags))
 
 			entryTime := time.Now()
-			defer func() { client.traceExit(16, csvColumnList, flags, err, time.Since(entryTime)) }()
+			defer func() { client.traceExit(16, csvColumnList, szflags.Mask(flags), err, time.Since(entryTime)) }()
 		}
 
 		reportHandle, err := client.ExportCsvEntityReport(ctx, csvColumnList, flags)
@@ -404,10 +507,13 @@ func (client *Szengine) ExportCsvEntityReportIterator(
 		}
 
 		defer func() {
-			err = client.CloseExportReport(ctx, reportHandle)
+			client.executor.Call(func() { err = client.closeExportReport(ctx, reportHandle) })
+
 			if err != nil {
 				panic(err) // IMPROVE:  Something better than panic(err)?
 			}
+
+			client.untrackExportHandle(reportHandle)
 		}()
 
 		client.fetchNextIntoChannel(ctx, reportHandle, stringFragmentChannel)
@@ -415,7 +521,7 @@ func (client *Szengine) ExportCsvEntityReportIterator(
 		if client.observers != nil {
 			go func() {
 				details := map[string]string{
-					"flags": strconv.FormatInt(flags, baseTen),
+					"flags": szflags.Mask(flags).String(),
 				}
 				notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8007, err, details)
 			}()
@@ -447,23 +553,30 @@ func (client *Szengine) ExportJSONEntityReport(ctx context.Context, flags int64)
 		result uintptr
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(17, flags)
+		client.traceEntry(17, szflags.Mask(flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(18, flags, result, err, time.Since(entryTime)) }()
+		defer func() { client.traceExit(18, szflags.Mask(flags), result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.exportJSONEntityReport(ctx, flags)
+	client.warnIrrelevantFlags(szflags.FamilyExport, "ExportJSONEntityReport", flags)
+
+	client.executor.Call(func() { result, err = client.exportJSONEntityReport(ctx, flags) })
+
+	if err == nil {
+		client.trackExportHandle(result, ExportReportJSON, flags)
+	}
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
-				"flags": strconv.FormatInt(flags, baseTen),
+				"flags": szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8008, err, details)
 		}()
@@ -488,22 +601,23 @@ Output
 */
 func (client *Szengine) ExportJSONEntityReportIterator(ctx context.Context, flags int64) chan senzing.StringFragment {
 	stringFragmentChannel := make(chan senzing.StringFragment)
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return stringFragmentChannel
 	}
 
 	go func() {
 		runtime.LockOSThread()
 		defer runtime.UnlockOSThread()
+		defer client.callGate.Exit()
 		defer close(stringFragmentChannel)
 
 		var err error
 
 		if client.isTrace {
-			client.traceEntry(19, flags)
+			client.traceEntry(19, szflags.Mask(flags))
 
 			entryTime := time.Now()
-			defer func() { client.traceExit(20, flags, err, time.Since(entryTime)) }()
+			defer func() { client.traceExit(20, szflags.Mask(flags), err, time.Since(entryTime)) }()
 		}
 
 		reportHandle, err := client.ExportJSONEntityReport(ctx, flags)
@@ -517,10 +631,13 @@ func (client *Szengine) ExportJSONEntityReportIterator(ctx context.Context, flag
 		}
 
 		defer func() {
-			err = client.CloseExportReport(ctx, reportHandle)
+			client.executor.Call(func() { err = client.closeExportReport(ctx, reportHandle) })
+
 			if err != nil {
 				panic(err) // IMPROVE:  Something better than panic(err)?
 			}
+
+			client.untrackExportHandle(reportHandle)
 		}()
 
 		client.fetchNextIntoChannel(ctx, reportHandle, stringFragmentChannel)
@@ -562,9 +679,10 @@ func (client *Szengine) FetchNext(ctx context.Context, exportHandle uintptr) (st
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(21, exportHandle)
@@ -573,7 +691,7 @@ func (client *Szengine) FetchNext(ctx context.Context, exportHandle uintptr) (st
 		defer func() { client.traceExit(22, exportHandle, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.fetchNext(ctx, exportHandle)
+	client.executor.Call(func() { result, err = client.fetchNext(ctx, exportHandle) })
 
 	if client.observers != nil {
 		go func() {
@@ -608,24 +726,27 @@ func (client *Szengine) FindInterestingEntitiesByEntityID(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(23, entityID, flags)
+		client.traceEntry(23, entityID, szflags.Mask(flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(24, entityID, flags, result, err, time.Since(entryTime)) }()
+		defer func() { client.traceExit(24, entityID, szflags.Mask(flags), result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.findInterestingEntitiesByEntityID(ctx, entityID, flags)
+	client.warnIrrelevantFlags(szflags.FamilyEntity, "FindInterestingEntitiesByEntityID", flags)
+
+	client.executor.Call(func() { result, err = client.findInterestingEntitiesByEntityID(ctx, entityID, flags) })
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"entityID": formatEntityID(entityID),
-				"flags":    strconv.FormatInt(flags, baseTen),
+				"flags":    szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8011, err, details)
 		}()
@@ -659,27 +780,41 @@ func (client *Szengine) FindInterestingEntitiesByRecordID(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(25, dataSourceCode, recordID, flags)
+		client.traceEntry(25, dataSourceCode, recordID, szflags.Mask(flags))
 
 		entryTime := time.Now()
 		defer func() {
-			client.traceExit(26, dataSourceCode, recordID, flags, result, err, time.Since(entryTime))
+			client.traceExit(26, dataSourceCode, recordID, szflags.Mask(flags), result, err, time.Since(entryTime))
 		}()
 	}
 
-	result, err = client.findInterestingEntitiesByRecordID(ctx, dataSourceCode, recordID, flags)
+	client.warnIrrelevantFlags(szflags.FamilyEntity, "FindInterestingEntitiesByRecordID", flags)
+
+	err = client.checkInputs(
+		4011,
+		validation.Identifier("dataSourceCode", dataSourceCode),
+		validation.Identifier("recordID", recordID),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() {
+		result, err = client.findInterestingEntitiesByRecordID(ctx, dataSourceCode, recordID, flags)
+	})
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
-				"flags":          strconv.FormatInt(flags, baseTen),
+				"flags":          szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8012, err, details)
 		}()
@@ -720,12 +855,13 @@ func (client *Szengine) FindNetworkByEntityID(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(27, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+		client.traceEntry(27, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, szflags.Mask(flags))
 
 		entryTime := time.Now()
 		defer func() {
@@ -735,7 +871,7 @@ func (client *Szengine) FindNetworkByEntityID(
 				maxDegrees,
 				buildOutDegrees,
 				buildOutMaxEntities,
-				flags,
+				szflags.Mask(flags),
 				result,
 				err,
 				time.Since(entryTime),
@@ -743,20 +879,29 @@ func (client *Szengine) FindNetworkByEntityID(
 		}()
 	}
 
-	result, err = client.findNetworkByEntityIDV2(
-		ctx,
-		entityIDs,
-		maxDegrees,
-		buildOutDegrees,
-		buildOutMaxEntities,
-		flags,
-	)
+	client.warnIrrelevantFlags(szflags.FamilyFindNetwork, "FindNetworkByEntityID", flags)
+
+	err = client.checkInputs(4013, validation.JSON("entityIDs", entityIDs))
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() {
+		result, err = client.findNetworkByEntityIDV2(
+			ctx,
+			entityIDs,
+			maxDegrees,
+			buildOutDegrees,
+			buildOutMaxEntities,
+			flags,
+		)
+	})
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"entityIDs": entityIDs,
-				"flags":     strconv.FormatInt(flags, baseTen),
+				"flags":     szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8013, err, details)
 		}()
@@ -797,12 +942,13 @@ func (client *Szengine) FindNetworkByRecordID(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(29, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, flags)
+		client.traceEntry(29, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, szflags.Mask(flags))
 
 		entryTime := time.Now()
 		defer func() {
@@ -812,7 +958,7 @@ func (client *Szengine) FindNetworkByRecordID(
 				maxDegrees,
 				buildOutDegrees,
 				buildOutMaxEntities,
-				flags,
+				szflags.Mask(flags),
 				result,
 				err,
 				time.Since(entryTime),
@@ -820,20 +966,29 @@ func (client *Szengine) FindNetworkByRecordID(
 		}()
 	}
 
-	result, err = client.findNetworkByRecordIDV2(
-		ctx,
-		recordKeys,
-		maxDegrees,
-		buildOutDegrees,
-		buildOutMaxEntities,
-		flags,
-	)
+	client.warnIrrelevantFlags(szflags.FamilyFindNetwork, "FindNetworkByRecordID", flags)
+
+	err = client.checkInputs(4015, validation.JSON("recordKeys", recordKeys))
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() {
+		result, err = client.findNetworkByRecordIDV2(
+			ctx,
+			recordKeys,
+			maxDegrees,
+			buildOutDegrees,
+			buildOutMaxEntities,
+			flags,
+		)
+	})
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"recordKeys": recordKeys,
-				"flags":      strconv.FormatInt(flags, baseTen),
+				"flags":      szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8014, err, details)
 		}()
@@ -877,31 +1032,49 @@ func (client *Szengine) FindPathByEntityID(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(31, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags)
+		client.traceEntry(31, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, szflags.Mask(flags))
 
 		entryTime := time.Now()
 		defer func() {
 			client.traceExit(32, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources,
-				flags, result, err, time.Since(entryTime))
+				szflags.Mask(flags), result, err, time.Since(entryTime))
 		}()
 	}
 
+	client.warnIrrelevantFlags(szflags.FamilyFindPath, "FindPathByEntityID", flags)
+
+	err = client.checkInputs(
+		errorNumberForFindPath(avoidEntityIDs, requiredDataSources, 4017, 4021, 4025),
+		validation.JSON("avoidEntityIDs", avoidEntityIDs),
+		validation.JSON("requiredDataSources", requiredDataSources),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
 	switch {
 	case len(requiredDataSources) > 0:
-		result, err = client.findPathByEntityIDIncludingSourceV2(
-			ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs,
-			requiredDataSources, flags)
+		client.executor.Call(func() {
+			result, err = client.findPathByEntityIDIncludingSourceV2(
+				ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs,
+				requiredDataSources, flags)
+		})
 	case len(avoidEntityIDs) > 0:
-		result, err = client.findPathByEntityIDWithAvoidsV2(
-			ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs,
-			flags)
+		client.executor.Call(func() {
+			result, err = client.findPathByEntityIDWithAvoidsV2(
+				ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs,
+				flags)
+		})
 	default:
-		result, err = client.findPathByEntityIDV2(ctx, startEntityID, endEntityID, maxDegrees, flags)
+		client.executor.Call(func() {
+			result, err = client.findPathByEntityIDV2(ctx, startEntityID, endEntityID, maxDegrees, flags)
+		})
 	}
 
 	if client.observers != nil {
@@ -911,7 +1084,7 @@ func (client *Szengine) FindPathByEntityID(
 				"endEntityID":         formatEntityID(endEntityID),
 				"avoidEntityIDs":      avoidEntityIDs,
 				"requiredDataSources": requiredDataSources,
-				"flags":               strconv.FormatInt(flags, baseTen),
+				"flags":               szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8015, err, details)
 		}()
@@ -967,34 +1140,56 @@ func (client *Szengine) FindPathByRecordID(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(33, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees,
-			avoidRecordKeys, requiredDataSources, flags)
+			avoidRecordKeys, requiredDataSources, szflags.Mask(flags))
 
 		entryTime := time.Now()
 		defer func() {
 			client.traceExit(34, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees,
-				avoidRecordKeys, requiredDataSources, flags, result, err, time.Since(entryTime))
+				avoidRecordKeys, requiredDataSources, szflags.Mask(flags), result, err, time.Since(entryTime))
 		}()
 	}
 
+	client.warnIrrelevantFlags(szflags.FamilyFindPath, "FindPathByRecordID", flags)
+
+	err = client.checkInputs(
+		errorNumberForFindPath(avoidRecordKeys, requiredDataSources, 4019, 4023, 4027),
+		validation.Identifier("startDataSourceCode", startDataSourceCode),
+		validation.Identifier("startRecordID", startRecordID),
+		validation.Identifier("endDataSourceCode", endDataSourceCode),
+		validation.Identifier("endRecordID", endRecordID),
+		validation.JSON("avoidRecordKeys", avoidRecordKeys),
+		validation.JSON("requiredDataSources", requiredDataSources),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
 	switch {
 	case len(requiredDataSources) > 0:
-		result, err = client.findPathByRecordIDIncludingSourceV2(
-			ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys,
-			requiredDataSources, flags)
+		client.executor.Call(func() {
+			result, err = client.findPathByRecordIDIncludingSourceV2(
+				ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys,
+				requiredDataSources, flags)
+		})
 	case len(avoidRecordKeys) > 0:
-		result, err = client.findPathByRecordIDWithAvoidsV2(
-			ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys,
-			flags)
+		client.executor.Call(func() {
+			result, err = client.findPathByRecordIDWithAvoidsV2(
+				ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys,
+				flags)
+		})
 	default:
-		result, err = client.findPathByRecordIDV2(
-			ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID,
-			maxDegrees, flags)
+		client.executor.Call(func() {
+			result, err = client.findPathByRecordIDV2(
+				ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID,
+				maxDegrees, flags)
+		})
 	}
 
 	if client.observers != nil {
@@ -1006,7 +1201,7 @@ func (client *Szengine) FindPathByRecordID(
 				"endRecordID":         endRecordID,
 				"avoidRecordKeys":     avoidRecordKeys,
 				"requiredDataSources": requiredDataSources,
-				"flags":               strconv.FormatInt(flags, baseTen),
+				"flags":               szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8016, err, details)
 		}()
@@ -1032,9 +1227,10 @@ func (client *Szengine) GetActiveConfigID(ctx context.Context) (int64, error) {
 		result int64
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(35)
@@ -1043,7 +1239,7 @@ func (client *Szengine) GetActiveConfigID(ctx context.Context) (int64, error) {
 		defer func() { client.traceExit(36, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.getActiveConfigID(ctx)
+	client.executor.Call(func() { result, err = client.getActiveConfigID(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -1073,24 +1269,27 @@ func (client *Szengine) GetEntityByEntityID(ctx context.Context, entityID int64,
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(37, entityID, flags)
+		client.traceEntry(37, entityID, szflags.Mask(flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(38, entityID, flags, result, err, time.Since(entryTime)) }()
+		defer func() { client.traceExit(38, entityID, szflags.Mask(flags), result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.getEntityByEntityIDV2(ctx, entityID, flags)
+	client.warnIrrelevantFlags(szflags.FamilyEntity, "GetEntityByEntityID", flags)
+
+	client.executor.Call(func() { result, err = client.getEntityByEntityIDV2(ctx, entityID, flags) })
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"entityID": formatEntityID(entityID),
-				"flags":    strconv.FormatInt(flags, baseTen),
+				"flags":    szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8018, err, details)
 		}()
@@ -1122,27 +1321,39 @@ func (client *Szengine) GetEntityByRecordID(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(39, dataSourceCode, recordID, flags)
+		client.traceEntry(39, dataSourceCode, recordID, szflags.Mask(flags))
 
 		entryTime := time.Now()
 		defer func() {
-			client.traceExit(40, dataSourceCode, recordID, flags, result, err, time.Since(entryTime))
+			client.traceExit(40, dataSourceCode, recordID, szflags.Mask(flags), result, err, time.Since(entryTime))
 		}()
 	}
 
-	result, err = client.getEntityByRecordIDV2(ctx, dataSourceCode, recordID, flags)
+	client.warnIrrelevantFlags(szflags.FamilyEntity, "GetEntityByRecordID", flags)
+
+	err = client.checkInputs(
+		4032,
+		validation.Identifier("dataSourceCode", dataSourceCode),
+		validation.Identifier("recordID", recordID),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { result, err = client.getEntityByRecordIDV2(ctx, dataSourceCode, recordID, flags) })
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
-				"flags":          strconv.FormatInt(flags, baseTen),
+				"flags":          szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8019, err, details)
 		}()
@@ -1177,27 +1388,39 @@ func (client *Szengine) GetRecord(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(45, dataSourceCode, recordID, flags)
+		client.traceEntry(45, dataSourceCode, recordID, szflags.Mask(flags))
 
 		entryTime := time.Now()
 		defer func() {
-			client.traceExit(46, dataSourceCode, recordID, flags, result, err, time.Since(entryTime))
+			client.traceExit(46, dataSourceCode, recordID, szflags.Mask(flags), result, err, time.Since(entryTime))
 		}()
 	}
 
-	result, err = client.getRecordV2(ctx, dataSourceCode, recordID, flags)
+	client.warnIrrelevantFlags(szflags.FamilyRecord, "GetRecord", flags)
+
+	err = client.checkInputs(
+		4035,
+		validation.Identifier("dataSourceCode", dataSourceCode),
+		validation.Identifier("recordID", recordID),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { result, err = client.getRecordV2(ctx, dataSourceCode, recordID, flags) })
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
-				"flags":          strconv.FormatInt(flags, baseTen),
+				"flags":          szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8020, err, details)
 		}()
@@ -1225,25 +1448,33 @@ func (client *Szengine) GetRecordPreview(ctx context.Context, recordDefinition s
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(77, recordDefinition, flags)
+		client.traceEntry(77, recordDefinition, szflags.Mask(flags))
 
 		entryTime := time.Now()
 		defer func() {
-			client.traceExit(78, recordDefinition, flags, result, err, time.Since(entryTime))
+			client.traceExit(78, recordDefinition, szflags.Mask(flags), result, err, time.Since(entryTime))
 		}()
 	}
 
-	result, err = client.getRecordPreview(ctx, recordDefinition, flags)
+	client.warnIrrelevantFlags(szflags.FamilyRecord, "GetRecordPreview", flags)
+
+	err = client.checkInputs(4061, validation.JSON("recordDefinition", recordDefinition))
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { result, err = client.getRecordPreview(ctx, recordDefinition, flags) })
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
-				"flags": strconv.FormatInt(flags, baseTen),
+				"flags": szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8035, err, details)
 		}()
@@ -1277,9 +1508,10 @@ func (client *Szengine) GetRedoRecord(ctx context.Context) (string, error) {
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(47)
@@ -1288,7 +1520,7 @@ func (client *Szengine) GetRedoRecord(ctx context.Context) (string, error) {
 		defer func() { client.traceExit(48, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.getRedoRecord(ctx)
+	client.executor.Call(func() { result, err = client.getRedoRecord(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -1319,9 +1551,10 @@ func (client *Szengine) GetStats(ctx context.Context) (string, error) {
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(49)
@@ -1330,7 +1563,7 @@ func (client *Szengine) GetStats(ctx context.Context) (string, error) {
 		defer func() { client.traceExit(50, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.getStats(ctx)
+	client.executor.Call(func() { result, err = client.getStats(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -1366,24 +1599,32 @@ func (client *Szengine) GetVirtualEntityByRecordID(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(51, recordKeys, flags)
+		client.traceEntry(51, recordKeys, szflags.Mask(flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(52, recordKeys, flags, result, err, time.Since(entryTime)) }()
+		defer func() { client.traceExit(52, recordKeys, szflags.Mask(flags), result, err, time.Since(entryTime)) }()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilyEntity, "GetVirtualEntityByRecordID", flags)
+
+	err = client.checkInputs(4038, validation.JSON("recordKeys", recordKeys))
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
 	}
 
-	result, err = client.getVirtualEntityByRecordIDV2(ctx, recordKeys, flags)
+	client.executor.Call(func() { result, err = client.getVirtualEntityByRecordIDV2(ctx, recordKeys, flags) })
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"recordKeys": recordKeys,
-				"flags":      strconv.FormatInt(flags, baseTen),
+				"flags":      szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8023, err, details)
 		}()
@@ -1409,24 +1650,27 @@ func (client *Szengine) HowEntityByEntityID(ctx context.Context, entityID int64,
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(53, entityID, flags)
+		client.traceEntry(53, entityID, szflags.Mask(flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(54, entityID, flags, result, err, time.Since(entryTime)) }()
+		defer func() { client.traceExit(54, entityID, szflags.Mask(flags), result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.howEntityByEntityIDV2(ctx, entityID, flags)
+	client.warnIrrelevantFlags(szflags.FamilyHow, "HowEntityByEntityID", flags)
+
+	client.executor.Call(func() { result, err = client.howEntityByEntityIDV2(ctx, entityID, flags) })
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"entityID": formatEntityID(entityID),
-				"flags":    strconv.FormatInt(flags, baseTen),
+				"flags":    szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8024, err, details)
 		}()
@@ -1447,9 +1691,10 @@ Input
 func (client *Szengine) PrimeEngine(ctx context.Context) error {
 	var err error
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(57)
@@ -1458,7 +1703,7 @@ func (client *Szengine) PrimeEngine(ctx context.Context) error {
 		defer func() { client.traceExit(58, err, time.Since(entryTime)) }()
 	}
 
-	err = client.primeEngine(ctx)
+	client.executor.Call(func() { err = client.primeEngine(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -1491,27 +1736,35 @@ func (client *Szengine) ProcessRedoRecord(ctx context.Context, redoRecord string
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(59, redoRecord, flags)
+		client.traceEntry(59, redoRecord, szflags.Mask(flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(60, redoRecord, flags, result, err, time.Since(entryTime)) }()
+		defer func() { client.traceExit(60, redoRecord, szflags.Mask(flags), result, err, time.Since(entryTime)) }()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilyWithInfo, "ProcessRedoRecord", flags)
+
+	err = client.checkInputs(errorNumberForFlags(flags, 4044, 4045), validation.JSON("redoRecord", redoRecord))
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
 	}
 
 	if (flags & senzing.SzWithInfo) == senzing.SzNoFlags {
-		result, err = client.processRedoRecord(ctx, redoRecord)
+		client.executor.Call(func() { result, err = client.processRedoRecord(ctx, redoRecord) })
 	} else {
-		result, err = client.processRedoRecordWithInfo(ctx, redoRecord)
+		client.executor.Call(func() { result, err = client.processRedoRecordWithInfo(ctx, redoRecord) })
 	}
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
-				"flags": strconv.FormatInt(flags, baseTen),
+				"flags": szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8027, err, details)
 		}()
@@ -1538,29 +1791,32 @@ func (client *Szengine) ReevaluateEntity(ctx context.Context, entityID int64, fl
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(61, entityID, flags)
+		client.traceEntry(61, entityID, szflags.Mask(flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(62, entityID, flags, result, err, time.Since(entryTime)) }()
+		defer func() { client.traceExit(62, entityID, szflags.Mask(flags), result, err, time.Since(entryTime)) }()
 	}
 
+	client.warnIrrelevantFlags(szflags.FamilyWithInfo, "ReevaluateEntity", flags)
+
 	if (flags & senzing.SzWithInfo) == senzing.SzNoFlags {
-		result, err = client.reevaluateEntity(ctx, entityID, flags)
+		client.executor.Call(func() { result, err = client.reevaluateEntity(ctx, entityID, flags) })
 	} else {
 		finalFlags := flags & ^senzing.SzWithInfo
-		result, err = client.reevaluateEntityWithInfo(ctx, entityID, finalFlags)
+		client.executor.Call(func() { result, err = client.reevaluateEntityWithInfo(ctx, entityID, finalFlags) })
 	}
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"entityID": formatEntityID(entityID),
-				"flags":    strconv.FormatInt(flags, baseTen),
+				"flags":    szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8028, err, details)
 		}()
@@ -1593,22 +1849,38 @@ func (client *Szengine) ReevaluateRecord(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(63, dataSourceCode, recordID, flags)
+		client.traceEntry(63, dataSourceCode, recordID, szflags.Mask(flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(64, dataSourceCode, recordID, flags, result, err, time.Since(entryTime)) }()
+		defer func() {
+			client.traceExit(64, dataSourceCode, recordID, szflags.Mask(flags), result, err, time.Since(entryTime))
+		}()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilyWithInfo, "ReevaluateRecord", flags)
+
+	err = client.checkInputs(
+		errorNumberForFlags(flags, 4048, 4049),
+		validation.Identifier("dataSourceCode", dataSourceCode),
+		validation.Identifier("recordID", recordID),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
 	}
 
 	if (flags & senzing.SzWithInfo) == senzing.SzNoFlags {
-		result, err = client.reevaluateRecord(ctx, dataSourceCode, recordID, flags)
+		client.executor.Call(func() { result, err = client.reevaluateRecord(ctx, dataSourceCode, recordID, flags) })
 	} else {
 		finalFlags := flags & ^senzing.SzWithInfo
-		result, err = client.reevaluateRecordWithInfo(ctx, dataSourceCode, recordID, finalFlags)
+		client.executor.Call(func() {
+			result, err = client.reevaluateRecordWithInfo(ctx, dataSourceCode, recordID, finalFlags)
+		})
 	}
 
 	if client.observers != nil {
@@ -1616,7 +1888,7 @@ func (client *Szengine) ReevaluateRecord(
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
-				"flags":          strconv.FormatInt(flags, baseTen),
+				"flags":          szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8029, err, details)
 		}()
@@ -1653,25 +1925,40 @@ func (client *Szengine) SearchByAttributes(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(69, attributes, searchProfile, flags)
+		client.traceEntry(69, attributes, searchProfile, szflags.MaskFor(szflags.FamilySearch, flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(70, attributes, searchProfile, flags, result, err, time.Since(entryTime)) }()
+		defer func() {
+			client.traceExit(70, attributes, searchProfile, szflags.MaskFor(szflags.FamilySearch, flags),
+				result, err, time.Since(entryTime))
+		}()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilySearch, "SearchByAttributes", flags)
+
+	err = client.checkInputs(
+		4053,
+		validation.JSON("attributes", attributes),
+		validation.Identifier("searchProfile", searchProfile),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
 	}
 
-	result, err = client.searchByAttributesV3(ctx, attributes, searchProfile, flags)
+	client.executor.Call(func() { result, err = client.searchByAttributesV3(ctx, attributes, searchProfile, flags) })
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"attributes":    attributes,
 				"searchProfile": searchProfile,
-				"flags":         strconv.FormatInt(flags, baseTen),
+				"flags":         szflags.MaskFor(szflags.FamilySearch, flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8031, err, details)
 		}()
@@ -1703,25 +1990,30 @@ func (client *Szengine) WhyEntities(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(71, entityID1, entityID2, flags)
+		client.traceEntry(71, entityID1, entityID2, szflags.Mask(flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(72, entityID1, entityID2, flags, result, err, time.Since(entryTime)) }()
+		defer func() {
+			client.traceExit(72, entityID1, entityID2, szflags.Mask(flags), result, err, time.Since(entryTime))
+		}()
 	}
 
-	result, err = client.whyEntitiesV2(ctx, entityID1, entityID2, flags)
+	client.warnIrrelevantFlags(szflags.FamilyWhy, "WhyEntities", flags)
+
+	client.executor.Call(func() { result, err = client.whyEntitiesV2(ctx, entityID1, entityID2, flags) })
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"entityID1": formatEntityID(entityID1),
 				"entityID2": formatEntityID(entityID2),
-				"flags":     strconv.FormatInt(flags, baseTen),
+				"flags":     szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8032, err, details)
 		}()
@@ -1753,25 +2045,39 @@ func (client *Szengine) WhyRecordInEntity(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(73, dataSourceCode, recordID, flags)
+		client.traceEntry(73, dataSourceCode, recordID, szflags.Mask(flags))
 
 		entryTime := time.Now()
-		defer func() { client.traceExit(74, dataSourceCode, recordID, flags, result, err, time.Since(entryTime)) }()
+		defer func() {
+			client.traceExit(74, dataSourceCode, recordID, szflags.Mask(flags), result, err, time.Since(entryTime))
+		}()
 	}
 
-	result, err = client.whyRecordInEntityV2(ctx, dataSourceCode, recordID, flags)
+	client.warnIrrelevantFlags(szflags.FamilyWhy, "WhyRecordInEntity", flags)
+
+	err = client.checkInputs(
+		4058,
+		validation.Identifier("dataSourceCode", dataSourceCode),
+		validation.Identifier("recordID", recordID),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { result, err = client.whyRecordInEntityV2(ctx, dataSourceCode, recordID, flags) })
 
 	if client.observers != nil {
 		go func() {
 			details := map[string]string{
 				"dataSourceCode": dataSourceCode,
 				"recordID":       recordID,
-				"flags":          strconv.FormatInt(flags, baseTen),
+				"flags":          szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8033, err, details)
 		}()
@@ -1807,12 +2113,13 @@ func (client *Szengine) WhyRecords(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(75, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+		client.traceEntry(75, dataSourceCode1, recordID1, dataSourceCode2, recordID2, szflags.Mask(flags))
 
 		entryTime := time.Now()
 		defer func() {
@@ -1822,7 +2129,7 @@ func (client *Szengine) WhyRecords(
 				recordID1,
 				dataSourceCode2,
 				recordID2,
-				flags,
+				szflags.Mask(flags),
 				result,
 				err,
 				time.Since(entryTime),
@@ -1830,7 +2137,22 @@ func (client *Szengine) WhyRecords(
 		}()
 	}
 
-	result, err = client.whyRecordsV2(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+	client.warnIrrelevantFlags(szflags.FamilyWhy, "WhyRecords", flags)
+
+	err = client.checkInputs(
+		4060,
+		validation.Identifier("dataSourceCode1", dataSourceCode1),
+		validation.Identifier("recordID1", recordID1),
+		validation.Identifier("dataSourceCode2", dataSourceCode2),
+		validation.Identifier("recordID2", recordID2),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() {
+		result, err = client.whyRecordsV2(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+	})
 
 	if client.observers != nil {
 		go func() {
@@ -1839,7 +2161,7 @@ func (client *Szengine) WhyRecords(
 				"recordID1":       recordID1,
 				"dataSourceCode2": dataSourceCode2,
 				"recordID2":       recordID2,
-				"flags":           strconv.FormatInt(flags, baseTen),
+				"flags":           szflags.Mask(flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8034, err, details)
 		}()
@@ -1878,20 +2200,33 @@ func (client *Szengine) WhySearch(
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(69, attributes, entityID, searchProfile, flags)
+		client.traceEntry(69, attributes, entityID, searchProfile, szflags.MaskFor(szflags.FamilySearch, flags))
 
 		entryTime := time.Now()
 		defer func() {
-			client.traceExit(70, attributes, entityID, searchProfile, flags, result, err, time.Since(entryTime))
+			client.traceExit(70, attributes, entityID, searchProfile, szflags.MaskFor(szflags.FamilySearch, flags),
+				result, err, time.Since(entryTime))
 		}()
 	}
 
-	result, err = client.whySearchV2(ctx, attributes, entityID, searchProfile, flags)
+	client.warnIrrelevantFlags(szflags.FamilySearch, "WhySearch", flags)
+
+	err = client.checkInputs(
+		4064,
+		validation.JSON("attributes", attributes),
+		validation.Identifier("searchProfile", searchProfile),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { result, err = client.whySearchV2(ctx, attributes, entityID, searchProfile, flags) })
 
 	if client.observers != nil {
 		go func() {
@@ -1899,7 +2234,7 @@ func (client *Szengine) WhySearch(
 				"attributes":    attributes,
 				"entityID":      formatEntityID(entityID),
 				"searchProfile": searchProfile,
-				"flags":         strconv.FormatInt(flags, baseTen),
+				"flags":         szflags.MaskFor(szflags.FamilySearch, flags).String(),
 			}
 			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8031, err, details)
 		}()
@@ -1913,58 +2248,649 @@ func (client *Szengine) WhySearch(
 // ----------------------------------------------------------------------------
 
 /*
-Method GetObserverOrigin returns the "origin" value of past Observer messages.
+Method Drain stops accepting new calls and waits for calls in flight and export iterators to finish.
 
-Input
-  - ctx: A context to control lifecycle.
+Calls made after Drain are rejected. Destroy() may still be called.
+Destroy() drains the object itself, so Drain is only needed to quiesce an object without destroying it.
 
-Output
-  - The value sent in the Observer's "origin" key/value pair.
+Input
+  - ctx: A context to bound the wait.
+    If ctx is done before the calls finish, new calls are accepted again and an error is returned.
 */
-func (client *Szengine) GetObserverOrigin(ctx context.Context) string {
-	_ = ctx
+func (client *Szengine) Drain(ctx context.Context) error {
+	err := client.callGate.Close(ctx)
+	if err != nil && !errors.Is(err, helper.ErrCallGateClosed) {
+		return wraperror.Errorf(errForPackage, "calls in flight did not finish: %v", err)
+	}
 
-	return client.observerOrigin
+	return nil
 }
 
 /*
-Method Initialize initializes the SzEngine object.
-
-It must be called prior to any other calls.
+Method FindNetworkByEntityIDToWriter is the same as [Szengine.FindNetworkByEntityID],
+but streams the JSON document to a writer instead of returning it.
+The document is written straight from the memory of the Senzing C binary, which is then freed,
+so a large response is not copied into Go memory.
 
 Input
   - ctx: A context to control lifecycle.
-  - instanceName: A name for the auditing node, to help identify it within system logs.
-  - settings: A JSON string containing configuration parameters.
-  - configID: The configuration ID used for the initialization.  0 for current default configuration.
-  - verboseLogging: A flag to enable deeper logging of the Sz processing. 0 for no Senzing logging; 1 for logging.
+  - writer: Receives the JSON document. Nothing is written if the call fails.
+  - entityIDs: A JSON document listing entities.
+    Example: `{"ENTITIES": [{"ENTITY_ID": 1}, {"ENTITY_ID": 2}, {"ENTITY_ID": 3}]}`
+  - maxDegrees: The maximum number of degrees in paths between entityIDs.
+  - buildOutDegrees: The number of degrees of relationships to show around each search entity. Zero (0)
+    prevents buildout.
+  - buildOutMaxEntities: The maximum number of entities to build out in the returned network.
+  - flags: Flags used to control information returned.
+
+Output
+  - The number of bytes written.
 */
-func (client *Szengine) Initialize(
+func (client *Szengine) FindNetworkByEntityIDToWriter(
 	ctx context.Context,
-	instanceName string,
-	settings string,
-	configID int64,
-	verboseLogging int64,
-) error {
-	var err error
+	writer io.Writer,
+	entityIDs string,
+	maxDegrees int64,
+	buildOutDegrees int64,
+	buildOutMaxEntities int64,
+	flags int64,
+) (int64, error) {
+	var (
+		err      error
+		response *C.char
+		result   int64
+	)
+
+	if !client.callGate.Enter() {
+		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
+	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
-		client.traceEntry(55, instanceName, settings, configID, verboseLogging)
+		client.traceEntry(83, entityIDs, maxDegrees, buildOutDegrees, buildOutMaxEntities, szflags.Mask(flags))
 
 		entryTime := time.Now()
 		defer func() {
-			client.traceExit(56, instanceName, settings, configID, verboseLogging, err, time.Since(entryTime))
+			client.traceExit(
+				84,
+				entityIDs,
+				maxDegrees,
+				buildOutDegrees,
+				buildOutMaxEntities,
+				szflags.Mask(flags),
+				result,
+				err,
+				time.Since(entryTime),
+			)
 		}()
 	}
 
-	client.instanceName = instanceName
-	client.settings = settings
-	client.verboseLogging = verboseLogging
+	client.warnIrrelevantFlags(szflags.FamilyFindNetwork, "FindNetworkByEntityID", flags)
 
-	if configID > 0 {
-		err = client.initWithConfigID(ctx, instanceName, settings, configID, verboseLogging)
-	} else {
-		err = client.init(ctx, instanceName, settings, verboseLogging)
+	err = client.checkInputs(4013, validation.JSON("entityIDs", entityIDs))
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() {
+		response, err = client.findNetworkByEntityIDV2Response(
+			ctx,
+			entityIDs,
+			maxDegrees,
+			buildOutDegrees,
+			buildOutMaxEntities,
+			flags,
+		)
+	})
+
+	if err == nil {
+		result, err = client.writeResponse(4013, writer, response)
+	}
+
+	C.SzHelper_free(unsafe.Pointer(response))
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"entityIDs": entityIDs,
+				"flags":     szflags.Mask(flags).String(),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8038, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method FindNetworkByRecordIDToWriter is the same as [Szengine.FindNetworkByRecordID],
+but streams the JSON document to a writer instead of returning it.
+The document is written straight from the memory of the Senzing C binary, which is then freed,
+so a large response is not copied into Go memory.
+
+Input
+  - ctx: A context to control lifecycle.
+  - writer: Receives the JSON document. Nothing is written if the call fails.
+  - recordKeys: A JSON document listing records.
+    Example: `{"RECORDS": [{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001"}]}`
+  - maxDegrees: The maximum number of degrees in paths between entities identified by the recordKeys.
+  - buildOutDegrees: The number of degrees of relationships to show around each search entity.
+    Zero (0) prevents buildout.
+  - buildOutMaxEntities: The maximum number of entities to build out in the returned network.
+  - flags: Flags used to control information returned.
+
+Output
+  - The number of bytes written.
+*/
+func (client *Szengine) FindNetworkByRecordIDToWriter(
+	ctx context.Context,
+	writer io.Writer,
+	recordKeys string,
+	maxDegrees int64,
+	buildOutDegrees int64,
+	buildOutMaxEntities int64,
+	flags int64,
+) (int64, error) {
+	var (
+		err      error
+		response *C.char
+		result   int64
+	)
+
+	if !client.callGate.Enter() {
+		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
+	if client.isTrace {
+		client.traceEntry(85, recordKeys, maxDegrees, buildOutDegrees, buildOutMaxEntities, szflags.Mask(flags))
+
+		entryTime := time.Now()
+		defer func() {
+			client.traceExit(
+				86,
+				recordKeys,
+				maxDegrees,
+				buildOutDegrees,
+				buildOutMaxEntities,
+				szflags.Mask(flags),
+				result,
+				err,
+				time.Since(entryTime),
+			)
+		}()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilyFindNetwork, "FindNetworkByRecordID", flags)
+
+	err = client.checkInputs(4015, validation.JSON("recordKeys", recordKeys))
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() {
+		response, err = client.findNetworkByRecordIDV2Response(
+			ctx,
+			recordKeys,
+			maxDegrees,
+			buildOutDegrees,
+			buildOutMaxEntities,
+			flags,
+		)
+	})
+
+	if err == nil {
+		result, err = client.writeResponse(4015, writer, response)
+	}
+
+	C.SzHelper_free(unsafe.Pointer(response))
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"recordKeys": recordKeys,
+				"flags":      szflags.Mask(flags).String(),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8039, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method GetEntityByEntityIDBytes is the same as [Szengine.GetEntityByEntityID],
+but returns the JSON document as a json.RawMessage, skipping the conversion to a string.
+
+Input
+  - ctx: A context to control lifecycle.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+
+Output
+
+  - A JSON document.
+*/
+func (client *Szengine) GetEntityByEntityIDBytes(ctx context.Context, entityID int64, flags int64) (json.RawMessage, error) {
+	var (
+		err    error
+		result json.RawMessage
+	)
+
+	if !client.callGate.Enter() {
+		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
+	if client.isTrace {
+		client.traceEntry(87, entityID, szflags.Mask(flags))
+
+		entryTime := time.Now()
+		defer func() { client.traceExit(88, entityID, szflags.Mask(flags), result, err, time.Since(entryTime)) }()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilyEntity, "GetEntityByEntityID", flags)
+
+	client.executor.Call(func() { result, err = client.getEntityByEntityIDV2Bytes(ctx, entityID, flags) })
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"entityID": formatEntityID(entityID),
+				"flags":    szflags.Mask(flags).String(),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8040, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method GetEntityByEntityIDToWriter is the same as [Szengine.GetEntityByEntityID],
+but streams the JSON document to a writer instead of returning it.
+The document is written straight from the memory of the Senzing C binary, which is then freed,
+so a large response is not copied into Go memory.
+
+Input
+  - ctx: A context to control lifecycle.
+  - writer: Receives the JSON document. Nothing is written if the call fails.
+  - entityID: The unique identifier of an entity.
+  - flags: Flags used to control information returned.
+
+Output
+  - The number of bytes written.
+*/
+func (client *Szengine) GetEntityByEntityIDToWriter(
+	ctx context.Context,
+	writer io.Writer,
+	entityID int64,
+	flags int64,
+) (int64, error) {
+	var (
+		err      error
+		response *C.char
+		result   int64
+	)
+
+	if !client.callGate.Enter() {
+		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
+	if client.isTrace {
+		client.traceEntry(89, entityID, szflags.Mask(flags))
+
+		entryTime := time.Now()
+		defer func() { client.traceExit(90, entityID, szflags.Mask(flags), result, err, time.Since(entryTime)) }()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilyEntity, "GetEntityByEntityID", flags)
+
+	client.executor.Call(func() { response, err = client.getEntityByEntityIDV2Response(ctx, entityID, flags) })
+
+	if err == nil {
+		result, err = client.writeResponse(4030, writer, response)
+	}
+
+	C.SzHelper_free(unsafe.Pointer(response))
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"entityID": formatEntityID(entityID),
+				"flags":    szflags.Mask(flags).String(),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8041, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method GetEntityByRecordIDBytes is the same as [Szengine.GetEntityByRecordID],
+but returns the JSON document as a json.RawMessage, skipping the conversion to a string.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) GetEntityByRecordIDBytes(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (json.RawMessage, error) {
+	var (
+		err    error
+		result json.RawMessage
+	)
+
+	if !client.callGate.Enter() {
+		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
+	if client.isTrace {
+		client.traceEntry(91, dataSourceCode, recordID, szflags.Mask(flags))
+
+		entryTime := time.Now()
+		defer func() {
+			client.traceExit(92, dataSourceCode, recordID, szflags.Mask(flags), result, err, time.Since(entryTime))
+		}()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilyEntity, "GetEntityByRecordID", flags)
+
+	err = client.checkInputs(
+		4032,
+		validation.Identifier("dataSourceCode", dataSourceCode),
+		validation.Identifier("recordID", recordID),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { result, err = client.getEntityByRecordIDV2Bytes(ctx, dataSourceCode, recordID, flags) })
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"dataSourceCode": dataSourceCode,
+				"recordID":       recordID,
+				"flags":          szflags.Mask(flags).String(),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8042, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method GetEntityByRecordIDToWriter is the same as [Szengine.GetEntityByRecordID],
+but streams the JSON document to a writer instead of returning it.
+The document is written straight from the memory of the Senzing C binary, which is then freed,
+so a large response is not copied into Go memory.
+
+Input
+  - ctx: A context to control lifecycle.
+  - writer: Receives the JSON document. Nothing is written if the call fails.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - The number of bytes written.
+*/
+func (client *Szengine) GetEntityByRecordIDToWriter(
+	ctx context.Context,
+	writer io.Writer,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (int64, error) {
+	var (
+		err      error
+		response *C.char
+		result   int64
+	)
+
+	if !client.callGate.Enter() {
+		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
+	if client.isTrace {
+		client.traceEntry(93, dataSourceCode, recordID, szflags.Mask(flags))
+
+		entryTime := time.Now()
+		defer func() {
+			client.traceExit(94, dataSourceCode, recordID, szflags.Mask(flags), result, err, time.Since(entryTime))
+		}()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilyEntity, "GetEntityByRecordID", flags)
+
+	err = client.checkInputs(
+		4032,
+		validation.Identifier("dataSourceCode", dataSourceCode),
+		validation.Identifier("recordID", recordID),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { response, err = client.getEntityByRecordIDV2Response(ctx, dataSourceCode, recordID, flags) })
+
+	if err == nil {
+		result, err = client.writeResponse(4032, writer, response)
+	}
+
+	C.SzHelper_free(unsafe.Pointer(response))
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"dataSourceCode": dataSourceCode,
+				"recordID":       recordID,
+				"flags":          szflags.Mask(flags).String(),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8043, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method GetObserverOrigin returns the "origin" value of past Observer messages.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The value sent in the Observer's "origin" key/value pair.
+*/
+func (client *Szengine) GetObserverOrigin(ctx context.Context) string {
+	_ = ctx
+
+	return client.observerOrigin
+}
+
+/*
+Method GetOpenExportHandles returns the export handles that have not been closed.
+
+Handles are created by [Szengine.ExportCsvEntityReport] and [Szengine.ExportJSONEntityReport]
+and removed by [Szengine.CloseExportReport].
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The open export handles, oldest first.
+*/
+func (client *Szengine) GetOpenExportHandles(ctx context.Context) []ExportHandleInfo {
+	_ = ctx
+
+	client.exportHandlesMutex.Lock()
+	defer client.exportHandlesMutex.Unlock()
+
+	result := make([]ExportHandleInfo, 0, len(client.exportHandles))
+	for _, entry := range client.exportHandles {
+		result = append(result, entry.info)
+	}
+
+	slices.SortFunc(result, func(a, b ExportHandleInfo) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return result
+}
+
+/*
+Method GetRecordBytes is the same as [Szengine.GetRecord],
+but returns the JSON document as a json.RawMessage, skipping the conversion to a string.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) GetRecordBytes(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (json.RawMessage, error) {
+	var (
+		err    error
+		result json.RawMessage
+	)
+
+	if !client.callGate.Enter() {
+		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
+	if client.isTrace {
+		client.traceEntry(95, dataSourceCode, recordID, szflags.Mask(flags))
+
+		entryTime := time.Now()
+		defer func() {
+			client.traceExit(96, dataSourceCode, recordID, szflags.Mask(flags), result, err, time.Since(entryTime))
+		}()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilyRecord, "GetRecord", flags)
+
+	err = client.checkInputs(
+		4035,
+		validation.Identifier("dataSourceCode", dataSourceCode),
+		validation.Identifier("recordID", recordID),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { result, err = client.getRecordV2Bytes(ctx, dataSourceCode, recordID, flags) })
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"dataSourceCode": dataSourceCode,
+				"recordID":       recordID,
+				"flags":          szflags.Mask(flags).String(),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8044, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method Hold makes new calls wait, then waits for calls in flight to finish,
+so that the Senzing configuration can be swapped safely. Release() lets the waiting calls proceed.
+
+Input
+  - ctx: A context to bound the wait.
+    If ctx is done before the calls finish, the waiting calls proceed and an error is returned.
+*/
+func (client *Szengine) Hold(ctx context.Context) error {
+	err := client.callGate.Hold(ctx)
+	if err != nil {
+		return wraperror.Errorf(errForPackage, "cannot hold calls: %v", err)
+	}
+
+	return nil
+}
+
+/*
+Method Initialize initializes the SzEngine object.
+
+It must be called prior to any other calls.
+
+Input
+  - ctx: A context to control lifecycle.
+  - instanceName: A name for the auditing node, to help identify it within system logs.
+  - settings: A JSON string containing configuration parameters.
+  - configID: The configuration ID used for the initialization.  0 for current default configuration.
+  - verboseLogging: A flag to enable deeper logging of the Sz processing. 0 for no Senzing logging; 1 for logging.
+*/
+func (client *Szengine) Initialize(
+	ctx context.Context,
+	instanceName string,
+	settings string,
+	configID int64,
+	verboseLogging int64,
+) error {
+	var err error
+
+	if client.isTrace {
+		client.traceEntry(55, instanceName, settings, configID, verboseLogging)
+
+		entryTime := time.Now()
+		defer func() {
+			client.traceExit(56, instanceName, settings, configID, verboseLogging, err, time.Since(entryTime))
+		}()
+	}
+
+	errorNumber := 4041 // See init() and initWithConfigID().
+
+	if configID > 0 {
+		errorNumber = 4042
+	}
+
+	err = client.checkInputs(
+		errorNumber,
+		validation.Identifier("instanceName", instanceName),
+		validation.Document("settings", settings),
+	)
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.instanceName = instanceName
+	client.settings = settings
+	client.verboseLogging = verboseLogging
+
+	if configID > 0 {
+		client.executor.Call(func() {
+			err = client.initWithConfigID(ctx, instanceName, settings, configID, verboseLogging)
+		})
+	} else {
+		client.executor.Call(func() { err = client.init(ctx, instanceName, settings, verboseLogging) })
 	}
 
 	if client.observers != nil {
@@ -1982,6 +2908,18 @@ func (client *Szengine) Initialize(
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+Method IsDestroyed reports whether Destroy() has been called.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szengine) IsDestroyed(ctx context.Context) bool {
+	_ = ctx
+
+	return client.isDestroyed
+}
+
 /*
 Method IsInitialized inspects C binary to see if it is initialized.
 
@@ -1997,6 +2935,90 @@ func (client *Szengine) IsInitialized(ctx context.Context) bool {
 	return result.returnCode != uninitializedError
 }
 
+/*
+Method PatchRecord applies a JSON Merge Patch to a record in the repository and adds the result again.
+
+The patch, as defined by [RFC 7386], is merged into the JSON_DATA of the stored record:
+a null value removes an attribute, an object value is merged and any other value replaces the attribute.
+The patch cannot change DATA_SOURCE or RECORD_ID.
+
+PatchRecord calls to the same SzEngine for the same record run one at a time,
+so they do not overwrite each other's changes.
+Other changes to the record, such as AddRecord() calls or patches by other processes,
+are not detected and may be overwritten,
+as Senzing cannot add a record on the condition that it is unchanged.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - patch: A JSON object with the changes to the record.
+  - flags: Flags used to control information returned. SzWithInfo is implied.
+
+Output
+  - The patched record definition, as added to the repository.
+  - A JSON document describing the affected entities, as returned by AddRecord() with SzWithInfo.
+
+[RFC 7386]: https://www.rfc-editor.org/rfc/rfc7386
+*/
+func (client *Szengine) PatchRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	patch string,
+	flags int64,
+) (string, string, error) {
+	var (
+		err      error
+		record   string
+		withInfo string
+	)
+
+	if !client.callGate.Enter() {
+		return record, withInfo, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
+	if client.isTrace {
+		client.traceEntry(81, dataSourceCode, recordID, patch, szflags.Mask(flags))
+
+		entryTime := time.Now()
+		defer func() {
+			client.traceExit(82, dataSourceCode, recordID, patch, szflags.Mask(flags), record, withInfo, err, time.Since(entryTime))
+		}()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilyWithInfo, "PatchRecord", flags)
+
+	err = client.checkInputs(
+		4065,
+		validation.Identifier("dataSourceCode", dataSourceCode),
+		validation.Identifier("recordID", recordID),
+		validation.JSON("patch", patch),
+	)
+	if err != nil {
+		return record, withInfo, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	finalFlags := flags & ^senzing.SzWithInfo
+	client.executor.Call(func() {
+		record, withInfo, err = client.patchRecordLocked(ctx, dataSourceCode, recordID, patch, finalFlags)
+	})
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"dataSourceCode": dataSourceCode,
+				"recordID":       recordID,
+				"flags":          szflags.Mask(flags).String(),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8037, err, details)
+		}()
+	}
+
+	return record, withInfo, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
 /*
 Method RegisterObserver adds the observer to the list of observers notified.
 
@@ -2042,6 +3064,11 @@ Input
 func (client *Szengine) Reinitialize(ctx context.Context, configID int64) error {
 	var err error
 
+	if !client.callGate.Enter() {
+		return wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
 	if client.isTrace {
 		client.traceEntry(65, configID)
 
@@ -2049,7 +3076,7 @@ func (client *Szengine) Reinitialize(ctx context.Context, configID int64) error
 		defer func() { client.traceExit(66, configID, err, time.Since(entryTime)) }()
 	}
 
-	err = client.reinit(ctx, configID)
+	client.executor.Call(func() { err = client.reinit(ctx, configID) })
 
 	if client.observers != nil {
 		go func() {
@@ -2060,7 +3087,130 @@ func (client *Szengine) Reinitialize(ctx context.Context, configID int64) error
 		}()
 	}
 
-	return wraperror.Errorf(err, wraperror.NoMessage)
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method Release lets the calls held by Hold() proceed.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szengine) Release(ctx context.Context) {
+	_ = ctx
+
+	client.callGate.Release()
+}
+
+/*
+Method SearchByAttributesBytes is the same as [Szengine.SearchByAttributes],
+but returns the JSON document as a json.RawMessage, skipping the conversion to a string.
+
+Input
+  - ctx: A context to control lifecycle.
+  - attributes: A JSON document containing the attributes desired in the result set.
+    Example: `{"NAME_FULL": "BOB SMITH", "EMAIL_ADDRESS": "bsmith@work.com"}`
+  - searchProfile: The name of the search profile to use in the search.
+    An empty string will use the default search profile.
+    Example: "SEARCH"
+  - flags: Flags used to control information returned.
+
+Output
+  - A JSON document.
+*/
+func (client *Szengine) SearchByAttributesBytes(
+	ctx context.Context,
+	attributes string,
+	searchProfile string,
+	flags int64,
+) (json.RawMessage, error) {
+	var (
+		err    error
+		result json.RawMessage
+	)
+
+	if !client.callGate.Enter() {
+		return result, wraperror.Errorf(errForPackage, "This SzEngine has been destroyed.")
+	}
+	defer client.callGate.Exit()
+
+	if client.isTrace {
+		client.traceEntry(97, attributes, searchProfile, szflags.MaskFor(szflags.FamilySearch, flags))
+
+		entryTime := time.Now()
+		defer func() {
+			client.traceExit(98, attributes, searchProfile, szflags.MaskFor(szflags.FamilySearch, flags),
+				result, err, time.Since(entryTime))
+		}()
+	}
+
+	client.warnIrrelevantFlags(szflags.FamilySearch, "SearchByAttributes", flags)
+
+	err = client.checkInputs(
+		4053,
+		validation.JSON("attributes", attributes),
+		validation.Identifier("searchProfile", searchProfile),
+	)
+	if err != nil {
+		return result, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	client.executor.Call(func() { result, err = client.searchByAttributesV3Bytes(ctx, attributes, searchProfile, flags) })
+
+	if client.observers != nil {
+		go func() {
+			details := map[string]string{
+				"attributes":    attributes,
+				"searchProfile": searchProfile,
+				"flags":         szflags.MaskFor(szflags.FamilySearch, flags).String(),
+			}
+			notifier.Notify(ctx, client.observers, client.observerOrigin, ComponentID, 8045, err, details)
+		}()
+	}
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+Method SetExecutor routes calls into the Senzing C binary through an [helper.Executor].
+
+By default each call locks the calling goroutine to its OS thread.
+With an executor the calls run on its pool of threads, which stay locked.
+Set the executor before making calls; it is not changed while calls are in flight.
+
+Input
+  - ctx: A context to control lifecycle.
+  - executor: The executor to use. nil restores the default.
+*/
+func (client *Szengine) SetExecutor(ctx context.Context, executor *helper.Executor) {
+	_ = ctx
+	client.executor = executor
+}
+
+/*
+Method SetExportHandleLeakThreshold enables a debug mode that logs export handles left open too long.
+
+Each open handle older than the threshold is logged once, at WARN level, with the stack trace that created it.
+
+Input
+  - ctx: A context to control lifecycle.
+  - threshold: How long a handle may stay open before it is logged. 0 disables the debug mode.
+*/
+func (client *Szengine) SetExportHandleLeakThreshold(ctx context.Context, threshold time.Duration) {
+	_ = ctx
+
+	client.exportHandlesMutex.Lock()
+	defer client.exportHandlesMutex.Unlock()
+
+	if client.exportHandleMonitorStop != nil {
+		close(client.exportHandleMonitorStop)
+		client.exportHandleMonitorStop = nil
+	}
+
+	if threshold > 0 {
+		client.exportHandleMonitorStop = make(chan struct{})
+		go client.monitorExportHandles(threshold, client.exportHandleMonitorStop)
+	}
 }
 
 /*
@@ -2495,13 +3645,31 @@ func (client *Szengine) findNetworkByEntityIDV2(
 	buildOutMaxEntities int64,
 	flags int64,
 ) (string, error) {
+	response, err := client.findNetworkByEntityIDV2Response(
+		ctx,
+		entityIDs,
+		maxDegrees,
+		buildOutDegrees,
+		buildOutMaxEntities,
+		flags,
+	)
+	defer C.SzHelper_free(unsafe.Pointer(response))
+
+	return C.GoString(response), err
+}
+
+func (client *Szengine) findNetworkByEntityIDV2Response(
+	ctx context.Context,
+	entityIDs string,
+	maxDegrees int64,
+	buildOutDegrees int64,
+	buildOutMaxEntities int64,
+	flags int64,
+) (*C.char, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
-	var (
-		err            error
-		resultResponse string
-	)
+	var err error
 
 	entityListForC := C.CString(entityIDs)
 
@@ -2527,11 +3695,7 @@ func (client *Szengine) findNetworkByEntityIDV2(
 		)
 	}
 
-	resultResponse = C.GoString(result.response)
-
-	C.SzHelper_free(unsafe.Pointer(result.response))
-
-	return resultResponse, err
+	return result.response, err
 }
 
 func (client *Szengine) findNetworkByRecordIDV2(
@@ -2542,13 +3706,31 @@ func (client *Szengine) findNetworkByRecordIDV2(
 	buildOutMaxEntities int64,
 	flags int64,
 ) (string, error) {
+	response, err := client.findNetworkByRecordIDV2Response(
+		ctx,
+		recordKeys,
+		maxDegrees,
+		buildOutDegrees,
+		buildOutMaxEntities,
+		flags,
+	)
+	defer C.SzHelper_free(unsafe.Pointer(response))
+
+	return C.GoString(response), err
+}
+
+func (client *Szengine) findNetworkByRecordIDV2Response(
+	ctx context.Context,
+	recordKeys string,
+	maxDegrees int64,
+	buildOutDegrees int64,
+	buildOutMaxEntities int64,
+	flags int64,
+) (*C.char, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
-	var (
-		err            error
-		resultResponse string
-	)
+	var err error
 
 	recordListForC := C.CString(recordKeys)
 
@@ -2573,11 +3755,7 @@ func (client *Szengine) findNetworkByRecordIDV2(
 		)
 	}
 
-	resultResponse = C.GoString(result.response)
-
-	C.SzHelper_free(unsafe.Pointer(result.response))
-
-	return resultResponse, err
+	return result.response, err
 }
 
 /*
@@ -3059,24 +4237,30 @@ func (client *Szengine) getActiveConfigID(ctx context.Context) (int64, error) {
 }
 
 func (client *Szengine) getEntityByEntityIDV2(ctx context.Context, entityID int64, flags int64) (string, error) {
+	response, err := client.getEntityByEntityIDV2Bytes(ctx, entityID, flags)
+
+	return bytesToString(response), err
+}
+
+func (client *Szengine) getEntityByEntityIDV2Bytes(ctx context.Context, entityID int64, flags int64) ([]byte, error) {
+	response, err := client.getEntityByEntityIDV2Response(ctx, entityID, flags)
+	defer C.SzHelper_free(unsafe.Pointer(response))
+
+	return goBytes(response), err
+}
+
+func (client *Szengine) getEntityByEntityIDV2Response(ctx context.Context, entityID int64, flags int64) (*C.char, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
-	var (
-		err            error
-		resultResponse string
-	)
+	var err error
 
 	result := C.Sz_getEntityByEntityID_V2_helper(C.int64_t(entityID), C.int64_t(flags))
 	if result.returnCode != noError {
 		err = client.newError(ctx, 4030, entityID, flags, result.returnCode)
 	}
 
-	resultResponse = C.GoString(result.response)
-
-	C.SzHelper_free(unsafe.Pointer(result.response))
-
-	return resultResponse, err
+	return result.response, err
 }
 
 func (client *Szengine) getEntityByRecordIDV2(
@@ -3085,13 +4269,33 @@ func (client *Szengine) getEntityByRecordIDV2(
 	recordID string,
 	flags int64,
 ) (string, error) {
+	response, err := client.getEntityByRecordIDV2Bytes(ctx, dataSourceCode, recordID, flags)
+
+	return bytesToString(response), err
+}
+
+func (client *Szengine) getEntityByRecordIDV2Bytes(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) ([]byte, error) {
+	response, err := client.getEntityByRecordIDV2Response(ctx, dataSourceCode, recordID, flags)
+	defer C.SzHelper_free(unsafe.Pointer(response))
+
+	return goBytes(response), err
+}
+
+func (client *Szengine) getEntityByRecordIDV2Response(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (*C.char, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
-	var (
-		err            error
-		resultResponse string
-	)
+	var err error
 
 	dataSourceCodeForC := C.CString(dataSourceCode)
 
@@ -3106,11 +4310,7 @@ func (client *Szengine) getEntityByRecordIDV2(
 		err = client.newError(ctx, 4032, dataSourceCode, recordID, flags, result.returnCode)
 	}
 
-	resultResponse = C.GoString(result.response)
-
-	C.SzHelper_free(unsafe.Pointer(result.response))
-
-	return resultResponse, err
+	return result.response, err
 }
 
 func (client *Szengine) getRecordV2(
@@ -3119,12 +4319,23 @@ func (client *Szengine) getRecordV2(
 	recordID string,
 	flags int64,
 ) (string, error) {
+	response, err := client.getRecordV2Bytes(ctx, dataSourceCode, recordID, flags)
+
+	return bytesToString(response), err
+}
+
+func (client *Szengine) getRecordV2Bytes(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) ([]byte, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
 	var (
 		err            error
-		resultResponse string
+		resultResponse []byte
 	)
 
 	dataSourceCodeForC := C.CString(dataSourceCode)
@@ -3140,7 +4351,7 @@ func (client *Szengine) getRecordV2(
 		err = client.newError(ctx, 4035, dataSourceCode, recordID, flags, result.returnCode)
 	}
 
-	resultResponse = C.GoString(result.response)
+	resultResponse = goBytes(result.response)
 
 	C.SzHelper_free(unsafe.Pointer(result.response))
 
@@ -3624,12 +4835,23 @@ func (client *Szengine) searchByAttributesV3(
 	searchProfile string,
 	flags int64,
 ) (string, error) {
+	response, err := client.searchByAttributesV3Bytes(ctx, attributes, searchProfile, flags)
+
+	return bytesToString(response), err
+}
+
+func (client *Szengine) searchByAttributesV3Bytes(
+	ctx context.Context,
+	attributes string,
+	searchProfile string,
+	flags int64,
+) ([]byte, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
 	var (
 		err            error
-		resultResponse string
+		resultResponse []byte
 	)
 
 	attributesForC := C.CString(attributes)
@@ -3645,7 +4867,7 @@ func (client *Szengine) searchByAttributesV3(
 		err = client.newError(ctx, 4053, attributes, searchProfile, flags, result.returnCode)
 	}
 
-	resultResponse = C.GoString(result.response)
+	resultResponse = goBytes(result.response)
 
 	C.SzHelper_free(unsafe.Pointer(result.response))
 
@@ -3933,7 +5155,13 @@ func (client *Szengine) fetchNextIntoChannel(
 
 			return
 		default:
-			entityReportFragment, err := client.FetchNext(ctx, reportHandle)
+			var (
+				entityReportFragment string
+				err                  error
+			)
+
+			client.executor.Call(func() { entityReportFragment, err = client.fetchNext(ctx, reportHandle) })
+
 			if err != nil {
 				fragment := senzing.StringFragment{
 					Error: err,
@@ -3960,7 +5188,11 @@ func (client *Szengine) fetchNextIntoChannel(
 // Get the Logger singleton.
 func (client *Szengine) getLogger() logging.Logging {
 	if client.logger == nil {
-		client.logger = helper.GetLogger(ComponentID, szengine.IDMessages, baseCallerSkip)
+		client.logger = helper.GetLogger(
+			ComponentID,
+			helper.MergeIDMessages(szengine.IDMessages, idMessages),
+			baseCallerSkip,
+		)
 	}
 
 	return client.logger
@@ -3969,7 +5201,11 @@ func (client *Szengine) getLogger() logging.Logging {
 // Get the Messenger singleton.
 func (client *Szengine) getMessenger() messenger.Messenger {
 	if client.messenger == nil {
-		client.messenger = helper.GetMessenger(ComponentID, szengine.IDMessages, baseCallerSkip)
+		client.messenger = helper.GetMessenger(
+			ComponentID,
+			helper.MergeIDMessages(szengine.IDMessages, idMessages),
+			baseCallerSkip,
+		)
 	}
 
 	return client.messenger
@@ -3985,13 +5221,143 @@ func (client *Szengine) traceExit(errorNumber int, details ...interface{}) {
 	client.getLogger().Log(errorNumber, details...)
 }
 
+// Warn, once per method and combination, about flags that have no effect on the method.
+func (client *Szengine) warnIrrelevantFlags(family szflags.Family, method string, flags int64) {
+	irrelevant := szflags.Irrelevant(family, flags)
+	if irrelevant == senzing.SzNoFlags {
+		return
+	}
+
+	key := method + ":" + strconv.FormatInt(irrelevant, baseTen)
+	if _, warned := client.irrelevantFlagsWarned.LoadOrStore(key, true); !warned {
+		client.getLogger().Log(3003, method, strings.Join(szflags.DecodeFor(family, irrelevant), " | "))
+	}
+}
+
 func formatEntityID(entityID int64) string {
 	return strconv.FormatInt(entityID, baseTen)
 }
 
+// --- Export handles ---------------------------------------------------------
+
+// Close export handles that were not closed before Destroy().
+func (client *Szengine) closeLeftoverExportHandles(ctx context.Context) int {
+	leftovers := client.GetOpenExportHandles(ctx)
+
+	for _, leftover := range leftovers {
+		var err error
+
+		client.executor.Call(func() { err = client.closeExportReport(ctx, leftover.Handle) })
+
+		if err == nil {
+			client.untrackExportHandle(leftover.Handle)
+		}
+
+		client.getLogger().Log(3002, leftover.Handle, time.Since(leftover.CreatedAt), leftover.CreationStack)
+	}
+
+	return len(leftovers)
+}
+
+// Log export handles that have been open longer than the threshold.
+func (client *Szengine) logExportHandleLeaks(threshold time.Duration) {
+	client.exportHandlesMutex.Lock()
+
+	leaks := []ExportHandleInfo{}
+
+	for _, entry := range client.exportHandles {
+		if !entry.isReported && time.Since(entry.info.CreatedAt) > threshold {
+			entry.isReported = true
+			leaks = append(leaks, entry.info)
+		}
+	}
+
+	client.exportHandlesMutex.Unlock()
+
+	for _, leak := range leaks {
+		client.getLogger().Log(3001, leak.Handle, time.Since(leak.CreatedAt), leak.CreationStack)
+	}
+}
+
+// Periodically look for export handles that have been open longer than the threshold.
+func (client *Szengine) monitorExportHandles(threshold time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(threshold / exportHandleChecks)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			client.logExportHandleLeaks(threshold)
+		}
+	}
+}
+
+// Remember an export handle returned by an Export*EntityReport() method.
+func (client *Szengine) trackExportHandle(exportHandle uintptr, report string, flags int64) {
+	client.exportHandlesMutex.Lock()
+	defer client.exportHandlesMutex.Unlock()
+
+	if client.exportHandles == nil {
+		client.exportHandles = map[uintptr]*exportHandleEntry{}
+	}
+
+	client.exportHandles[exportHandle] = &exportHandleEntry{
+		info: ExportHandleInfo{
+			CreatedAt:     time.Now(),
+			CreationStack: string(debug.Stack()),
+			Flags:         flags,
+			Handle:        exportHandle,
+			Report:        report,
+		},
+		isReported: false,
+	}
+}
+
+// Forget an export handle closed by CloseExportReport().
+func (client *Szengine) untrackExportHandle(exportHandle uintptr) {
+	client.exportHandlesMutex.Lock()
+	defer client.exportHandlesMutex.Unlock()
+
+	delete(client.exportHandles, exportHandle)
+}
+
 // --- Errors -----------------------------------------------------------------
 
-// Create a new error.
+// Check parameters before they are sent to the Senzing C binary.
+func (client *Szengine) checkInputs(errorNumber int, inputs ...validation.Input) error {
+	return helper.CheckInputs(ComponentID, errorNumber, client.getMessenger(), inputs...)
+}
+
+// The error number of the FindPath*() C function used for the avoid list and required data sources.
+func errorNumberForFindPath(
+	avoid string,
+	requiredDataSources string,
+	errorNumber int,
+	errorNumberWithAvoids int,
+	errorNumberIncludingSource int,
+) int {
+	switch {
+	case len(requiredDataSources) > 0:
+		return errorNumberIncludingSource
+	case len(avoid) > 0:
+		return errorNumberWithAvoids
+	default:
+		return errorNumber
+	}
+}
+
+// The error number of the C function used with or without the senzing.SzWithInfo flag.
+func errorNumberForFlags(flags int64, errorNumber int, errorNumberWithInfo int) int {
+	if (flags & senzing.SzWithInfo) == senzing.SzNoFlags {
+		return errorNumber
+	}
+
+	return errorNumberWithInfo
+}
+
+// Create a new error.  The returned *sdkerror.Error can be retrieved using errors.As().
 func (client *Szengine) newError(ctx context.Context, errorNumber int, details ...interface{}) error {
 	defer func() { client.panicOnError(client.clearLastException(ctx)) }()
 
@@ -4002,12 +5368,36 @@ func (client *Szengine) newError(ctx context.Context, errorNumber int, details .
 		lastException = err.Error()
 	}
 
+	arguments := details
 	details = append(details, messenger.MessageCode{Value: fmt.Sprintf(ExceptionCodeTemplate, lastExceptionCode)})
 	details = append(details, messenger.MessageReason{Value: lastException})
 	details = append(details, wraperror.Errorf(szerror.ErrSz, "exception: %s", lastException))
 	errorMessage := client.getMessenger().NewJSON(errorNumber, details...)
 
-	return szerror.New(lastExceptionCode, errorMessage) //nolint
+	return sdkerror.New(
+		ComponentID,
+		errorNumber,
+		lastExceptionCode,
+		lastException,
+		arguments,
+		szerror.New(lastExceptionCode, errorMessage),
+	)
+}
+
+// Create an error detected by this package rather than by the Senzing C binary.
+// The returned *sdkerror.Error wraps sentinel and can be retrieved using errors.As().
+func (client *Szengine) newLocalError(errorNumber int, sentinel error, reason string, details ...interface{}) error {
+	details = append(details, messenger.MessageReason{Value: reason})
+	errorMessage := client.getMessenger().NewJSON(errorNumber, details...)
+
+	return sdkerror.New(
+		ComponentID,
+		errorNumber,
+		0,
+		reason,
+		nil,
+		errors.Join(sentinel, szerror.ErrSz, errors.New(errorMessage)), //nolint:err113
+	)
 }
 
 /*
@@ -4071,9 +5461,11 @@ func (client *Szengine) getLastException(ctx context.Context) (string, error) {
 		defer func() { client.traceExit(42, result, err, time.Since(entryTime)) }()
 	}
 
-	stringBuffer := client.getByteArray(initialByteArraySize)
-	C.Sz_getLastException((*C.char)(unsafe.Pointer(&stringBuffer[0])), C.size_t(len(stringBuffer)))
-	result = string(bytes.Trim(stringBuffer, "\x00"))
+	stringBuffer := helper.GetExceptionBuffer()
+	defer helper.PutExceptionBuffer(stringBuffer)
+
+	C.Sz_getLastException((*C.char)(unsafe.Pointer(&(*stringBuffer)[0])), C.size_t(len(*stringBuffer)))
+	result = helper.NulTerminatedString(*stringBuffer)
 
 	return result, err
 }
@@ -4109,9 +5501,163 @@ func (client *Szengine) getLastExceptionCode(ctx context.Context) (int, error) {
 
 // --- Misc -------------------------------------------------------------------
 
-// Make a byte array.
-func (client *Szengine) getByteArray(size int) []byte {
-	return make([]byte, size)
+// The JSON_DATA of a stored record, compacted so that two reads can be compared byte for byte.
+func (client *Szengine) getRecordJSONData(ctx context.Context, dataSourceCode string, recordID string) ([]byte, error) {
+	response, err := client.getRecordV2Bytes(ctx, dataSourceCode, recordID, senzing.SzEntityIncludeRecordJSONData)
+	if err != nil {
+		return nil, err
+	}
+
+	var document struct {
+		JSONData json.RawMessage `json:"JSON_DATA"`
+	}
+
+	var compacted bytes.Buffer
+
+	err = json.Unmarshal(response, &document)
+	if err == nil {
+		err = json.Compact(&compacted, document.JSONData)
+	}
+
+	if err != nil {
+		return nil, client.newLocalError(4035, szerror.ErrSz, "reading JSON_DATA: "+err.Error(), dataSourceCode, recordID)
+	}
+
+	return compacted.Bytes(), nil
+}
+
+// The lock that serializes PatchRecord() calls for the record. Data source codes are not case-sensitive.
+func (client *Szengine) getPatchRecordLock(dataSourceCode string, recordID string) *sync.Mutex {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(strings.ToUpper(dataSourceCode)))
+	_, _ = hash.Write([]byte{0})
+	_, _ = hash.Write([]byte(recordID))
+
+	return &client.patchRecordLocks[hash.Sum32()%patchRecordLockStripes]
+}
+
+/*
+Method patchRecord reads the JSON_DATA of a record, applies the merge patch,
+and adds the patched record.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - patch: A JSON Merge Patch.
+  - flags: Flags used to control information returned, without SzWithInfo.
+
+Output
+  - The patched record definition.
+  - A JSON document describing the affected entities.
+*/
+func (client *Szengine) patchRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	patch string,
+	flags int64,
+) (string, string, error) {
+	original, err := client.getRecordJSONData(ctx, dataSourceCode, recordID)
+	if err != nil {
+		return "", "", err
+	}
+
+	patched, err := helper.MergePatch(original, []byte(patch))
+	if err != nil {
+		return "", "", client.newLocalError(4065, szerror.ErrSzBadInput, err.Error(), dataSourceCode, recordID)
+	}
+
+	reason := checkPatchedRecord(patched, dataSourceCode, recordID)
+	if len(reason) > 0 {
+		return "", "", client.newLocalError(4065, szerror.ErrSzBadInput, reason, dataSourceCode, recordID)
+	}
+
+	record := string(patched)
+
+	withInfo, err := client.addRecordWithInfo(ctx, dataSourceCode, recordID, record, flags)
+	if err != nil {
+		return "", "", err
+	}
+
+	return record, withInfo, nil
+}
+
+// Run patchRecord() holding the lock of the record, which is released even if patchRecord() panics.
+func (client *Szengine) patchRecordLocked(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	patch string,
+	flags int64,
+) (string, string, error) {
+	patchRecordLock := client.getPatchRecordLock(dataSourceCode, recordID)
+	patchRecordLock.Lock()
+	defer patchRecordLock.Unlock()
+
+	return client.patchRecord(ctx, dataSourceCode, recordID, patch, flags)
+}
+
+// Convert bytes that are not modified afterwards to a string without copying them.
+func bytesToString(value []byte) string {
+	if len(value) == 0 {
+		return ""
+	}
+
+	return unsafe.String(&value[0], len(value))
+}
+
+// Copy a NUL-terminated C string into a new byte slice.
+func goBytes(value *C.char) []byte {
+	return C.GoBytes(unsafe.Pointer(value), C.int(C.strlen(value)))
+}
+
+// Write a NUL-terminated C string to a writer without copying it into Go memory.
+func (client *Szengine) writeResponse(errorNumber int, writer io.Writer, response *C.char) (int64, error) {
+	length := C.strlen(response)
+	if length == 0 {
+		return 0, nil
+	}
+
+	written, err := writer.Write(unsafe.Slice((*byte)(unsafe.Pointer(response)), length))
+	if err != nil {
+		errorMessage := client.getMessenger().NewJSON(errorNumber, messenger.MessageReason{Value: err.Error()})
+		err = sdkerror.New(ComponentID, errorNumber, 0, err.Error(), nil, helper.NewJSONError(errorMessage, err))
+	}
+
+	return int64(written), err
+}
+
+// The reason the patched record cannot be added, or "" if it can.
+func checkPatchedRecord(patched []byte, dataSourceCode string, recordID string) string {
+	var record map[string]json.RawMessage
+
+	err := json.Unmarshal(patched, &record)
+	if err != nil || record == nil {
+		return "the patched record is not a JSON object"
+	}
+
+	if value, isPresent := record["DATA_SOURCE"]; isPresent && !strings.EqualFold(jsonText(value), dataSourceCode) {
+		return "the patch cannot change DATA_SOURCE"
+	}
+
+	if value, isPresent := record["RECORD_ID"]; isPresent && jsonText(value) != recordID {
+		return "the patch cannot change RECORD_ID"
+	}
+
+	return ""
+}
+
+// The text of a JSON string, or the JSON itself for other values. Example: 1001 and "1001" are both "1001".
+func jsonText(value json.RawMessage) string {
+	var text string
+
+	err := json.Unmarshal(value, &text)
+	if err != nil {
+		return string(value)
+	}
+
+	return text
 }
 
 // A hack: Only needed to import the "senzing" package for the godoc comments.
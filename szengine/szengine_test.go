@@ -3,11 +3,13 @@ package szengine_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,10 +22,13 @@ import (
 	"github.com/senzing-garage/go-helpers/truthset"
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/sz-sdk-go-core/getversion"
+	"github.com/senzing-garage/sz-sdk-go-core/sdkerror"
 	"github.com/senzing-garage/sz-sdk-go-core/szabstractfactory"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfig"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
 	"github.com/senzing-garage/sz-sdk-go-core/szengine"
+	"github.com/senzing-garage/sz-sdk-go-core/szflags"
+	"github.com/senzing-garage/sz-sdk-go-core/validation"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-go/szerror"
 	"github.com/stretchr/testify/require"
@@ -86,6 +91,7 @@ var (
 
 var (
 	defaultConfigID   int64
+	errWriter         = errors.New("writer failed")
 	logLevel          = env.GetEnv("SENZING_LOG_LEVEL", "INFO")
 	observerSingleton = &observer.NullObserver{
 		ID:       "Observer 1",
@@ -113,6 +119,11 @@ type GetEntityByRecordIDResponse struct {
 	} `json:"RESOLVED_ENTITY"`
 }
 
+// A writer whose writes fail, as when an HTTP client disconnects.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errWriter }
+
 // ----------------------------------------------------------------------------
 // Interface methods
 // ----------------------------------------------------------------------------
@@ -1416,6 +1427,255 @@ func TestSzEngine_WhySearch(test *testing.T) {
 	}
 }
 
+// ----------------------------------------------------------------------------
+// Public non-interface methods
+// ----------------------------------------------------------------------------
+
+func TestSzEngine_Bytes(test *testing.T) {
+	ctx := test.Context()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	szEngine := getTestObject(ctx, test)
+
+	defer func() { deleteRecords(ctx, szEngine, records) }()
+
+	addRecords(ctx, szEngine, records)
+
+	record := truthset.CustomerRecords["1001"]
+	entityID := getEntityID(ctx, szEngine, record)
+
+	// Each Bytes variant returns the same JSON document as its string counterpart.
+
+	expected, err := szEngine.GetEntityByEntityID(ctx, entityID, senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	actual, err := szEngine.GetEntityByEntityIDBytes(ctx, entityID, senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	require.JSONEq(test, expected, string(actual))
+
+	expected, err = szEngine.GetEntityByRecordID(ctx, record.DataSource, record.ID, senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	actual, err = szEngine.GetEntityByRecordIDBytes(ctx, record.DataSource, record.ID, senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	require.JSONEq(test, expected, string(actual))
+
+	expected, err = szEngine.GetRecord(ctx, record.DataSource, record.ID, senzing.SzRecordDefaultFlags)
+	require.NoError(test, err)
+	actual, err = szEngine.GetRecordBytes(ctx, record.DataSource, record.ID, senzing.SzRecordDefaultFlags)
+	require.NoError(test, err)
+	require.JSONEq(test, expected, string(actual))
+
+	expected, err = szEngine.SearchByAttributes(ctx, defaultAttributes, defaultSearchProfile, senzing.SzNoFlags)
+	require.NoError(test, err)
+	actual, err = szEngine.SearchByAttributesBytes(ctx, defaultAttributes, defaultSearchProfile, senzing.SzNoFlags)
+	require.NoError(test, err)
+	require.JSONEq(test, expected, string(actual))
+}
+
+func TestSzEngine_ToWriter(test *testing.T) {
+	ctx := test.Context()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+	}
+	szEngine := getTestObject(ctx, test)
+
+	defer func() { deleteRecords(ctx, szEngine, records) }()
+
+	addRecords(ctx, szEngine, records)
+
+	record := truthset.CustomerRecords["1001"]
+	entityID := getEntityID(ctx, szEngine, record)
+	entityIDs := entityIDsJSON(entityID)
+	recordKeys := `{"RECORDS": [{"DATA_SOURCE": "` + record.DataSource + `", "RECORD_ID": "` + record.ID + `"}]}`
+
+	// Each ToWriter variant writes the same JSON document as its string counterpart.
+
+	var buffer strings.Builder
+
+	expected, err := szEngine.FindNetworkByEntityID(ctx, entityIDs, 1, 1, 10, senzing.SzFindNetworkDefaultFlags)
+	require.NoError(test, err)
+	written, err := szEngine.FindNetworkByEntityIDToWriter(ctx, &buffer, entityIDs, 1, 1, 10, senzing.SzFindNetworkDefaultFlags)
+	require.NoError(test, err)
+	require.Equal(test, int64(buffer.Len()), written)
+	require.JSONEq(test, expected, buffer.String())
+
+	buffer.Reset()
+	expected, err = szEngine.FindNetworkByRecordID(ctx, recordKeys, 1, 1, 10, senzing.SzFindNetworkDefaultFlags)
+	require.NoError(test, err)
+	_, err = szEngine.FindNetworkByRecordIDToWriter(ctx, &buffer, recordKeys, 1, 1, 10, senzing.SzFindNetworkDefaultFlags)
+	require.NoError(test, err)
+	require.JSONEq(test, expected, buffer.String())
+
+	buffer.Reset()
+	expected, err = szEngine.GetEntityByEntityID(ctx, entityID, senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	_, err = szEngine.GetEntityByEntityIDToWriter(ctx, &buffer, entityID, senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	require.JSONEq(test, expected, buffer.String())
+
+	buffer.Reset()
+	expected, err = szEngine.GetEntityByRecordID(ctx, record.DataSource, record.ID, senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	_, err = szEngine.GetEntityByRecordIDToWriter(ctx, &buffer, record.DataSource, record.ID, senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	require.JSONEq(test, expected, buffer.String())
+}
+
+func TestSzEngine_ToWriter_badEntityID(test *testing.T) {
+	ctx := test.Context()
+	szEngine := getTestObject(ctx, test)
+
+	var buffer strings.Builder
+
+	written, err := szEngine.GetEntityByEntityIDToWriter(ctx, &buffer, badEntityID, senzing.SzEntityDefaultFlags)
+	printDebug(test, err)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+	require.Zero(test, written)
+	require.Empty(test, buffer.String())
+}
+
+func TestSzEngine_ToWriter_writerError(test *testing.T) {
+	ctx := test.Context()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+	}
+	szEngine := getTestObject(ctx, test)
+
+	defer func() { deleteRecords(ctx, szEngine, records) }()
+
+	addRecords(ctx, szEngine, records)
+
+	record := truthset.CustomerRecords["1001"]
+	_, err := szEngine.GetEntityByRecordIDToWriter(
+		ctx,
+		failingWriter{},
+		record.DataSource,
+		record.ID,
+		senzing.SzEntityDefaultFlags,
+	)
+	printDebug(test, err)
+	require.ErrorIs(test, err, errWriter)
+}
+
+func TestSzEngine_GetRecord_irrelevantFlags(test *testing.T) {
+	ctx := test.Context()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+	}
+	szEngine := getTestObject(ctx, test)
+
+	defer func() { deleteRecords(ctx, szEngine, records) }()
+
+	addRecords(ctx, szEngine, records)
+
+	// Irrelevant flags are logged as a warning, not rejected.
+
+	record := truthset.CustomerRecords["1001"]
+	flags := szflags.Record().With(senzing.SzWithInfo, senzing.SzFindPathStrictAvoid).Build()
+	actual, err := szEngine.GetRecord(ctx, record.DataSource, record.ID, flags)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+}
+
+func TestSzEngine_GetRecordBytes_badRecordID(test *testing.T) {
+	ctx := test.Context()
+	szEngine := getTestObject(ctx, test)
+	actual, err := szEngine.GetRecordBytes(ctx, truthset.CustomerRecords["1001"].DataSource, badRecordID, senzing.SzRecordDefaultFlags)
+	printDebug(test, err, actual)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+}
+
+func TestSzEngine_PatchRecord(test *testing.T) {
+	ctx := test.Context()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+	}
+	szEngine := getTestObject(ctx, test)
+
+	defer func() { deleteRecords(ctx, szEngine, records) }()
+
+	addRecords(ctx, szEngine, records)
+
+	record := truthset.CustomerRecords["1001"]
+	patch := `{"EMAIL_ADDRESS": "bob@example.com", "DATE_OF_BIRTH": null}`
+	patched, withInfo, err := szEngine.PatchRecord(ctx, record.DataSource, record.ID, patch, senzing.SzNoFlags)
+	printDebug(test, err, patched, withInfo)
+	require.NoError(test, err)
+	require.Contains(test, patched, `"EMAIL_ADDRESS":"bob@example.com"`)
+	require.NotContains(test, patched, "DATE_OF_BIRTH")
+	require.Contains(test, withInfo, "AFFECTED_ENTITIES")
+
+	stored, err := szEngine.GetRecord(ctx, record.DataSource, record.ID, senzing.SzEntityIncludeRecordJSONData)
+	require.NoError(test, err)
+	require.Contains(test, stored, "bob@example.com")
+}
+
+func TestSzEngine_PatchRecord_concurrent(test *testing.T) {
+	ctx := test.Context()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+	}
+	szEngine := getTestObject(ctx, test)
+
+	defer func() { deleteRecords(ctx, szEngine, records) }()
+
+	addRecords(ctx, szEngine, records)
+
+	// Patches through differently cased data source codes are serialized; none is lost.
+
+	record := truthset.CustomerRecords["1001"]
+	dataSourceCodes := []string{strings.ToUpper(record.DataSource), strings.ToLower(record.DataSource)}
+
+	var waitGroup sync.WaitGroup
+
+	for index := range 10 {
+		waitGroup.Go(func() {
+			patch := fmt.Sprintf(`{"PATCH_%d": "%d"}`, index, index)
+			_, _, err := szEngine.PatchRecord(ctx, dataSourceCodes[index%2], record.ID, patch, senzing.SzNoFlags)
+			if err != nil {
+				test.Error(err)
+			}
+		})
+	}
+
+	waitGroup.Wait()
+
+	stored, err := szEngine.GetRecord(ctx, record.DataSource, record.ID, senzing.SzEntityIncludeRecordJSONData)
+	require.NoError(test, err)
+
+	for index := range 10 {
+		require.Contains(test, stored, fmt.Sprintf(`"PATCH_%d"`, index))
+	}
+}
+
+func TestSzEngine_PatchRecord_changesRecordID(test *testing.T) {
+	ctx := test.Context()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+	}
+	szEngine := getTestObject(ctx, test)
+
+	defer func() { deleteRecords(ctx, szEngine, records) }()
+
+	addRecords(ctx, szEngine, records)
+
+	record := truthset.CustomerRecords["1001"]
+	_, _, err := szEngine.PatchRecord(ctx, record.DataSource, record.ID, `{"RECORD_ID": "9999"}`, senzing.SzNoFlags)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+
+	_, _, err = szEngine.PatchRecord(ctx, record.DataSource, record.ID, `["not", "an", "object"]`, senzing.SzNoFlags)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+}
+
+func TestSzEngine_PatchRecord_badRecordID(test *testing.T) {
+	ctx := test.Context()
+	szEngine := getTestObject(ctx, test)
+	_, _, err := szEngine.PatchRecord(ctx, truthset.CustomerRecords["1001"].DataSource, badRecordID, `{}`, senzing.SzNoFlags)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+}
+
 // ----------------------------------------------------------------------------
 // Logging and observing
 // ----------------------------------------------------------------------------
@@ -1441,6 +1701,42 @@ func TestSzEngine_GetObserverOrigin(test *testing.T) {
 	printDebug(test, nil, actual)
 }
 
+func TestSzEngine_GetOpenExportHandles(test *testing.T) {
+	ctx := test.Context()
+	szEngine := getTestObject(ctx, test)
+	exportHandle, err := szEngine.ExportJSONEntityReport(ctx, senzing.SzExportDefaultFlags)
+	printDebug(test, err)
+	require.NoError(test, err)
+
+	openExportHandles := szEngine.GetOpenExportHandles(ctx)
+	require.Len(test, openExportHandles, 1)
+	require.Equal(test, exportHandle, openExportHandles[0].Handle)
+	require.Equal(test, szengine.ExportReportJSON, openExportHandles[0].Report)
+	require.Contains(test, openExportHandles[0].CreationStack, "TestSzEngine_GetOpenExportHandles")
+
+	err = szEngine.CloseExportReport(ctx, exportHandle)
+	printDebug(test, err)
+	require.NoError(test, err)
+	require.Empty(test, szEngine.GetOpenExportHandles(ctx))
+}
+
+func TestSzEngine_SetExportHandleLeakThreshold(test *testing.T) {
+	ctx := test.Context()
+	szEngine := getTestObject(ctx, test)
+	szEngine.SetExportHandleLeakThreshold(ctx, time.Millisecond)
+
+	defer szEngine.SetExportHandleLeakThreshold(ctx, 0)
+
+	exportHandle, err := szEngine.ExportCsvEntityReport(ctx, "*", senzing.SzExportDefaultFlags)
+	printDebug(test, err)
+	require.NoError(test, err)
+	time.Sleep(10 * time.Millisecond)
+
+	err = szEngine.CloseExportReport(ctx, exportHandle)
+	printDebug(test, err)
+	require.NoError(test, err)
+}
+
 func TestSzEngine_UnregisterObserver(test *testing.T) {
 	ctx := test.Context()
 	szEngine := getTestObject(ctx, test)
@@ -1509,6 +1805,40 @@ func TestSzEngine_Destroy(test *testing.T) {
 	szEngineSingleton = nil // Reset szEngineSingleton
 }
 
+func TestSzEngine_Destroy_withOpenExportHandle(test *testing.T) {
+	ctx := test.Context()
+	szEngine := getTestObject(ctx, test)
+	_, err := szEngine.ExportJSONEntityReport(ctx, senzing.SzExportDefaultFlags)
+	printDebug(test, err)
+	require.NoError(test, err)
+
+	err = szEngine.Destroy(ctx)
+	printDebug(test, err)
+	require.NoError(test, err)
+	require.Empty(test, szEngine.GetOpenExportHandles(ctx))
+
+	szEngineSingleton = nil // Reset szEngineSingleton
+}
+
+func TestSzEngine_Destroy_afterDrain(test *testing.T) {
+	ctx := test.Context()
+	szEngine := getTestObject(ctx, test)
+	err := szEngine.Drain(ctx)
+	printDebug(test, err)
+	require.NoError(test, err)
+
+	_, err = szEngine.GetStats(ctx)
+	require.Error(test, err)
+	require.False(test, szEngine.IsDestroyed(ctx))
+
+	err = szEngine.Destroy(ctx)
+	printDebug(test, err)
+	require.NoError(test, err)
+	require.True(test, szEngine.IsDestroyed(ctx))
+
+	szEngineSingleton = nil // Reset szEngineSingleton
+}
+
 func TestSzEngine_Destroy_withObserver(test *testing.T) {
 	ctx := test.Context()
 	szEngine := getTestObject(ctx, test)
@@ -1519,6 +1849,157 @@ func TestSzEngine_Destroy_withObserver(test *testing.T) {
 	szEngineSingleton = nil // Reset szEngineSingleton
 }
 
+// ----------------------------------------------------------------------------
+// Benchmarks
+// ----------------------------------------------------------------------------
+
+func BenchmarkSzEngine_GetEntityByRecordID(benchmark *testing.B) {
+	benchmarkWithRecord(benchmark, func(ctx context.Context, szEngine *szengine.Szengine, record record.Record) error {
+		_, err := szEngine.GetEntityByRecordID(ctx, record.DataSource, record.ID, senzing.SzEntityDefaultFlags)
+
+		return err
+	})
+}
+
+func BenchmarkSzEngine_GetEntityByRecordIDBytes(benchmark *testing.B) {
+	benchmarkWithRecord(benchmark, func(ctx context.Context, szEngine *szengine.Szengine, record record.Record) error {
+		_, err := szEngine.GetEntityByRecordIDBytes(ctx, record.DataSource, record.ID, senzing.SzEntityDefaultFlags)
+
+		return err
+	})
+}
+
+func BenchmarkSzEngine_GetRecord(benchmark *testing.B) {
+	benchmarkWithRecord(benchmark, func(ctx context.Context, szEngine *szengine.Szengine, record record.Record) error {
+		_, err := szEngine.GetRecord(ctx, record.DataSource, record.ID, senzing.SzRecordDefaultFlags)
+
+		return err
+	})
+}
+
+func BenchmarkSzEngine_GetRecordBytes(benchmark *testing.B) {
+	benchmarkWithRecord(benchmark, func(ctx context.Context, szEngine *szengine.Szengine, record record.Record) error {
+		_, err := szEngine.GetRecordBytes(ctx, record.DataSource, record.ID, senzing.SzRecordDefaultFlags)
+
+		return err
+	})
+}
+
+func BenchmarkSzEngine_GetRecord_notFound(benchmark *testing.B) {
+	benchmarkWithRecord(benchmark, func(ctx context.Context, szEngine *szengine.Szengine, record record.Record) error {
+		_, err := szEngine.GetRecord(ctx, record.DataSource, badRecordID, senzing.SzRecordDefaultFlags)
+		if errors.Is(err, szerror.ErrSzNotFound) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+func BenchmarkSzEngine_SearchByAttributes(benchmark *testing.B) {
+	benchmarkWithRecord(benchmark, func(ctx context.Context, szEngine *szengine.Szengine, _ record.Record) error {
+		_, err := szEngine.SearchByAttributes(ctx, defaultAttributes, defaultSearchProfile, senzing.SzNoFlags)
+
+		return err
+	})
+}
+
+func BenchmarkSzEngine_SearchByAttributesBytes(benchmark *testing.B) {
+	benchmarkWithRecord(benchmark, func(ctx context.Context, szEngine *szengine.Szengine, _ record.Record) error {
+		_, err := szEngine.SearchByAttributesBytes(ctx, defaultAttributes, defaultSearchProfile, senzing.SzNoFlags)
+
+		return err
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Fuzz tests
+// ----------------------------------------------------------------------------
+
+// Only rejected parameters are fuzzed; accepted ones would be sent to the Senzing C binary.
+
+func FuzzSzEngine_AddRecord(fuzz *testing.F) {
+	for _, seed := range []string{"1001", "1001\x00", "10\xff01", "Zoë"} {
+		fuzz.Add(seed, `{"NAME_FULL": "Bob Smith"}`, senzing.SzWithoutInfo)
+		fuzz.Add(seed, "{\"NAME_FULL\": \"Bob\x00\"}", senzing.SzWithInfo)
+	}
+
+	szEngine := &szengine.Szengine{}
+
+	fuzz.Fuzz(func(test *testing.T, recordID string, recordDefinition string, flags int64) {
+		ctx := test.Context()
+		skipAcceptedInputs(
+			test,
+			validation.Identifier("recordID", recordID),
+			validation.JSON("recordDefinition", recordDefinition),
+		)
+
+		_, err := szEngine.AddRecord(ctx, "CUSTOMERS", recordID, recordDefinition, flags)
+		require.ErrorIs(test, err, szerror.ErrSzBadInput)
+
+		expectedMessageID := "SZSDK60044001"
+		if (flags & senzing.SzWithInfo) != senzing.SzNoFlags {
+			expectedMessageID = "SZSDK60044002"
+		}
+
+		requireMessageID(test, expectedMessageID, err)
+	})
+}
+
+func FuzzSzEngine_FindPathByEntityID(fuzz *testing.F) {
+	for _, seed := range []string{"", `{"ENTITIES": [{"ENTITY_ID": 1}]}`, "{\"ENTITIES\": \x00}", "\xff"} {
+		fuzz.Add(seed, "")
+		fuzz.Add("", seed)
+	}
+
+	szEngine := &szengine.Szengine{}
+
+	fuzz.Fuzz(func(test *testing.T, avoidEntityIDs string, requiredDataSources string) {
+		ctx := test.Context()
+		skipAcceptedInputs(
+			test,
+			validation.JSON("avoidEntityIDs", avoidEntityIDs),
+			validation.JSON("requiredDataSources", requiredDataSources),
+		)
+
+		_, err := szEngine.FindPathByEntityID(
+			ctx, 1, 2, defaultMaxDegrees, avoidEntityIDs, requiredDataSources, senzing.SzNoFlags)
+		require.ErrorIs(test, err, szerror.ErrSzBadInput)
+
+		expectedMessageID := "SZSDK60044017"
+
+		switch {
+		case len(requiredDataSources) > 0:
+			expectedMessageID = "SZSDK60044025"
+		case len(avoidEntityIDs) > 0:
+			expectedMessageID = "SZSDK60044021"
+		}
+
+		requireMessageID(test, expectedMessageID, err)
+	})
+}
+
+func FuzzSzEngine_SearchByAttributes(fuzz *testing.F) {
+	for _, seed := range []string{defaultAttributes, "{\"NAME_FULL\": \"Bob\x00\"}", "[\"\xff\"]"} {
+		fuzz.Add(seed, defaultSearchProfile)
+	}
+
+	szEngine := &szengine.Szengine{}
+
+	fuzz.Fuzz(func(test *testing.T, attributes string, searchProfile string) {
+		ctx := test.Context()
+		skipAcceptedInputs(
+			test,
+			validation.JSON("attributes", attributes),
+			validation.Identifier("searchProfile", searchProfile),
+		)
+
+		_, err := szEngine.SearchByAttributes(ctx, attributes, searchProfile, senzing.SzNoFlags)
+		require.ErrorIs(test, err, szerror.ErrSzBadInput)
+		requireMessageID(test, "SZSDK60044053", err)
+	})
+}
+
 // ----------------------------------------------------------------------------
 // Internal functions
 // ----------------------------------------------------------------------------
@@ -1549,6 +2030,33 @@ func createSzAbstractFactory(ctx context.Context) senzing.SzAbstractFactory {
 	return result
 }
 
+// Report allocations of call, made against an SzEngine holding one record.
+func benchmarkWithRecord(
+	benchmark *testing.B,
+	call func(ctx context.Context, szEngine *szengine.Szengine, record record.Record) error,
+) {
+	benchmark.Helper()
+
+	ctx := benchmark.Context()
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+	}
+	szEngine := getSzEngine(ctx)
+
+	defer func() { deleteRecords(ctx, szEngine, records) }()
+
+	addRecords(ctx, szEngine, records)
+	benchmark.ReportAllocs()
+	benchmark.ResetTimer()
+
+	for benchmark.Loop() {
+		err := call(ctx, szEngine, records[0])
+		if err != nil {
+			benchmark.Fatal(err)
+		}
+	}
+}
+
 func deleteRecords(ctx context.Context, szEngine senzing.SzEngine, records []record.Record) {
 	flags := senzing.SzWithoutInfo
 
@@ -1772,6 +2280,22 @@ func printDebug(t *testing.T, err error, items ...any) {
 	}
 }
 
+func requireMessageID(t *testing.T, expectedMessageID string, err error) {
+	t.Helper()
+
+	sdkError, isSdkError := sdkerror.From(err)
+	require.True(t, isSdkError)
+	require.Equal(t, expectedMessageID, sdkError.MessageID)
+}
+
+func skipAcceptedInputs(t *testing.T, inputs ...validation.Input) {
+	t.Helper()
+
+	if validation.Check(inputs...) == nil {
+		t.Skip("accepted parameters would be sent to the Senzing C binary")
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Test harness
 // ----------------------------------------------------------------------------
@@ -2121,7 +2645,7 @@ func getTestCasesForAddRecord() []TestMetadataForAddRecord {
 			name:               "badRecordDefinition",
 			recordDefinition:   badRecordDefinition,
 			expectedErr:        szerror.ErrSzBadInput,
-			expectedErrMessage: `{"function":"szengine.(*Szengine).AddRecord","error":{"id":"SZSDK60044001","reason":"SENZ3121|JSON Parsing Failure [code=3,offset=0]"}}`,
+			expectedErrMessage: `{"function":"szengine.(*Szengine).AddRecord","error":{"id":"SZSDK60044001","reason":"recordDefinition is not valid JSON"}}`,
 		},
 		{
 			name:               "badRecordID",
@@ -2132,6 +2656,12 @@ func getTestCasesForAddRecord() []TestMetadataForAddRecord {
 		{
 			name: "default",
 		},
+		{
+			name:               "invalidUTF8DataSourceCode",
+			dataSourceCode:     "CUSTOMERS\xff",
+			expectedErr:        szerror.ErrSzBadInput,
+			expectedErrMessage: `{"function":"szengine.(*Szengine).AddRecord","error":{"id":"SZSDK60044001","reason":"dataSourceCode is not valid UTF-8"}}`,
+		},
 		{
 			name:           "nilDataSourceCode",
 			dataSourceCode: nilDataSourceCode,
@@ -2146,6 +2676,12 @@ func getTestCasesForAddRecord() []TestMetadataForAddRecord {
 			name:     "nilRecordID",
 			recordID: nilRecordID,
 		},
+		{
+			name:               "nulByteInRecordID",
+			recordID:           "1001\x00",
+			expectedErr:        szerror.ErrSzBadInput,
+			expectedErrMessage: `{"function":"szengine.(*Szengine).AddRecord","error":{"id":"SZSDK60044001","reason":"recordID contains a NUL byte at offset 4"}}`,
+		},
 		{
 			name:  "withInfo",
 			flags: senzing.SzWithInfo,
@@ -2162,7 +2698,7 @@ func getTestCasesForAddRecord() []TestMetadataForAddRecord {
 			flags:              senzing.SzWithInfo,
 			recordDefinition:   badRecordDefinition,
 			expectedErr:        szerror.ErrSzBadInput,
-			expectedErrMessage: `{"function":"szengine.(*Szengine).AddRecord","error":{"id":"SZSDK60044002","reason":"SENZ3121|JSON Parsing Failure [code=3,offset=0]"}}`,
+			expectedErrMessage: `{"function":"szengine.(*Szengine).AddRecord","error":{"id":"SZSDK60044002","reason":"recordDefinition is not valid JSON"}}`,
 		},
 		{
 			name:               "withInfo_badRecordID",
@@ -2472,7 +3008,7 @@ func getTestCasesForFindPathByEntityID() []TestMetadataForFindPathByEntityID {
 			name:               "badAvoidEntityIDs",
 			avoidEntityIDs:     badAvoidEntityIDsFunc,
 			expectedErr:        szerror.ErrSzBadInput,
-			expectedErrMessage: `{"function":"szengine.(*Szengine).FindPathByEntityID","error":{"id":"SZSDK60044021","reason":"SENZ3121|JSON Parsing Failure [code=3,offset=0]"}}`,
+			expectedErrMessage: `{"function":"szengine.(*Szengine).FindPathByEntityID","error":{"id":"SZSDK60044021","reason":"avoidEntityIDs is not valid JSON"}}`,
 		},
 		{
 			name:               "badEndEntityID",
@@ -2489,7 +3025,7 @@ func getTestCasesForFindPathByEntityID() []TestMetadataForFindPathByEntityID {
 		{
 			name:                "badRequiredDataSource",
 			expectedErr:         szerror.ErrSzBadInput,
-			expectedErrMessage:  `{"function":"szengine.(*Szengine).FindPathByEntityID","error":{"id":"SZSDK60044025","reason":"SENZ3121|JSON Parsing Failure [code=3,offset=0]"}}`,
+			expectedErrMessage:  `{"function":"szengine.(*Szengine).FindPathByEntityID","error":{"id":"SZSDK60044025","reason":"requiredDataSources is not valid JSON"}}`,
 			requiredDataSources: badRequiredDataSourcesFunc,
 		},
 		{
@@ -2555,7 +3091,7 @@ func getTestCasesForFindPathByRecordID() []TestMetadataForFindPathByRecordID {
 			name:               "badAvoidRecordKeys",
 			avoidRecordKeys:    badAvoidRecordIDsFunc,
 			expectedErr:        szerror.ErrSzBadInput,
-			expectedErrMessage: `{"function":"szengine.(*Szengine).FindPathByRecordID","error":{"id":"SZSDK60044023","reason":"SENZ3121|JSON Parsing Failure [code=3,offset=0]"}}`,
+			expectedErrMessage: `{"function":"szengine.(*Szengine).FindPathByRecordID","error":{"id":"SZSDK60044023","reason":"avoidRecordKeys is not valid JSON"}}`,
 		},
 		{
 			name:               "badDataRecordID",
@@ -2579,7 +3115,7 @@ func getTestCasesForFindPathByRecordID() []TestMetadataForFindPathByRecordID {
 			name:               "badRequiredDataSources",
 			avoidRecordKeys:    badRequiredDataSourcesFunc,
 			expectedErr:        szerror.ErrSzBadInput,
-			expectedErrMessage: `{"function":"szengine.(*Szengine).FindPathByRecordID","error":{"id":"SZSDK60044023","reason":"SENZ3121|JSON Parsing Failure [code=3,offset=0]"}}`,
+			expectedErrMessage: `{"function":"szengine.(*Szengine).FindPathByRecordID","error":{"id":"SZSDK60044023","reason":"avoidRecordKeys is not valid JSON"}}`,
 		},
 		{
 			name: "default",
@@ -2756,7 +3292,7 @@ func getTestCasesForGetRecordPreview() []TestMetadataForGetRecordPreview {
 			{
 				name:               "badRecordDefinition",
 				expectedErr:        szerror.ErrSzBadInput,
-				expectedErrMessage: `{"function":"szengine.(*Szengine).GetRecordPreview","error":{"id":"SZSDK60044061","reason":"SENZ0002|Invalid Message"}}`,
+				expectedErrMessage: `{"function":"szengine.(*Szengine).GetRecordPreview","error":{"id":"SZSDK60044061","reason":"recordDefinition is not valid JSON"}}`,
 				recordDefinition:   badRecordDefinition,
 			},
 		}
@@ -2766,7 +3302,7 @@ func getTestCasesForGetRecordPreview() []TestMetadataForGetRecordPreview {
 			{
 				name:               "badRecordDefinition",
 				expectedErr:        szerror.ErrSzBadInput,
-				expectedErrMessage: `{"function":"szengine.(*Szengine).GetRecordPreview","error":{"id":"SZSDK60044061","reason":"SENZ3121|JSON Parsing Failure [code=3,offset=0]"}}`,
+				expectedErrMessage: `{"function":"szengine.(*Szengine).GetRecordPreview","error":{"id":"SZSDK60044061","reason":"recordDefinition is not valid JSON"}}`,
 				recordDefinition:   badRecordDefinition,
 			},
 		}
@@ -2916,8 +3452,8 @@ func getTestCasesForSearchByAttributes() []TestMetadataForSearchByAttributes {
 		{
 			name:               "badAttributes",
 			attributes:         badAttributes,
-			expectedErr:        szerror.ErrSz,
-			expectedErrMessage: `{"function":"szengine.(*Szengine).SearchByAttributes","error":{"id":"SZSDK60044053","reason":"SENZ0027|Invalid value for search-attributes"}}`,
+			expectedErr:        szerror.ErrSzBadInput,
+			expectedErrMessage: `{"function":"szengine.(*Szengine).SearchByAttributes","error":{"id":"SZSDK60044053","reason":"attributes is not valid JSON"}}`,
 		},
 		{
 			name:               "badSearchProfile",
@@ -2931,8 +3467,8 @@ func getTestCasesForSearchByAttributes() []TestMetadataForSearchByAttributes {
 		{
 			name:               "nilAttributes",
 			attributes:         nilAttributes,
-			expectedErr:        szerror.ErrSz,
-			expectedErrMessage: `{"function":"szengine.(*Szengine).SearchByAttributes","error":{"id":"SZSDK60044053","reason":"SENZ0027|Invalid value for search-attributes"}}`,
+			expectedErr:        szerror.ErrSzBadInput,
+			expectedErrMessage: `{"function":"szengine.(*Szengine).SearchByAttributes","error":{"id":"SZSDK60044053","reason":"attributes is not valid JSON"}}`,
 		},
 		{
 			name:          "nilSearchProfile",
@@ -3074,8 +3610,8 @@ func getTestCasesForWhySearch() []TestMetadataForWhySearch {
 		{
 			name:               "badAttributes",
 			attributes:         badAttributes,
-			expectedErr:        szerror.ErrSz,
-			expectedErrMessage: `{"function":"szengine.(*Szengine).WhySearch","error":{"id":"SZSDK60044064","reason":"SENZ0027|Invalid value for search-attributes"}}`,
+			expectedErr:        szerror.ErrSzBadInput,
+			expectedErrMessage: `{"function":"szengine.(*Szengine).WhySearch","error":{"id":"SZSDK60044064","reason":"attributes is not valid JSON"}}`,
 		},
 		{
 			name:               "badEntityID",
@@ -3095,8 +3631,8 @@ func getTestCasesForWhySearch() []TestMetadataForWhySearch {
 		{
 			name:               "nilAttributes",
 			attributes:         nilAttributes,
-			expectedErr:        szerror.ErrSz,
-			expectedErrMessage: `{"function":"szengine.(*Szengine).WhySearch","error":{"id":"SZSDK60044064","reason":"SENZ0027|Invalid value for search-attributes"}}`,
+			expectedErr:        szerror.ErrSzBadInput,
+			expectedErrMessage: `{"function":"szengine.(*Szengine).WhySearch","error":{"id":"SZSDK60044064","reason":"attributes is not valid JSON"}}`,
 		},
 		{
 			name:               "nilEntityID",
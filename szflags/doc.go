@@ -0,0 +1,18 @@
+/*
+Package szflags builds, decodes and checks the "SzXxx" flags passed to [senzing.SzEngine] methods.
+
+Flags are int64 bit masks.
+A [Builder] starts from the recommended preset of a method [Family] and adds or removes flags:
+
+	flags := szflags.Entity().With(senzing.SzEntityIncludeRecordJSONData).Without(senzing.SzEntityIncludeAllRelations).Build()
+
+[Decode] turns a mask back into the names of its flags, and [Mask] prints those names
+wherever a mask is formatted with %s or %v, for example in trace logs and observer messages.
+Some bits have different names in different families; [DecodeFor] and [MaskFor] use the names of one family.
+[Irrelevant] reports flags that have no effect on the methods of a family;
+the [szengine] package logs a warning when such flags are passed.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+[szengine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szengine
+*/
+package szflags
@@ -0,0 +1,177 @@
+package szflags
+
+import (
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Family identifies a group of [senzing.SzEngine] methods that accept the same flags.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+type Family int
+
+type flagName struct {
+	name  string
+	value int64
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+The Family* constants identify method families.
+
+  - FamilyEntity: GetEntityByEntityID, GetEntityByRecordID, GetVirtualEntityByRecordID, FindInterestingEntitiesBy*.
+  - FamilyExport: ExportCsvEntityReport, ExportJSONEntityReport.
+  - FamilyFindNetwork: FindNetworkByEntityID, FindNetworkByRecordID.
+  - FamilyFindPath: FindPathByEntityID, FindPathByRecordID.
+  - FamilyHow: HowEntityByEntityID.
+  - FamilyRecord: GetRecord, GetRecordPreview.
+  - FamilySearch: SearchByAttributes, WhySearch.
+  - FamilyWhy: WhyEntities, WhyRecordInEntity, WhyRecords.
+  - FamilyWithInfo: AddRecord, DeleteRecord, ProcessRedoRecord, ReevaluateEntity, ReevaluateRecord.
+*/
+const (
+	FamilyEntity Family = iota
+	FamilyExport
+	FamilyFindNetwork
+	FamilyFindPath
+	FamilyHow
+	FamilyRecord
+	FamilySearch
+	FamilyWhy
+	FamilyWithInfo
+)
+
+const (
+	noFlagsName   = "SzNoFlags"
+	nameSeparator = " | "
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Names of the single-bit flags. Bits without an entry are reserved and named "BitNN", numbered from 1.
+var flagNames = []flagName{
+	{"SzExportIncludeMultiRecordEntities", senzing.SzExportIncludeMultiRecordEntities},
+	{"SzExportIncludePossiblySame", senzing.SzExportIncludePossiblySame},
+	{"SzExportIncludePossiblyRelated", senzing.SzExportIncludePossiblyRelated},
+	{"SzExportIncludeNameOnly", senzing.SzExportIncludeNameOnly},
+	{"SzExportIncludeDisclosed", senzing.SzExportIncludeDisclosed},
+	{"SzExportIncludeSingleRecordEntities", senzing.SzExportIncludeSingleRecordEntities},
+	{"SzEntityIncludePossiblySameRelations", senzing.SzEntityIncludePossiblySameRelations},
+	{"SzEntityIncludePossiblyRelatedRelations", senzing.SzEntityIncludePossiblyRelatedRelations},
+	{"SzEntityIncludeNameOnlyRelations", senzing.SzEntityIncludeNameOnlyRelations},
+	{"SzEntityIncludeDisclosedRelations", senzing.SzEntityIncludeDisclosedRelations},
+	{"SzEntityIncludeAllFeatures", senzing.SzEntityIncludeAllFeatures},
+	{"SzEntityIncludeRepresentativeFeatures", senzing.SzEntityIncludeRepresentativeFeatures},
+	{"SzEntityIncludeEntityName", senzing.SzEntityIncludeEntityName},
+	{"SzEntityIncludeRecordSummary", senzing.SzEntityIncludeRecordSummary},
+	{"SzEntityIncludeRecordData", senzing.SzEntityIncludeRecordData},
+	{"SzEntityIncludeRecordMatchingInfo", senzing.SzEntityIncludeRecordMatchingInfo},
+	{"SzEntityIncludeRecordJSONData", senzing.SzEntityIncludeRecordJSONData},
+	{"SzEntityIncludeRecordFeatures", senzing.SzEntityIncludeRecordFeatures},
+	{"SzEntityIncludeRelatedEntityName", senzing.SzEntityIncludeRelatedEntityName},
+	{"SzEntityIncludeRelatedMatchingInfo", senzing.SzEntityIncludeRelatedMatchingInfo},
+	{"SzEntityIncludeRelatedRecordSummary", senzing.SzEntityIncludeRelatedRecordSummary},
+	{"SzEntityIncludeRelatedRecordData", senzing.SzEntityIncludeRelatedRecordData},
+	{"SzEntityIncludeInternalFeatures", senzing.SzEntityIncludeInternalFeatures},
+	{"SzEntityIncludeFeatureStats", senzing.SzEntityIncludeFeatureStats},
+	{"SzFindPathStrictAvoid", senzing.SzFindPathStrictAvoid},
+	{"SzIncludeFeatureScores", senzing.SzIncludeFeatureScores},
+	{"SzSearchIncludeStats", senzing.SzSearchIncludeStats},
+	{"SzEntityIncludeRecordTypes", senzing.SzEntityIncludeRecordTypes},
+	{"SzEntityIncludeRelatedRecordTypes", senzing.SzEntityIncludeRelatedRecordTypes},
+	{"SzFindPathIncludeMatchingInfo", senzing.SzFindPathIncludeMatchingInfo},
+	{"SzEntityIncludeRecordUnmappedData", senzing.SzEntityIncludeRecordUnmappedData},
+	{"SzSearchIncludeAllCandidates", senzing.SzSearchIncludeAllCandidates},
+	{"SzFindNetworkIncludeMatchingInfo", senzing.SzFindNetworkIncludeMatchingInfo},
+	{"SzIncludeMatchKeyDetails", senzing.SzIncludeMatchKeyDetails},
+	{"SzEntityIncludeRecordFeatureDetails", senzing.SzEntityIncludeRecordFeatureDetails},
+	{"SzEntityIncludeRecordFeatureStats", senzing.SzEntityIncludeRecordFeatureStats},
+	{"SzSearchIncludeRequest", senzing.SzSearchIncludeRequest},
+	{"SzSearchIncludeRequestDetails", senzing.SzSearchIncludeRequestDetails},
+	{"SzEntityIncludeRecordDates", senzing.SzEntityIncludeRecordDates},
+	{"SzIncludeFeatureHashes", senzing.SzIncludeFeatureHashes},
+	{"SzWithInfo", senzing.SzWithInfo},
+}
+
+// The search methods name the bits shared with export after what they select.
+var searchFlagNames = []flagName{
+	{"SzSearchIncludeResolved", senzing.SzSearchIncludeResolved},
+	{"SzSearchIncludePossiblySame", senzing.SzSearchIncludePossiblySame},
+	{"SzSearchIncludePossiblyRelated", senzing.SzSearchIncludePossiblyRelated},
+	{"SzSearchIncludeNameOnly", senzing.SzSearchIncludeNameOnly},
+}
+
+// Flags that shape the entities returned by any method that returns entities.
+var entityFlags = senzing.Flags(
+	senzing.SzEntityIncludeAllFeatures,
+	senzing.SzEntityIncludeAllRelations,
+	senzing.SzEntityIncludeEntityName,
+	senzing.SzEntityIncludeFeatureStats,
+	senzing.SzEntityIncludeInternalFeatures,
+	senzing.SzEntityIncludeRecordData,
+	senzing.SzEntityIncludeRecordDates,
+	senzing.SzEntityIncludeRecordFeatureDetails,
+	senzing.SzEntityIncludeRecordFeatureStats,
+	senzing.SzEntityIncludeRecordFeatures,
+	senzing.SzEntityIncludeRecordJSONData,
+	senzing.SzEntityIncludeRecordMatchingInfo,
+	senzing.SzEntityIncludeRecordSummary,
+	senzing.SzEntityIncludeRecordTypes,
+	senzing.SzEntityIncludeRecordUnmappedData,
+	senzing.SzEntityIncludeRelatedEntityName,
+	senzing.SzEntityIncludeRelatedMatchingInfo,
+	senzing.SzEntityIncludeRelatedRecordData,
+	senzing.SzEntityIncludeRelatedRecordSummary,
+	senzing.SzEntityIncludeRelatedRecordTypes,
+	senzing.SzEntityIncludeRepresentativeFeatures,
+	senzing.SzIncludeFeatureHashes,
+	senzing.SzIncludeMatchKeyDetails,
+)
+
+// Flags that have an effect on the methods of each family.
+var relevantFlags = map[Family]int64{
+	FamilyEntity:      entityFlags,
+	FamilyExport:      entityFlags | senzing.SzExportIncludeAllEntities | senzing.SzExportIncludeAllHavingRelationships,
+	FamilyFindNetwork: entityFlags | senzing.SzFindNetworkIncludeMatchingInfo,
+	FamilyFindPath: entityFlags |
+		senzing.SzFindPathIncludeMatchingInfo |
+		senzing.SzFindPathStrictAvoid,
+	FamilyHow: senzing.Flags(
+		senzing.SzEntityIncludeInternalFeatures,
+		senzing.SzIncludeFeatureHashes,
+		senzing.SzIncludeFeatureScores,
+		senzing.SzIncludeMatchKeyDetails,
+	),
+	FamilyRecord: entityFlags,
+	FamilySearch: entityFlags |
+		senzing.SzIncludeFeatureScores |
+		senzing.SzSearchIncludeAllCandidates |
+		senzing.SzSearchIncludeAllEntities |
+		senzing.SzSearchIncludeRequest |
+		senzing.SzSearchIncludeRequestDetails |
+		senzing.SzSearchIncludeStats,
+	FamilyWhy:      entityFlags | senzing.SzIncludeFeatureScores,
+	FamilyWithInfo: senzing.SzWithInfo,
+}
+
+var familyNames = map[Family]string{
+	FamilyEntity:      "entity",
+	FamilyExport:      "export",
+	FamilyFindNetwork: "find network",
+	FamilyFindPath:    "find path",
+	FamilyHow:         "how",
+	FamilyRecord:      "record",
+	FamilySearch:      "search",
+	FamilyWhy:         "why",
+	FamilyWithInfo:    "with info",
+}
@@ -0,0 +1,449 @@
+package szflags
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+/*
+Type Builder struct combines flags, starting from the recommended preset of a method family.
+A Builder is a value; With and Without return a new Builder.
+*/
+type Builder struct {
+	family Family
+	value  int64
+}
+
+/*
+Type Mask is an int64 of "SzXxx" flags that formats as the names of its flags.
+
+The %s and %v verbs, String() and MarshalJSON() produce names such as
+"SzEntityIncludeEntityName | SzEntityIncludeRecordSummary".
+The %d, %x, %X, %o and %b verbs format the number.
+Bits shared by several families are named as by [Decode]; use [FamilyMask] for the names of one family.
+*/
+type Mask int64
+
+/*
+Type FamilyMask struct is a [Mask] of the flags of a method family.
+It formats like a Mask, naming the flags as [DecodeFor] does.
+
+Create with [MaskFor].
+*/
+type FamilyMask struct {
+	Family Family
+	Flags  int64
+}
+
+/*
+Type IrrelevantFlagsError struct reports flags that have no effect on the methods of a family.
+It matches [szerror.ErrSzBadInput] with errors.Is().
+
+[szerror.ErrSzBadInput]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+*/
+type IrrelevantFlagsError struct {
+	Family Family
+	Flags  int64
+}
+
+// ----------------------------------------------------------------------------
+// Builder presets
+// ----------------------------------------------------------------------------
+
+/*
+The Entity function returns a Builder starting from [senzing.SzEntityDefaultFlags].
+
+[senzing.SzEntityDefaultFlags]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEntityDefaultFlags
+*/
+func Entity() Builder {
+	return NewBuilder(FamilyEntity, senzing.SzEntityDefaultFlags)
+}
+
+/*
+The EntityBrief function returns a Builder starting from [senzing.SzEntityBriefDefaultFlags].
+
+[senzing.SzEntityBriefDefaultFlags]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEntityBriefDefaultFlags
+*/
+func EntityBrief() Builder {
+	return NewBuilder(FamilyEntity, senzing.SzEntityBriefDefaultFlags)
+}
+
+/*
+The Export function returns a Builder starting from [senzing.SzExportDefaultFlags].
+
+[senzing.SzExportDefaultFlags]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzExportDefaultFlags
+*/
+func Export() Builder {
+	return NewBuilder(FamilyExport, senzing.SzExportDefaultFlags)
+}
+
+/*
+The FindNetwork function returns a Builder starting from [senzing.SzFindNetworkDefaultFlags].
+
+[senzing.SzFindNetworkDefaultFlags]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzFindNetworkDefaultFlags
+*/
+func FindNetwork() Builder {
+	return NewBuilder(FamilyFindNetwork, senzing.SzFindNetworkDefaultFlags)
+}
+
+/*
+The FindPath function returns a Builder starting from [senzing.SzFindPathDefaultFlags].
+
+[senzing.SzFindPathDefaultFlags]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzFindPathDefaultFlags
+*/
+func FindPath() Builder {
+	return NewBuilder(FamilyFindPath, senzing.SzFindPathDefaultFlags)
+}
+
+/*
+The How function returns a Builder starting from [senzing.SzHowEntityDefaultFlags].
+
+[senzing.SzHowEntityDefaultFlags]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzHowEntityDefaultFlags
+*/
+func How() Builder {
+	return NewBuilder(FamilyHow, senzing.SzHowEntityDefaultFlags)
+}
+
+/*
+The Record function returns a Builder starting from [senzing.SzRecordDefaultFlags].
+
+[senzing.SzRecordDefaultFlags]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzRecordDefaultFlags
+*/
+func Record() Builder {
+	return NewBuilder(FamilyRecord, senzing.SzRecordDefaultFlags)
+}
+
+/*
+The Search function returns a Builder starting from [senzing.SzSearchByAttributesDefaultFlags].
+
+[senzing.SzSearchByAttributesDefaultFlags]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzSearchByAttributesDefaultFlags
+*/
+func Search() Builder {
+	return NewBuilder(FamilySearch, senzing.SzSearchByAttributesDefaultFlags)
+}
+
+/*
+The SearchStrong function returns a Builder starting from [senzing.SzSearchByAttributesStrong].
+
+[senzing.SzSearchByAttributesStrong]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzSearchByAttributesStrong
+*/
+func SearchStrong() Builder {
+	return NewBuilder(FamilySearch, senzing.SzSearchByAttributesStrong)
+}
+
+/*
+The Why function returns a Builder starting from [senzing.SzWhyEntitiesDefaultFlags].
+
+[senzing.SzWhyEntitiesDefaultFlags]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzWhyEntitiesDefaultFlags
+*/
+func Why() Builder {
+	return NewBuilder(FamilyWhy, senzing.SzWhyEntitiesDefaultFlags)
+}
+
+/*
+The WithInfo function returns a Builder for methods that optionally return "with info" documents,
+starting from [senzing.SzWithInfo].
+
+[senzing.SzWithInfo]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzWithInfo
+*/
+func WithInfo() Builder {
+	return NewBuilder(FamilyWithInfo, senzing.SzWithInfo)
+}
+
+/*
+The NewBuilder function returns a Builder for a method family starting from any flags.
+
+Input
+  - family: The methods the flags are meant for.
+  - flags: The starting flags.
+*/
+func NewBuilder(family Family, flags int64) Builder {
+	return Builder{
+		family: family,
+		value:  flags,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Builder methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Build returns the combined flags.
+*/
+func (builder Builder) Build() int64 {
+	return builder.value
+}
+
+/*
+Method Family returns the method family of the Builder.
+*/
+func (builder Builder) Family() Family {
+	return builder.family
+}
+
+/*
+Method String returns the names of the combined flags.
+*/
+func (builder Builder) String() string {
+	return Mask(builder.value).String()
+}
+
+/*
+Method Validate returns an [IrrelevantFlagsError] if flags were added that have no effect on the family's methods.
+*/
+func (builder Builder) Validate() error {
+	return Check(builder.family, builder.value)
+}
+
+/*
+Method With returns a Builder with the flags added.
+*/
+func (builder Builder) With(flags ...int64) Builder {
+	builder.value |= senzing.Flags(flags...)
+
+	return builder
+}
+
+/*
+Method Without returns a Builder with the flags removed.
+*/
+func (builder Builder) Without(flags ...int64) Builder {
+	builder.value &^= senzing.Flags(flags...)
+
+	return builder
+}
+
+// ----------------------------------------------------------------------------
+// Decoding
+// ----------------------------------------------------------------------------
+
+/*
+The Decode function returns the names of the single-bit flags set in a mask, lowest bit first.
+Reserved bits are named "BitNN", numbered from 1 as in the [senzing] package.
+
+Input
+  - flags: The mask.
+
+Output
+  - The names. Empty for [senzing.SzNoFlags].
+
+[senzing]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing
+[senzing.SzNoFlags]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzNoFlags
+*/
+func Decode(flags int64) []string {
+	return decode(flags, nil)
+}
+
+/*
+The DecodeFor function is like Decode, but uses the names a method family gives to shared bits.
+For example, bit 0 is "SzSearchIncludeResolved" for FamilySearch and
+"SzExportIncludeMultiRecordEntities" otherwise.
+
+Input
+  - family: The methods the flags are meant for.
+  - flags: The mask.
+
+Output
+  - The names.
+*/
+func DecodeFor(family Family, flags int64) []string {
+	if family == FamilySearch {
+		return decode(flags, searchFlagNames)
+	}
+
+	return decode(flags, nil)
+}
+
+/*
+The MaskFor function returns a [FamilyMask] that names flags as a method family does.
+
+Input
+  - family: The methods the flags are meant for.
+  - flags: The mask.
+*/
+func MaskFor(family Family, flags int64) FamilyMask {
+	return FamilyMask{
+		Family: family,
+		Flags:  flags,
+	}
+}
+
+/*
+Method Format writes the flag names for the %s and %v verbs and the number for the other verbs.
+*/
+func (mask FamilyMask) Format(state fmt.State, verb rune) {
+	format(state, verb, mask.Flags, mask.String())
+}
+
+/*
+Method MarshalJSON encodes the flag names as a JSON string.
+*/
+func (mask FamilyMask) MarshalJSON() ([]byte, error) {
+	return json.Marshal(mask.String()) //nolint
+}
+
+/*
+Method String returns the flag names joined by " | ", or "SzNoFlags".
+*/
+func (mask FamilyMask) String() string {
+	return joinNames(DecodeFor(mask.Family, mask.Flags))
+}
+
+/*
+Method Format writes the flag names for the %s and %v verbs and the number for the other verbs.
+*/
+func (mask Mask) Format(state fmt.State, verb rune) {
+	format(state, verb, int64(mask), mask.String())
+}
+
+/*
+Method MarshalJSON encodes the flag names as a JSON string.
+*/
+func (mask Mask) MarshalJSON() ([]byte, error) {
+	return json.Marshal(mask.String()) //nolint
+}
+
+/*
+Method String returns the flag names joined by " | ", or "SzNoFlags".
+*/
+func (mask Mask) String() string {
+	return joinNames(Decode(int64(mask)))
+}
+
+// ----------------------------------------------------------------------------
+// Validation
+// ----------------------------------------------------------------------------
+
+/*
+The Check function returns an [IrrelevantFlagsError] if a mask has flags that have no effect on a family's methods.
+
+Input
+  - family: The methods the flags are meant for.
+  - flags: The mask.
+
+Output
+  - nil if every flag is relevant.
+*/
+func Check(family Family, flags int64) error {
+	irrelevant := Irrelevant(family, flags)
+	if irrelevant == senzing.SzNoFlags {
+		return nil
+	}
+
+	return &IrrelevantFlagsError{
+		Family: family,
+		Flags:  irrelevant,
+	}
+}
+
+/*
+The Irrelevant function returns the flags in a mask that have no effect on a family's methods.
+
+Input
+  - family: The methods the flags are meant for.
+  - flags: The mask.
+
+Output
+  - The irrelevant flags; [senzing.SzNoFlags] if there are none.
+
+[senzing.SzNoFlags]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzNoFlags
+*/
+func Irrelevant(family Family, flags int64) int64 {
+	relevant, ok := relevantFlags[family]
+	if !ok {
+		return senzing.SzNoFlags
+	}
+
+	return flags &^ relevant
+}
+
+/*
+Method Error lists the irrelevant flags.
+*/
+func (err *IrrelevantFlagsError) Error() string {
+	return fmt.Sprintf(
+		"flags have no effect on %s methods: %s",
+		err.Family,
+		strings.Join(DecodeFor(err.Family, err.Flags), nameSeparator),
+	)
+}
+
+/*
+Method Is makes the error match [szerror.ErrSzBadInput] and [szerror.ErrSz].
+
+[szerror.ErrSzBadInput]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+[szerror.ErrSz]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+*/
+func (err *IrrelevantFlagsError) Is(target error) bool {
+	return target == szerror.ErrSzBadInput || target == szerror.ErrSz
+}
+
+/*
+Method String returns the name of the family, e.g. "entity".
+*/
+func (family Family) String() string {
+	if name, ok := familyNames[family]; ok {
+		return name
+	}
+
+	return "Family(" + strconv.Itoa(int(family)) + ")"
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func decode(flags int64, overrides []flagName) []string {
+	result := []string{}
+	remaining := uint64(flags)
+
+	for remaining != 0 {
+		bit := bits.TrailingZeros64(remaining)
+		value := int64(1) << bit
+		remaining &^= uint64(value)
+		result = append(result, nameOf(value, overrides))
+	}
+
+	return result
+}
+
+// Names for %s and %v, the number for the other verbs.
+func format(state fmt.State, verb rune, flags int64, names string) {
+	switch verb {
+	case 's', 'v':
+		_, _ = state.Write([]byte(names))
+	default:
+		fmt.Fprintf(state, fmt.FormatString(state, verb), flags)
+	}
+}
+
+func joinNames(names []string) string {
+	if len(names) == 0 {
+		return noFlagsName
+	}
+
+	return strings.Join(names, nameSeparator)
+}
+
+func nameOf(value int64, overrides []flagName) string {
+	for _, candidate := range overrides {
+		if candidate.value == value {
+			return candidate.name
+		}
+	}
+
+	for _, candidate := range flagNames {
+		if candidate.value == value {
+			return candidate.name
+		}
+	}
+
+	return "Bit" + strconv.Itoa(bits.TrailingZeros64(uint64(value))+1)
+}
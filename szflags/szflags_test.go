@@ -0,0 +1,134 @@
+package szflags_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szflags"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestBuilder_presets(test *testing.T) {
+	testCases := []struct {
+		builder  szflags.Builder
+		expected int64
+		family   szflags.Family
+	}{
+		{szflags.Entity(), senzing.SzEntityDefaultFlags, szflags.FamilyEntity},
+		{szflags.EntityBrief(), senzing.SzEntityBriefDefaultFlags, szflags.FamilyEntity},
+		{szflags.Export(), senzing.SzExportDefaultFlags, szflags.FamilyExport},
+		{szflags.FindNetwork(), senzing.SzFindNetworkDefaultFlags, szflags.FamilyFindNetwork},
+		{szflags.FindPath(), senzing.SzFindPathDefaultFlags, szflags.FamilyFindPath},
+		{szflags.How(), senzing.SzHowEntityDefaultFlags, szflags.FamilyHow},
+		{szflags.Record(), senzing.SzRecordDefaultFlags, szflags.FamilyRecord},
+		{szflags.Search(), senzing.SzSearchByAttributesDefaultFlags, szflags.FamilySearch},
+		{szflags.SearchStrong(), senzing.SzSearchByAttributesStrong, szflags.FamilySearch},
+		{szflags.Why(), senzing.SzWhyEntitiesDefaultFlags, szflags.FamilyWhy},
+		{szflags.WithInfo(), senzing.SzWithInfo, szflags.FamilyWithInfo},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.family.String(), func(test *testing.T) {
+			assert.Equal(test, testCase.expected, testCase.builder.Build())
+			assert.Equal(test, testCase.family, testCase.builder.Family())
+			require.NoError(test, testCase.builder.Validate(), "presets only use relevant flags")
+		})
+	}
+}
+
+func TestBuilder_WithWithout(test *testing.T) {
+	builder := szflags.Entity().
+		With(senzing.SzEntityIncludeRecordJSONData).
+		Without(senzing.SzEntityIncludeAllRelations)
+	expected := (senzing.SzEntityDefaultFlags | senzing.SzEntityIncludeRecordJSONData) &^
+		senzing.SzEntityIncludeAllRelations
+	assert.Equal(test, expected, builder.Build())
+	assert.Equal(test, senzing.SzEntityDefaultFlags, szflags.Entity().Build(), "builders are values")
+}
+
+func TestBuilder_Validate(test *testing.T) {
+	err := szflags.Entity().With(senzing.SzWithInfo, senzing.SzFindPathStrictAvoid).Validate()
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+
+	var irrelevantFlagsError *szflags.IrrelevantFlagsError
+
+	require.ErrorAs(test, err, &irrelevantFlagsError)
+	assert.Equal(test, senzing.SzWithInfo|senzing.SzFindPathStrictAvoid, irrelevantFlagsError.Flags)
+	assert.Equal(test, "flags have no effect on entity methods: SzFindPathStrictAvoid | SzWithInfo", err.Error())
+}
+
+func TestDecode(test *testing.T) {
+	assert.Empty(test, szflags.Decode(senzing.SzNoFlags))
+	assert.Equal(test,
+		[]string{"SzEntityIncludeEntityName", "SzEntityIncludeRecordSummary", "SzFindNetworkIncludeMatchingInfo"},
+		szflags.Decode(senzing.SzFindNetworkDefaultFlags))
+	assert.Equal(test, []string{"Bit18", "SzWithInfo"}, szflags.Decode(senzing.Bit18|senzing.SzWithInfo))
+	assert.Equal(test, []string{"Bit64"}, szflags.Decode(-1<<63))
+}
+
+func TestDecode_allNamedBits(test *testing.T) {
+	for bit := range 63 {
+		names := szflags.Decode(int64(1) << bit)
+		require.Len(test, names, 1)
+	}
+
+	assert.Len(test, szflags.Decode(-1), 64)
+}
+
+func TestDecodeFor(test *testing.T) {
+	assert.Equal(test,
+		[]string{"SzSearchIncludeResolved", "SzSearchIncludePossiblySame", "SzSearchIncludeStats"},
+		szflags.DecodeFor(szflags.FamilySearch, senzing.SzSearchByAttributesMinimalStrong))
+	assert.Equal(test,
+		[]string{"SzExportIncludeMultiRecordEntities", "SzExportIncludeSingleRecordEntities"},
+		szflags.DecodeFor(szflags.FamilyExport, senzing.SzExportIncludeAllEntities))
+}
+
+func TestIrrelevant(test *testing.T) {
+	assert.Equal(test, senzing.SzNoFlags, szflags.Irrelevant(szflags.FamilyWithInfo, senzing.SzWithInfo))
+	assert.Equal(test,
+		senzing.SzEntityIncludeEntityName,
+		szflags.Irrelevant(szflags.FamilyWithInfo, senzing.SzWithInfo|senzing.SzEntityIncludeEntityName))
+	assert.Equal(test, senzing.SzNoFlags, szflags.Irrelevant(szflags.Family(99), -1))
+	require.NoError(test, szflags.Check(szflags.FamilySearch, senzing.SzWhySearchDefaultFlags))
+}
+
+func TestMask(test *testing.T) {
+	mask := szflags.Mask(senzing.SzHowEntityDefaultFlags)
+	assert.Equal(test, "SzIncludeFeatureScores", mask.String())
+	assert.Equal(test, "SzNoFlags", szflags.Mask(senzing.SzNoFlags).String())
+	assert.Equal(test, "GetEntity(1, SzIncludeFeatureScores)", fmt.Sprintf("GetEntity(%d, %v)", 1, mask))
+	assert.Equal(test, "67108864", fmt.Sprintf("%d", mask))
+	assert.Equal(test, "4000000", fmt.Sprintf("%x", mask))
+
+	actual, err := json.Marshal(map[string]szflags.Mask{"flags": szflags.Mask(senzing.SzWithInfo)})
+	require.NoError(test, err)
+	assert.JSONEq(test, `{"flags": "SzWithInfo"}`, string(actual))
+}
+
+func TestMaskFor(test *testing.T) {
+	mask := szflags.MaskFor(szflags.FamilySearch, senzing.SzSearchIncludeResolved|senzing.SzSearchIncludePossiblySame)
+	assert.Equal(test, "SzSearchIncludeResolved | SzSearchIncludePossiblySame", mask.String())
+	assert.Equal(test, mask.String(), fmt.Sprintf("%v", mask))
+	assert.Equal(test, "3", fmt.Sprintf("%d", mask))
+	assert.Equal(test, "SzNoFlags", szflags.MaskFor(szflags.FamilySearch, senzing.SzNoFlags).String())
+	assert.Equal(test,
+		"SzExportIncludeMultiRecordEntities",
+		szflags.MaskFor(szflags.FamilyExport, senzing.SzExportIncludeMultiRecordEntities).String())
+
+	actual, err := json.Marshal(map[string]szflags.FamilyMask{"flags": mask})
+	require.NoError(test, err)
+	assert.JSONEq(test, `{"flags": "SzSearchIncludeResolved | SzSearchIncludePossiblySame"}`, string(actual))
+}
+
+func TestFamily_String(test *testing.T) {
+	assert.Equal(test, "find path", szflags.FamilyFindPath.String())
+	assert.Equal(test, "Family(99)", szflags.Family(99).String())
+}
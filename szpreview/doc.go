@@ -0,0 +1,17 @@
+/*
+Package szpreview tells whether a record would resolve into an existing entity, without adding it.
+
+[Preview] calls GetRecordPreview() of [senzing.SzEngine] to obtain the features Senzing would derive
+from the record, then SearchByAttributes() with those features to find candidate entities.
+The [Result] lists the candidates, ranked as by the [szsearch] package, and a [Verdict]:
+
+	result, err := szpreview.Preview(ctx, szEngine, recordDefinition, szsearch.Options{Limit: 5})
+	...
+	fmt.Println(result) // "would join entity 42", "new entity, related to entity 7" or "new entity"
+
+The [Engine] interface only has these two methods, so a preview cannot change the repository.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+[szsearch]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szsearch
+*/
+package szpreview
@@ -0,0 +1,67 @@
+package szpreview
+
+import (
+	"context"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Engine interface is the read-only part of [senzing.SzEngine] used for previews.
+Any senzing.SzEngine satisfies it.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+type Engine interface {
+	GetRecordPreview(ctx context.Context, recordDefinition string, flags int64) (string, error)
+	SearchByAttributes(ctx context.Context, attributes string, searchProfile string, flags int64) (string, error)
+}
+
+/*
+Type Verdict int is what adding the record would do.
+*/
+type Verdict int
+
+// Fragment of a GetRecordPreview() response.
+type previewDocument struct {
+	Features map[string][]struct {
+		Attributes map[string]string `json:"ATTRIBUTES"`
+		FeatDesc   string            `json:"FEAT_DESC"`
+		UsageType  string            `json:"USAGE_TYPE"`
+	} `json:"FEATURES"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+The Verdict* constants are the possible verdicts.
+
+  - VerdictNewEntity: No entity matches; the record would become a new entity.
+  - VerdictWouldJoin: The record would resolve into the best candidate.
+  - VerdictWouldRelate: The record would become a new entity related to the best candidate.
+*/
+const (
+	VerdictNewEntity Verdict = iota
+	VerdictWouldJoin
+	VerdictWouldRelate
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var verdictNames = map[Verdict]string{
+	VerdictNewEntity:   "NEW_ENTITY",
+	VerdictWouldJoin:   "WOULD_JOIN",
+	VerdictWouldRelate: "WOULD_RELATE",
+}
+
+// Attributes that identify the record rather than describe the entity.
+var recordAttributes = map[string]bool{
+	"DATA_SOURCE": true,
+	"RECORD_ID":   true,
+}
@@ -0,0 +1,206 @@
+package szpreview
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szsearch"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+/*
+Type Feature struct is a feature Senzing derived from the record.
+*/
+type Feature struct {
+	Attributes  map[string]string // The attributes of the record that make up the feature.
+	Description string            // FEAT_DESC. Example: "Robert Smith".
+	UsageType   string            // Example: "PRIMARY".
+}
+
+/*
+Type Result struct is the outcome of a preview.
+*/
+type Result struct {
+	Candidates []szsearch.Result    // The page of ranked candidates selected by the options.
+	EntityID   int64                // The best candidate; 0 for VerdictNewEntity.
+	Features   map[string][]Feature // By feature type. Example: "NAME".
+	Total      int                  // Number of ranked candidates before pagination.
+	Verdict    Verdict              // What adding the record would do.
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Preview function tells whether a record would resolve into an existing entity.
+It only calls GetRecordPreview() and SearchByAttributes(); the repository is not changed.
+
+The verdict is taken from the best candidate kept by the MinMatchLevel and MinScore options,
+before Offset and Limit select the page of candidates returned.
+
+Input
+  - ctx: A context to control lifecycle.
+  - engine: A senzing.SzEngine.
+  - recordDefinition: The record, as it would be passed to AddRecord().
+  - options: The search profile and flags, and how candidates are filtered and paginated.
+
+Output
+  - The verdict, the candidates and the features of the record.
+*/
+func Preview(ctx context.Context, engine Engine, recordDefinition string, options szsearch.Options) (*Result, error) {
+	response, err := engine.GetRecordPreview(ctx, recordDefinition, senzing.SzRecordPreviewDefaultFlags)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	features, err := ParsePreviewResponse(response)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := searchAttributes(features, recordDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	allOptions := options
+	allOptions.Limit = 0
+	allOptions.Offset = 0
+
+	ranked, err := szsearch.SearchAttributes(ctx, engine, attributes, allOptions)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	page := szsearch.Rank(ranked.Results, options)
+	result := &Result{
+		Candidates: page.Results,
+		EntityID:   0,
+		Features:   features,
+		Total:      page.Total,
+		Verdict:    VerdictNewEntity,
+	}
+
+	if len(ranked.Results) > 0 {
+		best := ranked.Results[0]
+		result.EntityID = best.EntityID
+		result.Verdict = VerdictWouldRelate
+
+		if best.MatchLevel == szsearch.MatchLevelResolved {
+			result.Verdict = VerdictWouldJoin
+		}
+	}
+
+	return result, nil
+}
+
+/*
+The ParsePreviewResponse function returns the features in a GetRecordPreview() response.
+
+Input
+  - response: The JSON document returned by GetRecordPreview() with SzEntityIncludeRecordFeatureDetails.
+
+Output
+  - The features, by feature type.
+*/
+func ParsePreviewResponse(response string) (map[string][]Feature, error) {
+	var document previewDocument
+
+	err := json.Unmarshal([]byte(response), &document)
+	if err != nil {
+		return nil, fmt.Errorf("parsing record preview: %w", err)
+	}
+
+	result := map[string][]Feature{}
+
+	for featureType, features := range document.Features {
+		for _, feature := range features {
+			result[featureType] = append(result[featureType], Feature{
+				Attributes:  feature.Attributes,
+				Description: feature.FeatDesc,
+				UsageType:   feature.UsageType,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method String returns the verdict in words, for example "would join entity 42".
+*/
+func (result *Result) String() string {
+	switch result.Verdict {
+	case VerdictWouldJoin:
+		return "would join entity " + strconv.FormatInt(result.EntityID, 10)
+	case VerdictWouldRelate:
+		return "new entity, related to entity " + strconv.FormatInt(result.EntityID, 10)
+	default:
+		return "new entity"
+	}
+}
+
+/*
+Method String returns the name of the verdict, e.g. "WOULD_JOIN".
+*/
+func (verdict Verdict) String() string {
+	if name, ok := verdictNames[verdict]; ok {
+		return name
+	}
+
+	return "Verdict(" + strconv.Itoa(int(verdict)) + ")"
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// The features' attributes, in a stable order, or the record itself if the preview did not list attributes.
+func searchAttributes(features map[string][]Feature, recordDefinition string) (string, error) {
+	searchFeatures := []map[string]string{}
+
+	for _, featureType := range slices.Sorted(maps.Keys(features)) {
+		for _, feature := range features[featureType] {
+			if len(feature.Attributes) > 0 {
+				searchFeatures = append(searchFeatures, feature.Attributes)
+			}
+		}
+	}
+
+	var document map[string]any
+
+	if len(searchFeatures) > 0 {
+		document = map[string]any{"FEATURES": searchFeatures}
+	} else {
+		err := json.Unmarshal([]byte(recordDefinition), &document)
+		if err != nil {
+			return "", fmt.Errorf("parsing record definition: %w", err)
+		}
+
+		for attribute := range recordAttributes {
+			delete(document, attribute)
+		}
+	}
+
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(document)
+	if err != nil {
+		return "", fmt.Errorf("encoding search attributes: %w", err)
+	}
+
+	return string(bytes.TrimSpace(buffer.Bytes())), nil
+}
@@ -0,0 +1,140 @@
+package szpreview_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szpreview"
+	"github.com/senzing-garage/sz-sdk-go-core/szsearch"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	recordDefinition = `{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001", "NAME_FULL": "Robert Smith", "DATE_OF_BIRTH": "12/11/1978"}`
+	previewResponse  = `{"FEATURES":{"NAME":[{"LIB_FEAT_ID":-2,"USAGE_TYPE":"PRIMARY","FEAT_DESC":"Robert Smith","ATTRIBUTES":{"NAME_FULL":"Robert Smith"}}],"DOB":[{"LIB_FEAT_ID":-3,"FEAT_DESC":"12/11/1978","ATTRIBUTES":{"DATE_OF_BIRTH":"12/11/1978"}}]}}`
+	resolvedResponse = `{"RESOLVED_ENTITIES":[
+{"MATCH_INFO":{"MATCH_LEVEL_CODE":"POSSIBLY_SAME","MATCH_KEY":"+NAME","FEATURE_SCORES":{"NAME":[{"SCORE":90}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":7,"ENTITY_NAME":"Bob Smith"}}},
+{"MATCH_INFO":{"MATCH_LEVEL_CODE":"RESOLVED","MATCH_KEY":"+NAME+DOB","FEATURE_SCORES":{"NAME":[{"SCORE":100}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":42,"ENTITY_NAME":"Robert Smith"}}}
+]}`
+	relatedResponse = `{"RESOLVED_ENTITIES":[{"MATCH_INFO":{"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+NAME","FEATURE_SCORES":{"NAME":[{"SCORE":80}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":7,"ENTITY_NAME":"Bob Smith"}}}]}`
+	noMatchResponse = `{"RESOLVED_ENTITIES":[]}`
+)
+
+type fakeEngine struct {
+	previewErr      error
+	previewFlags    int64
+	previewResponse string
+	searched        string
+	searchResponse  string
+}
+
+func (engine *fakeEngine) GetRecordPreview(ctx context.Context, recordDefinition string, flags int64) (string, error) {
+	_, _ = ctx, recordDefinition
+	engine.previewFlags = flags
+
+	return engine.previewResponse, engine.previewErr
+}
+
+func (engine *fakeEngine) SearchByAttributes(
+	ctx context.Context,
+	attributes string,
+	searchProfile string,
+	flags int64,
+) (string, error) {
+	_, _, _ = ctx, searchProfile, flags
+	engine.searched = attributes
+
+	return engine.searchResponse, nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestPreview_wouldJoin(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{previewResponse: previewResponse, searchResponse: resolvedResponse}
+
+	result, err := szpreview.Preview(ctx, engine, recordDefinition, szsearch.Options{})
+	require.NoError(test, err)
+	assert.Equal(test, senzing.SzRecordPreviewDefaultFlags, engine.previewFlags)
+	assert.JSONEq(test, `{"FEATURES": [{"DATE_OF_BIRTH": "12/11/1978"}, {"NAME_FULL": "Robert Smith"}]}`, engine.searched)
+	assert.Equal(test, szpreview.VerdictWouldJoin, result.Verdict)
+	assert.Equal(test, int64(42), result.EntityID)
+	assert.Equal(test, "would join entity 42", result.String())
+	assert.Equal(test, 2, result.Total)
+	require.Len(test, result.Candidates, 2)
+	assert.Equal(test, int64(42), result.Candidates[0].EntityID)
+	assert.Equal(test, []szpreview.Feature{{
+		Attributes:  map[string]string{"NAME_FULL": "Robert Smith"},
+		Description: "Robert Smith",
+		UsageType:   "PRIMARY",
+	}}, result.Features["NAME"])
+}
+
+func TestPreview_verdictIgnoresPagination(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{previewResponse: previewResponse, searchResponse: resolvedResponse}
+
+	result, err := szpreview.Preview(ctx, engine, recordDefinition, szsearch.Options{Offset: 1, Limit: 1})
+	require.NoError(test, err)
+	assert.Equal(test, szpreview.VerdictWouldJoin, result.Verdict)
+	assert.Equal(test, int64(42), result.EntityID)
+	require.Len(test, result.Candidates, 1)
+	assert.Equal(test, int64(7), result.Candidates[0].EntityID)
+}
+
+func TestPreview_wouldRelate(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{previewResponse: previewResponse, searchResponse: relatedResponse}
+
+	result, err := szpreview.Preview(ctx, engine, recordDefinition, szsearch.Options{})
+	require.NoError(test, err)
+	assert.Equal(test, szpreview.VerdictWouldRelate, result.Verdict)
+	assert.Equal(test, "new entity, related to entity 7", result.String())
+}
+
+func TestPreview_newEntity(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{previewResponse: previewResponse, searchResponse: relatedResponse}
+
+	result, err := szpreview.Preview(ctx, engine, recordDefinition, szsearch.Options{
+		MinMatchLevel: szsearch.MatchLevelPossiblySame,
+	})
+	require.NoError(test, err)
+	assert.Equal(test, szpreview.VerdictNewEntity, result.Verdict)
+	assert.Equal(test, int64(0), result.EntityID)
+	assert.Equal(test, "new entity", result.String())
+
+	engine.searchResponse = noMatchResponse
+	result, err = szpreview.Preview(ctx, engine, recordDefinition, szsearch.Options{})
+	require.NoError(test, err)
+	assert.Equal(test, szpreview.VerdictNewEntity, result.Verdict)
+}
+
+func TestPreview_withoutFeatureAttributes(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{previewResponse: `{}`, searchResponse: noMatchResponse}
+
+	_, err := szpreview.Preview(ctx, engine, recordDefinition, szsearch.Options{})
+	require.NoError(test, err)
+	assert.JSONEq(test, `{"NAME_FULL": "Robert Smith", "DATE_OF_BIRTH": "12/11/1978"}`, engine.searched)
+}
+
+func TestPreview_previewError(test *testing.T) {
+	ctx := test.Context()
+	previewErr := errors.New("bad record")
+	engine := &fakeEngine{previewErr: previewErr}
+
+	_, err := szpreview.Preview(ctx, engine, recordDefinition, szsearch.Options{})
+	require.ErrorIs(test, err, previewErr)
+	assert.Empty(test, engine.searched, "SearchByAttributes should not be called")
+}
+
+func TestVerdict_String(test *testing.T) {
+	assert.Equal(test, "WOULD_JOIN", szpreview.VerdictWouldJoin.String())
+	assert.Equal(test, "Verdict(9)", szpreview.Verdict(9).String())
+}
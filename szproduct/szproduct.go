@@ -14,8 +14,8 @@ package szproduct
 import "C"
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"runtime"
 	"strconv"
@@ -29,6 +29,8 @@ import (
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/go-observing/subject"
 	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/sdkerror"
+	"github.com/senzing-garage/sz-sdk-go-core/validation"
 	"github.com/senzing-garage/sz-sdk-go/szerror"
 	"github.com/senzing-garage/sz-sdk-go/szproduct"
 )
@@ -38,6 +40,8 @@ Type Szproduct struct implements the [senzing.SzProduct] interface
 for communicating with the Senzing C binaries.
 */
 type Szproduct struct {
+	callGate       helper.CallGate
+	executor       *helper.Executor
 	instanceName   string
 	isDestroyed    bool
 	isTrace        bool
@@ -50,11 +54,10 @@ type Szproduct struct {
 }
 
 const (
-	baseCallerSkip       = 4
-	baseTen              = 10
-	initialByteArraySize = 65535
-	noError              = 0
-	uninitializedError   = -1
+	baseCallerSkip     = 4
+	baseTen            = 10
+	noError            = 0
+	uninitializedError = -1
 )
 
 // ----------------------------------------------------------------------------
@@ -66,6 +69,10 @@ Method Destroy will destroy and perform cleanup for the Senzing SzProduct object
 
 It should be called after all other calls are complete.
 
+New calls are rejected once Destroy is called.
+Calls already in flight are given until ctx is done to finish;
+if they do not, nothing is destroyed and an error is returned.
+
 Input
   - ctx: A context to control lifecycle.
 */
@@ -76,6 +83,11 @@ func (client *Szproduct) Destroy(ctx context.Context) error {
 		return wraperror.Errorf(errForPackage, "This SzProduct has been destroyed.")
 	}
 
+	err = client.Drain(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
 	if client.isTrace {
 		client.traceEntry(3)
 
@@ -83,7 +95,7 @@ func (client *Szproduct) Destroy(ctx context.Context) error {
 		defer func() { client.traceExit(4, err, time.Since(entryTime)) }()
 	}
 
-	err = client.destroy(ctx)
+	client.executor.Call(func() { err = client.destroy(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -114,9 +126,10 @@ func (client *Szproduct) GetLicense(ctx context.Context) (string, error) {
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzProduct has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(9)
@@ -125,7 +138,7 @@ func (client *Szproduct) GetLicense(ctx context.Context) (string, error) {
 		defer func() { client.traceExit(10, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.getLicense(ctx)
+	client.executor.Call(func() { result, err = client.getLicense(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -152,9 +165,10 @@ func (client *Szproduct) GetVersion(ctx context.Context) (string, error) {
 		result string
 	)
 
-	if client.isDestroyed {
+	if !client.callGate.Enter() {
 		return result, wraperror.Errorf(errForPackage, "This SzProduct has been destroyed.")
 	}
+	defer client.callGate.Exit()
 
 	if client.isTrace {
 		client.traceEntry(11)
@@ -163,7 +177,7 @@ func (client *Szproduct) GetVersion(ctx context.Context) (string, error) {
 		defer func() { client.traceExit(12, result, err, time.Since(entryTime)) }()
 	}
 
-	result, err = client.getVersion(ctx)
+	client.executor.Call(func() { result, err = client.getVersion(ctx) })
 
 	if client.observers != nil {
 		go func() {
@@ -179,6 +193,25 @@ func (client *Szproduct) GetVersion(ctx context.Context) (string, error) {
 // Public non-interface methods
 // ----------------------------------------------------------------------------
 
+/*
+Method Drain stops accepting new calls and waits for calls in flight to finish.
+
+Calls made after Drain are rejected. Destroy() may still be called.
+Destroy() drains the object itself, so Drain is only needed to quiesce an object without destroying it.
+
+Input
+  - ctx: A context to bound the wait.
+    If ctx is done before the calls finish, new calls are accepted again and an error is returned.
+*/
+func (client *Szproduct) Drain(ctx context.Context) error {
+	err := client.callGate.Close(ctx)
+	if err != nil && !errors.Is(err, helper.ErrCallGateClosed) {
+		return wraperror.Errorf(errForPackage, "calls in flight did not finish: %v", err)
+	}
+
+	return nil
+}
+
 /*
 Method GetObserverOrigin returns the "origin" value of past Observer messages.
 
@@ -194,6 +227,23 @@ func (client *Szproduct) GetObserverOrigin(ctx context.Context) string {
 	return client.observerOrigin
 }
 
+/*
+Method Hold makes new calls wait, then waits for calls in flight to finish,
+so that the Senzing configuration can be swapped safely. Release() lets the waiting calls proceed.
+
+Input
+  - ctx: A context to bound the wait.
+    If ctx is done before the calls finish, the waiting calls proceed and an error is returned.
+*/
+func (client *Szproduct) Hold(ctx context.Context) error {
+	err := client.callGate.Hold(ctx)
+	if err != nil {
+		return wraperror.Errorf(errForPackage, "cannot hold calls: %v", err)
+	}
+
+	return nil
+}
+
 /*
 Method Initialize initializes the Senzing SzProduct object.
 
@@ -220,10 +270,19 @@ func (client *Szproduct) Initialize(
 		defer func() { client.traceExit(14, instanceName, settings, verboseLogging, err, time.Since(entryTime)) }()
 	}
 
+	err = client.checkInputs(
+		4002,
+		validation.Identifier("instanceName", instanceName),
+		validation.Document("settings", settings),
+	)
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
 	client.instanceName = instanceName
 	client.settings = settings
 	client.verboseLogging = verboseLogging
-	err = client.init(ctx, instanceName, settings, verboseLogging)
+	client.executor.Call(func() { err = client.init(ctx, instanceName, settings, verboseLogging) })
 
 	if client.observers != nil {
 		go func() {
@@ -239,6 +298,18 @@ func (client *Szproduct) Initialize(
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+Method IsDestroyed reports whether Destroy() has been called.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szproduct) IsDestroyed(ctx context.Context) bool {
+	_ = ctx
+
+	return client.isDestroyed
+}
+
 /*
 Method IsInitialized inspects C binary to see if it is initialized.
 
@@ -289,6 +360,34 @@ func (client *Szproduct) RegisterObserver(ctx context.Context, observer observer
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+Method Release lets the calls held by Hold() proceed.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (client *Szproduct) Release(ctx context.Context) {
+	_ = ctx
+
+	client.callGate.Release()
+}
+
+/*
+Method SetExecutor routes calls into the Senzing C binary through an [helper.Executor].
+
+By default each call locks the calling goroutine to its OS thread.
+With an executor the calls run on its pool of threads, which stay locked.
+Set the executor before making calls; it is not changed while calls are in flight.
+
+Input
+  - ctx: A context to control lifecycle.
+  - executor: The executor to use. nil restores the default.
+*/
+func (client *Szproduct) SetExecutor(ctx context.Context, executor *helper.Executor) {
+	_ = ctx
+	client.executor = executor
+}
+
 /*
 Method SetLogLevel sets the level of logging.
 
@@ -483,7 +582,12 @@ func (client *Szproduct) traceExit(errorNumber int, details ...interface{}) {
 
 // --- Errors -----------------------------------------------------------------
 
-// Create a new error.
+// Check parameters before they are sent to the Senzing C binary.
+func (client *Szproduct) checkInputs(errorNumber int, inputs ...validation.Input) error {
+	return helper.CheckInputs(ComponentID, errorNumber, client.getMessenger(), inputs...)
+}
+
+// Create a new error.  The returned *sdkerror.Error can be retrieved using errors.As().
 func (client *Szproduct) newError(ctx context.Context, errorNumber int, details ...interface{}) error {
 	defer func() { client.panicOnError(client.clearLastException(ctx)) }()
 
@@ -494,12 +598,20 @@ func (client *Szproduct) newError(ctx context.Context, errorNumber int, details
 		lastException = err.Error()
 	}
 
+	arguments := details
 	details = append(details, messenger.MessageCode{Value: fmt.Sprintf(ExceptionCodeTemplate, lastExceptionCode)})
 	details = append(details, messenger.MessageReason{Value: lastException})
 	details = append(details, wraperror.Errorf(szerror.ErrSz, "exception: %s", lastException))
 	errorMessage := client.getMessenger().NewJSON(errorNumber, details...)
 
-	return szerror.New(lastExceptionCode, errorMessage) //nolint
+	return sdkerror.New(
+		ComponentID,
+		errorNumber,
+		lastExceptionCode,
+		lastException,
+		arguments,
+		szerror.New(lastExceptionCode, errorMessage),
+	)
 }
 
 /*
@@ -563,9 +675,11 @@ func (client *Szproduct) getLastException(ctx context.Context) (string, error) {
 		defer func() { client.traceExit(6, result, err, time.Since(entryTime)) }()
 	}
 
-	stringBuffer := client.getByteArray(initialByteArraySize)
-	C.SzProduct_getLastException((*C.char)(unsafe.Pointer(&stringBuffer[0])), C.size_t(len(stringBuffer)))
-	result = string(bytes.Trim(stringBuffer, "\x00"))
+	stringBuffer := helper.GetExceptionBuffer()
+	defer helper.PutExceptionBuffer(stringBuffer)
+
+	C.SzProduct_getLastException((*C.char)(unsafe.Pointer(&(*stringBuffer)[0])), C.size_t(len(*stringBuffer)))
+	result = helper.NulTerminatedString(*stringBuffer)
 
 	return result, err
 }
@@ -601,17 +715,6 @@ func (client *Szproduct) getLastExceptionCode(ctx context.Context) (int, error)
 
 // --- Misc -------------------------------------------------------------------
 
-// Get space for an array of bytes of a given size.
-// func (client *Szproduct) getByteArrayC(size int) *C.char {
-// 	bytes := C.malloc(C.size_t(size))
-// 	return (*C.char)(bytes)
-// }
-
-// Make a byte array.
-func (client *Szproduct) getByteArray(size int) []byte {
-	return make([]byte, size)
-}
-
 // A hack: Only needed to import the "senzing" package for the godoc comments.
 // func junk() {
 // 	fmt.Printf(senzing.SzNoAttributes)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
 
 	truncator "github.com/aquilax/truncate"
@@ -12,6 +13,8 @@ import (
 	"github.com/senzing-garage/go-helpers/fileutil"
 	"github.com/senzing-garage/go-helpers/settings"
 	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/sz-sdk-go-core/helper"
+	"github.com/senzing-garage/sz-sdk-go-core/internal/testhelper"
 	"github.com/senzing-garage/sz-sdk-go-core/szabstractfactory"
 	"github.com/senzing-garage/sz-sdk-go-core/szproduct"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
@@ -126,6 +129,51 @@ func TestSzproduct_Destroy_withObserver(test *testing.T) {
 	require.NoError(test, err)
 }
 
+func TestSzproduct_SetExecutor(test *testing.T) {
+	ctx := test.Context()
+	szProduct := getTestObject(test)
+	executor := helper.NewExecutor(1)
+
+	defer executor.Close()
+
+	szProduct.SetExecutor(ctx, executor)
+	defer szProduct.SetExecutor(ctx, nil)
+
+	actual, err := szProduct.GetVersion(ctx)
+	printDebug(test, err, actual)
+	require.NoError(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Benchmarks
+// ----------------------------------------------------------------------------
+
+func BenchmarkSzproduct_GetVersion(benchmark *testing.B) {
+	ctx := benchmark.Context()
+	szProduct := getSzProduct(ctx)
+
+	testhelper.BenchmarkLatency(benchmark, func() {
+		_, err := szProduct.GetVersion(ctx)
+		panicOnError(err)
+	})
+}
+
+func BenchmarkSzproduct_GetVersion_withExecutor(benchmark *testing.B) {
+	ctx := benchmark.Context()
+	szProduct := getSzProduct(ctx)
+	executor := helper.NewExecutor(runtime.GOMAXPROCS(0))
+
+	defer executor.Close()
+
+	szProduct.SetExecutor(ctx, executor)
+	defer szProduct.SetExecutor(ctx, nil)
+
+	testhelper.BenchmarkLatency(benchmark, func() {
+		_, err := szProduct.GetVersion(ctx)
+		panicOnError(err)
+	})
+}
+
 // ----------------------------------------------------------------------------
 // Internal functions
 // ----------------------------------------------------------------------------
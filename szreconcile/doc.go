@@ -0,0 +1,30 @@
+/*
+Package szreconcile brings the data sources of the default Senzing configuration to a desired state.
+
+The desired state is a YAML or JSON [Spec] file, kept under version control:
+
+	# datasources.yaml
+	dataSources:
+	  - CUSTOMERS
+	  - REFERENCE
+	  - WATCHLIST
+	prune: true # Unregister data sources that are not listed, except SEARCH and TEST of the template.
+
+A [Reconciler] compares the spec with the default configuration of [senzing.SzConfigManager],
+registers and unregisters data sources as needed, registers the new configuration with a generated comment,
+and makes it the default with ReplaceDefaultConfigID().
+If another process changed the default configuration meanwhile, the plan is recomputed from the new default
+and applied again, up to [Options] MaxAttempts times with a growing delay.
+The configuration registered by a lost attempt stays in the repository, unused; its identifier is in [Result]:
+
+	spec, err := szreconcile.LoadSpec("datasources.yaml")
+	...
+	result, err := szreconcile.New(szConfigManager, szreconcile.Options{DryRun: true}).Reconcile(ctx, spec)
+	...
+	fmt.Print(result.Plan) // "+ REFERENCE\n- OLD_SOURCE\n"
+
+With DryRun, the plan is computed but nothing is changed.
+
+[senzing.SzConfigManager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzConfigManager
+*/
+package szreconcile
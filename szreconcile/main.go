@@ -0,0 +1,60 @@
+package szreconcile
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type ConfigManager interface is the part of [senzing.SzConfigManager] used for reconciliation.
+Any senzing.SzConfigManager satisfies it.
+
+[senzing.SzConfigManager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzConfigManager
+*/
+type ConfigManager interface {
+	CreateConfigFromConfigID(ctx context.Context, configID int64) (senzing.SzConfig, error)
+	GetDefaultConfigID(ctx context.Context) (int64, error)
+	RegisterConfig(ctx context.Context, configDefinition string, configComment string) (int64, error)
+	ReplaceDefaultConfigID(ctx context.Context, currentDefaultConfigID int64, newDefaultConfigID int64) error
+}
+
+// Fragment of a GetDataSourceRegistry() response.
+type registryDocument struct {
+	DataSources []struct {
+		DataSourceCode string `json:"DSRC_CODE"`
+	} `json:"DATA_SOURCES"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// DefaultMaxAttempts is the number of attempts when Options.MaxAttempts is 0.
+const DefaultMaxAttempts = 5
+
+// DefaultRetryDelay is the wait before the first retry when Options.RetryDelay is 0.
+const DefaultRetryDelay = 100 * time.Millisecond
+
+const baseTen = 10
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var (
+	errEmptyDataSourceCode = errors.New("empty data source code")
+	errTooManyConflicts    = errors.New("the default configuration kept changing")
+)
+
+// Data sources of the template configuration, used by Senzing itself and never pruned.
+var templateDataSources = map[string]bool{
+	"SEARCH": true,
+	"TEST":   true,
+}
@@ -0,0 +1,393 @@
+package szreconcile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"gopkg.in/yaml.v3"
+)
+
+/*
+Type Options struct controls a Reconciler.
+*/
+type Options struct {
+	Comment     string // Prepended to the generated comment of the registered configuration.
+	DryRun      bool   // Compute the plan without changing anything.
+	MaxAttempts int    // Attempts when the default configuration changes meanwhile. 0 means DefaultMaxAttempts.
+
+	// Wait before the first retry, doubled before each further retry. 0 means DefaultRetryDelay.
+	RetryDelay time.Duration
+}
+
+/*
+Type Plan struct lists the changes needed to reach the spec.
+*/
+type Plan struct {
+	BaseConfigID int64    // The default configuration the plan was computed from.
+	Register     []string // Data sources to register, sorted.
+	Unregister   []string // Data sources to unregister, sorted.
+}
+
+/*
+Type Reconciler struct applies a Spec to the default configuration.
+*/
+type Reconciler struct {
+	configManager ConfigManager
+	options       Options
+}
+
+/*
+Type Result struct is the outcome of a reconciliation.
+*/
+type Result struct {
+	Attempts int    // Number of plans computed; more than 1 when the default configuration changed meanwhile.
+	Comment  string // The comment of the registered configuration.
+	ConfigID int64  // The new default configuration; 0 for a dry run or when there was nothing to do.
+	Plan     *Plan  // The plan that was applied, or would be applied for a dry run.
+
+	// Configurations registered by attempts that could not make them the default.
+	// Senzing cannot remove a registered configuration, so they stay in the repository, unused.
+	UnusedConfigIDs []int64
+}
+
+/*
+Type Spec struct is the desired state of the data sources.
+*/
+type Spec struct {
+	DataSources []string `json:"dataSources" yaml:"dataSources"` // Data sources that must be registered.
+	Prune       bool     `json:"prune"       yaml:"prune"`       // Whether to unregister data sources that are not listed, except SEARCH and TEST.
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The LoadSpec function reads a Spec from a YAML or JSON file.
+
+Input
+  - path: The file.
+
+Output
+  - The spec.
+*/
+func LoadSpec(path string) (*Spec, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading spec: %w", err)
+	}
+
+	return ParseSpec(content)
+}
+
+/*
+The New function returns a Reconciler.
+
+Input
+  - configManager: A senzing.SzConfigManager.
+  - options: How to reconcile.
+*/
+func New(configManager ConfigManager, options Options) *Reconciler {
+	return &Reconciler{
+		configManager: configManager,
+		options:       options,
+	}
+}
+
+/*
+The NewPlan function compares the registered data sources with a spec.
+
+Input
+  - spec: The desired state.
+  - registered: The data sources in the configuration.
+
+Output
+  - The changes needed; BaseConfigID is not set.
+    The SEARCH and TEST data sources of the template configuration are used by Senzing itself and never unregistered.
+*/
+func NewPlan(spec *Spec, registered []string) *Plan {
+	wanted := map[string]bool{}
+	for _, code := range spec.DataSources {
+		wanted[strings.ToUpper(code)] = true
+	}
+
+	present := map[string]bool{}
+	for _, code := range registered {
+		present[strings.ToUpper(code)] = true
+	}
+
+	plan := &Plan{BaseConfigID: 0, Register: []string{}, Unregister: []string{}}
+
+	for code := range wanted {
+		if !present[code] {
+			plan.Register = append(plan.Register, code)
+		}
+	}
+
+	if spec.Prune {
+		for code := range present {
+			if !wanted[code] && !templateDataSources[code] {
+				plan.Unregister = append(plan.Unregister, code)
+			}
+		}
+	}
+
+	slices.Sort(plan.Register)
+	slices.Sort(plan.Unregister)
+
+	return plan
+}
+
+/*
+The ParseSpec function reads a Spec from YAML or JSON. Unknown keys are errors.
+
+Input
+  - content: The YAML or JSON document.
+
+Output
+  - The spec, with data source codes in upper case.
+*/
+func ParseSpec(content []byte) (*Spec, error) {
+	var spec Spec
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+
+	err := decoder.Decode(&spec)
+	if err != nil {
+		return nil, fmt.Errorf("parsing spec: %w", err)
+	}
+
+	for index, code := range spec.DataSources {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if len(code) == 0 {
+			return nil, fmt.Errorf("parsing spec: dataSources[%d]: %w", index, errEmptyDataSourceCode)
+		}
+
+		spec.DataSources[index] = code
+	}
+
+	return &spec, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method IsEmpty reports whether the plan has no changes.
+*/
+func (plan *Plan) IsEmpty() bool {
+	return len(plan.Register) == 0 && len(plan.Unregister) == 0
+}
+
+/*
+Method String returns the plan, one change per line: "+ CODE" to register and "- CODE" to unregister.
+*/
+func (plan *Plan) String() string {
+	if plan.IsEmpty() {
+		return "No changes to configuration " + strconv.FormatInt(plan.BaseConfigID, baseTen) + ".\n"
+	}
+
+	var builder strings.Builder
+
+	for _, code := range plan.Register {
+		builder.WriteString("+ " + code + "\n")
+	}
+
+	for _, code := range plan.Unregister {
+		builder.WriteString("- " + code + "\n")
+	}
+
+	return builder.String()
+}
+
+/*
+Method Reconcile brings the default configuration to the spec.
+
+Each attempt that loses a race registers a configuration that does not become the default;
+these are listed in Result.UnusedConfigIDs.
+Retries wait RetryDelay, doubled each time, and stop when ctx is done.
+
+Input
+  - ctx: A context to control lifecycle.
+  - spec: The desired state.
+
+Output
+  - The plan and, unless it was a dry run or there was nothing to do, the new default configuration.
+*/
+func (reconciler *Reconciler) Reconcile(ctx context.Context, spec *Spec) (*Result, error) {
+	maxAttempts := reconciler.options.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	retryDelay := reconciler.options.RetryDelay
+	if retryDelay <= 0 {
+		retryDelay = DefaultRetryDelay
+	}
+
+	result := &Result{Attempts: 0, Comment: "", ConfigID: 0, Plan: nil, UnusedConfigIDs: []int64{}}
+
+	var err error
+
+	for result.Attempts < maxAttempts {
+		if result.Attempts > 0 {
+			err = sleep(ctx, retryDelay)
+			if err != nil {
+				return result, err
+			}
+
+			retryDelay *= 2
+		}
+
+		result.Attempts++
+
+		err = reconciler.attempt(ctx, spec, result)
+		if !errors.Is(err, szerror.ErrSzReplaceConflict) {
+			return result, err
+		}
+	}
+
+	return result, fmt.Errorf("%w after %d attempts: %w", errTooManyConflicts, result.Attempts, err)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Plan from the current default configuration and, unless there is nothing to do, apply the plan.
+func (reconciler *Reconciler) attempt(ctx context.Context, spec *Spec, result *Result) error {
+	configManager := reconciler.configManager
+
+	baseConfigID, err := configManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	config, err := configManager.CreateConfigFromConfigID(ctx, baseConfigID)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	registered, err := registeredDataSources(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	result.Plan = NewPlan(spec, registered)
+	result.Plan.BaseConfigID = baseConfigID
+
+	if reconciler.options.DryRun || result.Plan.IsEmpty() {
+		return nil
+	}
+
+	configDefinition, err := apply(ctx, config, result.Plan)
+	if err != nil {
+		return err
+	}
+
+	result.Comment = reconciler.comment(result.Plan)
+
+	configID, err := configManager.RegisterConfig(ctx, configDefinition, result.Comment)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	err = configManager.ReplaceDefaultConfigID(ctx, baseConfigID, configID)
+	if err != nil {
+		result.UnusedConfigIDs = append(result.UnusedConfigIDs, configID)
+
+		return err //nolint:wrapcheck
+	}
+
+	result.ConfigID = configID
+
+	return nil
+}
+
+// The comment of the registered configuration. Example: "szreconcile from 1001: +REFERENCE -OLD_SOURCE".
+func (reconciler *Reconciler) comment(plan *Plan) string {
+	changes := []string{}
+	for _, code := range plan.Register {
+		changes = append(changes, "+"+code)
+	}
+
+	for _, code := range plan.Unregister {
+		changes = append(changes, "-"+code)
+	}
+
+	comment := "szreconcile from " + strconv.FormatInt(plan.BaseConfigID, baseTen) + ": " + strings.Join(changes, " ")
+	if len(reconciler.options.Comment) > 0 {
+		comment = reconciler.options.Comment + " (" + comment + ")"
+	}
+
+	return comment
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Apply a plan to a configuration and export it.
+func apply(ctx context.Context, config senzing.SzConfig, plan *Plan) (string, error) {
+	for _, code := range plan.Register {
+		_, err := config.RegisterDataSource(ctx, code)
+		if err != nil {
+			return "", err //nolint:wrapcheck
+		}
+	}
+
+	for _, code := range plan.Unregister {
+		_, err := config.UnregisterDataSource(ctx, code)
+		if err != nil {
+			return "", err //nolint:wrapcheck
+		}
+	}
+
+	return config.Export(ctx) //nolint:wrapcheck
+}
+
+func registeredDataSources(ctx context.Context, config senzing.SzConfig) ([]string, error) {
+	response, err := config.GetDataSourceRegistry(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	var document registryDocument
+
+	err = json.Unmarshal([]byte(response), &document)
+	if err != nil {
+		return nil, fmt.Errorf("parsing data source registry: %w", err)
+	}
+
+	result := make([]string, 0, len(document.DataSources))
+	for _, dataSource := range document.DataSources {
+		result = append(result, dataSource.DataSourceCode)
+	}
+
+	return result, nil
+}
+
+// Wait for delay, or less if ctx is done first.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("waiting to retry: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,230 @@
+package szreconcile_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/internal/testhelper"
+	"github.com/senzing-garage/sz-sdk-go-core/szreconcile"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const specYAML = `
+dataSources:
+  - customers
+  - WATCHLIST
+prune: true
+`
+
+// A configuration holding only data source codes, exported as a comma-separated list.
+type fakeConfig struct {
+	dataSources []string
+}
+
+func (config *fakeConfig) Export(ctx context.Context) (string, error) {
+	_ = ctx
+
+	return strings.Join(config.dataSources, ","), nil
+}
+
+func (config *fakeConfig) GetDataSourceRegistry(ctx context.Context) (string, error) {
+	_ = ctx
+	document := map[string][]map[string]any{"DATA_SOURCES": {}}
+
+	for index, code := range config.dataSources {
+		document["DATA_SOURCES"] = append(document["DATA_SOURCES"], map[string]any{"DSRC_ID": index + 1, "DSRC_CODE": code})
+	}
+
+	response, err := json.Marshal(document)
+
+	return string(response), err
+}
+
+func (config *fakeConfig) RegisterDataSource(ctx context.Context, dataSourceCode string) (string, error) {
+	_ = ctx
+	config.dataSources = append(config.dataSources, dataSourceCode)
+
+	return `{"DSRC_ID": 1001}`, nil
+}
+
+func (config *fakeConfig) UnregisterDataSource(ctx context.Context, dataSourceCode string) (string, error) {
+	_ = ctx
+	config.dataSources = slices.DeleteFunc(config.dataSources, func(code string) bool { return code == dataSourceCode })
+
+	return "", nil
+}
+
+// A fake SzConfigManager whose configurations are comma-separated lists of data source codes.
+// A process that wins a race adds the OTHER data source.
+func newFakeConfigManager(dataSources ...string) *testhelper.ConfigManager {
+	result := testhelper.NewConfigManager(strings.Join(dataSources, ","))
+	result.NewConfig = func(definition string) senzing.SzConfig {
+		config := &fakeConfig{}
+		if len(definition) > 0 {
+			config.dataSources = strings.Split(definition, ",")
+		}
+
+		return config
+	}
+	result.Rival = func(definition string) string { return definition + ",OTHER" }
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestParseSpec(test *testing.T) {
+	spec, err := szreconcile.ParseSpec([]byte(specYAML))
+	require.NoError(test, err)
+	assert.Equal(test, &szreconcile.Spec{DataSources: []string{"CUSTOMERS", "WATCHLIST"}, Prune: true}, spec)
+
+	spec, err = szreconcile.ParseSpec([]byte(`{"dataSources": ["REFERENCE"]}`))
+	require.NoError(test, err)
+	assert.Equal(test, []string{"REFERENCE"}, spec.DataSources)
+	assert.False(test, spec.Prune)
+
+	_, err = szreconcile.ParseSpec([]byte("dataSource:\n  - TYPO\n"))
+	require.ErrorContains(test, err, "dataSource")
+
+	_, err = szreconcile.ParseSpec([]byte("dataSources:\n  - ' '\n"))
+	require.ErrorContains(test, err, "dataSources[0]")
+}
+
+func TestLoadSpec(test *testing.T) {
+	path := filepath.Join(test.TempDir(), "datasources.yaml")
+	require.NoError(test, os.WriteFile(path, []byte(specYAML), 0o600))
+
+	spec, err := szreconcile.LoadSpec(path)
+	require.NoError(test, err)
+	assert.Len(test, spec.DataSources, 2)
+
+	_, err = szreconcile.LoadSpec(filepath.Join(test.TempDir(), "missing.yaml"))
+	require.ErrorIs(test, err, os.ErrNotExist)
+}
+
+func TestNewPlan(test *testing.T) {
+	spec := &szreconcile.Spec{DataSources: []string{"CUSTOMERS", "WATCHLIST"}, Prune: false}
+	plan := szreconcile.NewPlan(spec, []string{"TEST", "SEARCH", "CUSTOMERS"})
+	assert.Equal(test, []string{"WATCHLIST"}, plan.Register)
+	assert.Empty(test, plan.Unregister)
+
+	spec.Prune = true
+	plan = szreconcile.NewPlan(spec, []string{"TEST", "SEARCH", "CUSTOMERS", "OLD"})
+	assert.Equal(test, []string{"OLD"}, plan.Unregister, "SEARCH and TEST are used by Senzing itself")
+	assert.Equal(test, "+ WATCHLIST\n- OLD\n", plan.String())
+
+	plan = szreconcile.NewPlan(spec, []string{"WATCHLIST", "CUSTOMERS"})
+	assert.True(test, plan.IsEmpty())
+	assert.Equal(test, "No changes to configuration 0.\n", plan.String())
+}
+
+func TestReconciler_Reconcile(test *testing.T) {
+	ctx := test.Context()
+	manager := newFakeConfigManager("TEST", "CUSTOMERS", "OLD")
+	spec, err := szreconcile.ParseSpec([]byte(specYAML))
+	require.NoError(test, err)
+
+	result, err := szreconcile.New(manager, szreconcile.Options{Comment: "release 42"}).Reconcile(ctx, spec)
+	require.NoError(test, err)
+	assert.Equal(test, 1, result.Attempts)
+	assert.Equal(test, int64(2), result.ConfigID)
+	assert.Equal(test, int64(2), manager.DefaultConfigID)
+	assert.Equal(test, "TEST,CUSTOMERS,WATCHLIST", manager.Definitions[2])
+	assert.Equal(test, "release 42 (szreconcile from 1: +WATCHLIST -OLD)", manager.Comments[2])
+
+	// Reconciling again finds nothing to do.
+	result, err = szreconcile.New(manager, szreconcile.Options{}).Reconcile(ctx, spec)
+	require.NoError(test, err)
+	assert.True(test, result.Plan.IsEmpty())
+	assert.Equal(test, int64(0), result.ConfigID)
+	assert.Len(test, manager.Definitions, 2)
+}
+
+func TestReconciler_Reconcile_dryRun(test *testing.T) {
+	ctx := test.Context()
+	manager := newFakeConfigManager("TEST")
+	spec := &szreconcile.Spec{DataSources: []string{"CUSTOMERS"}, Prune: false}
+
+	result, err := szreconcile.New(manager, szreconcile.Options{DryRun: true}).Reconcile(ctx, spec)
+	require.NoError(test, err)
+	assert.Equal(test, "+ CUSTOMERS\n", result.Plan.String())
+	assert.Equal(test, int64(1), result.Plan.BaseConfigID)
+	assert.Equal(test, int64(0), result.ConfigID)
+	assert.Equal(test, int64(1), manager.DefaultConfigID)
+	assert.Len(test, manager.Definitions, 1)
+}
+
+func TestReconciler_Reconcile_conflict(test *testing.T) {
+	ctx := test.Context()
+	manager := newFakeConfigManager("TEST")
+	manager.Conflicts = 1
+	spec := &szreconcile.Spec{DataSources: []string{"CUSTOMERS"}, Prune: false}
+
+	result, err := szreconcile.New(manager, szreconcile.Options{RetryDelay: time.Millisecond}).Reconcile(ctx, spec)
+	require.NoError(test, err)
+	assert.Equal(test, 2, result.Attempts)
+	assert.Equal(test, int64(3), result.Plan.BaseConfigID, "the plan is recomputed from the configuration that won the race")
+	assert.Equal(test, manager.DefaultConfigID, result.ConfigID)
+	assert.Equal(test, "TEST,OTHER,CUSTOMERS", manager.Definitions[result.ConfigID])
+	assert.Equal(test, []int64{2}, result.UnusedConfigIDs, "the configuration of the lost attempt")
+}
+
+func TestReconciler_Reconcile_tooManyConflicts(test *testing.T) {
+	ctx := test.Context()
+	manager := newFakeConfigManager("TEST")
+	manager.Conflicts = 10
+	spec := &szreconcile.Spec{DataSources: []string{"CUSTOMERS"}, Prune: false}
+
+	result, err := szreconcile.New(manager, szreconcile.Options{MaxAttempts: 3, RetryDelay: time.Millisecond}).
+		Reconcile(ctx, spec)
+	require.ErrorIs(test, err, szerror.ErrSzReplaceConflict)
+	assert.Equal(test, 3, result.Attempts)
+	assert.Equal(test, int64(0), result.ConfigID)
+	assert.Len(test, result.UnusedConfigIDs, 3)
+}
+
+func TestReconciler_Reconcile_canceledWhileWaiting(test *testing.T) {
+	ctx, cancel := context.WithTimeout(test.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	manager := newFakeConfigManager("TEST")
+	manager.Conflicts = 10
+	spec := &szreconcile.Spec{DataSources: []string{"CUSTOMERS"}, Prune: false}
+
+	result, err := szreconcile.New(manager, szreconcile.Options{RetryDelay: time.Hour}).Reconcile(ctx, spec)
+	require.ErrorIs(test, err, context.DeadlineExceeded)
+	assert.Equal(test, 1, result.Attempts)
+}
+
+func TestReconciler_Reconcile_szConfigManager(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := testhelper.NewSzConfigManager(test, "CUSTOMERS")
+	spec := &szreconcile.Spec{DataSources: []string{"CUSTOMERS", "WATCHLIST"}, Prune: false}
+
+	result, err := szreconcile.New(szConfigManager, szreconcile.Options{}).Reconcile(ctx, spec)
+	require.NoError(test, err)
+	assert.Equal(test, []string{"WATCHLIST"}, result.Plan.Register)
+
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, result.ConfigID, defaultConfigID)
+
+	// The base configuration is no longer the default, so a compare-and-swap from it fails.
+	err = szConfigManager.ReplaceDefaultConfigID(ctx, result.Plan.BaseConfigID, result.Plan.BaseConfigID)
+	require.ErrorIs(test, err, szerror.ErrSzReplaceConflict)
+
+	result, err = szreconcile.New(szConfigManager, szreconcile.Options{}).Reconcile(ctx, spec)
+	require.NoError(test, err)
+	assert.True(test, result.Plan.IsEmpty())
+}
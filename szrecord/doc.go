@@ -0,0 +1,17 @@
+/*
+Package szrecord builds record definitions in the Senzing Generic Entity Specification.
+
+A [Builder] has typed methods for names, addresses, phones, identifiers, dates
+and relationships, and [Builder.AddFeature] for other features.
+Each method may be called several times to add several instances of a feature,
+each with its own usage type, such as "HOME" or "WORK"; see the package example.
+
+The JSON document can be passed to AddRecord() or GetRecordPreview() of [senzing.SzEngine].
+[Builder.Build] first checks attribute names, usage types and the format of dates, email addresses,
+phone numbers and Social Security numbers, so that mistakes are reported without calling Senzing.
+Problems are reported as [*Error] values that match [szerror.ErrSzBadInput].
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+[szerror.ErrSzBadInput]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+*/
+package szrecord
@@ -0,0 +1,147 @@
+package szrecord
+
+import (
+	"regexp"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	attributeDataSource = "DATA_SOURCE"
+	attributeFeatures   = "FEATURES"
+	attributeRecordID   = "RECORD_ID"
+	attributeRecordType = "RECORD_TYPE"
+)
+
+const (
+	maxPhoneDigits = 15
+	minPhoneDigits = 7
+	ssnDigits      = 9
+	ssnLastDigits  = 4
+)
+
+const (
+	problemBadAttributeName = "is not a valid attribute name"
+	problemBadDate          = "%q is not a recognized date; use YYYY-MM-DD, YYYYMMDD, YYYY-MM, YYYY or MM/DD/YYYY"
+	problemBadEmail         = "%q is not a valid email address"
+	problemBadPhone         = "%q is not a valid phone number"
+	problemBadSSN           = "%q is not a valid Social Security number; 9 digits or the last 4 digits are expected"
+	problemBadUsageType     = "%q is not a valid usage type; use letters, digits, '_' or '-'"
+	problemEmpty            = "is empty"
+	problemInvalidUTF8      = "is not valid UTF-8"
+	problemMixedName        = "mixes an organization name with parts of a person's name"
+	problemNulByte          = "contains a NUL byte"
+	problemReserved         = "is set by the Builder and cannot be used as a payload attribute"
+	problemReservedFeature  = "is set by the Builder and cannot be used as a feature attribute"
+	problemUnknownAttribute = "is not an attribute of the Generic Entity Specification"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Layouts accepted for dates, most specific first.
+var dateLayouts = []string{
+	"2006-01-02",
+	"20060102",
+	"2006-01",
+	"2006",
+	"01/02/2006",
+	"1/2/2006",
+}
+
+// Attributes of the Generic Entity Specification that make up features. Payload attributes must not use them.
+var featureAttributes = map[string]bool{
+	"ACCOUNT_DOMAIN":         true,
+	"ACCOUNT_NUMBER":         true,
+	"ADDR_CITY":              true,
+	"ADDR_COUNTRY":           true,
+	"ADDR_FULL":              true,
+	"ADDR_LINE1":             true,
+	"ADDR_LINE2":             true,
+	"ADDR_LINE3":             true,
+	"ADDR_LINE4":             true,
+	"ADDR_LINE5":             true,
+	"ADDR_LINE6":             true,
+	"ADDR_POSTAL_CODE":       true,
+	"ADDR_STATE":             true,
+	"ADDR_TYPE":              true,
+	"CITIZENSHIP":            true,
+	"DATE_OF_BIRTH":          true,
+	"DATE_OF_DEATH":          true,
+	"DRIVERS_LICENSE_NUMBER": true,
+	"DRIVERS_LICENSE_STATE":  true,
+	"DUNS_NUMBER":            true,
+	"EMAIL_ADDRESS":          true,
+	"GENDER":                 true,
+	"LEI_NUMBER":             true,
+	"NAME_FIRST":             true,
+	"NAME_FULL":              true,
+	"NAME_LAST":              true,
+	"NAME_MIDDLE":            true,
+	"NAME_ORG":               true,
+	"NAME_PREFIX":            true,
+	"NAME_SUFFIX":            true,
+	"NAME_TYPE":              true,
+	"NATIONAL_ID_COUNTRY":    true,
+	"NATIONAL_ID_NUMBER":     true,
+	"NATIONAL_ID_TYPE":       true,
+	"NATIONALITY":            true,
+	"NPI_NUMBER":             true,
+	"OTHER_ID_COUNTRY":       true,
+	"OTHER_ID_NUMBER":        true,
+	"OTHER_ID_TYPE":          true,
+	"PASSPORT_COUNTRY":       true,
+	"PASSPORT_NUMBER":        true,
+	"PHONE_NUMBER":           true,
+	"PHONE_TYPE":             true,
+	"PLACE_OF_BIRTH":         true,
+	"REGISTRATION_COUNTRY":   true,
+	"REGISTRATION_DATE":      true,
+	"REL_ANCHOR_DOMAIN":      true,
+	"REL_ANCHOR_KEY":         true,
+	"REL_POINTER_DOMAIN":     true,
+	"REL_POINTER_KEY":        true,
+	"REL_POINTER_ROLE":       true,
+	"SSN_NUMBER":             true,
+	"TAX_ID_COUNTRY":         true,
+	"TAX_ID_NUMBER":          true,
+	"TAX_ID_TYPE":            true,
+	"TRUSTED_ID_NUMBER":      true,
+	"TRUSTED_ID_TYPE":        true,
+	"WEBSITE_ADDRESS":        true,
+}
+
+// Payload attributes starting with these prefixes are most likely misspelled feature attributes.
+var featurePrefixes = []string{
+	"ADDR_",
+	"DATE_OF_",
+	"DRIVERS_LICENSE_",
+	"EMAIL_",
+	"NAME_",
+	"NATIONAL_ID_",
+	"PASSPORT_",
+	"PHONE_",
+	"REL_ANCHOR_",
+	"REL_POINTER_",
+	"SSN_",
+	"TAX_ID_",
+}
+
+// Attributes written by the Builder itself.
+var reservedAttributes = map[string]bool{
+	attributeDataSource: true,
+	attributeFeatures:   true,
+	attributeRecordID:   true,
+	attributeRecordType: true,
+}
+
+var (
+	attributeNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+	phoneExtensionSuffix = regexp.MustCompile(`(?i)\s*(x|ext\.?|extension)\s*[0-9]+$`)
+	phonePattern         = regexp.MustCompile(`^\+?[0-9 ().\-/]+$`)
+	ssnPattern           = regexp.MustCompile(`^[0-9][0-9 \-]*[0-9]$`)
+	usageTypePattern     = regexp.MustCompile(`^[A-Za-z0-9_\-]+$`)
+)
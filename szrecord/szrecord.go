@@ -0,0 +1,601 @@
+package szrecord
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+/*
+Type Builder struct assembles a record definition.
+Methods return the Builder so calls can be chained;
+problems found along the way are reported by Build() and Validate().
+*/
+type Builder struct {
+	dataSourceCode string
+	features       []map[string]string
+	payload        map[string]string
+	problems       []error
+	recordID       string
+	recordType     string
+}
+
+/*
+Type Address struct is an address feature.
+Use either Full or the individual parts.
+*/
+type Address struct {
+	City       string // ADDR_CITY
+	Country    string // ADDR_COUNTRY
+	Full       string // ADDR_FULL
+	Line1      string // ADDR_LINE1
+	Line2      string // ADDR_LINE2
+	Line3      string // ADDR_LINE3
+	PostalCode string // ADDR_POSTAL_CODE
+	State      string // ADDR_STATE
+	Type       string // ADDR_TYPE, the usage type. Example: "HOME".
+}
+
+/*
+Type Name struct is a name feature.
+Use Org for organizations, otherwise Full or the individual parts of a person's name.
+*/
+type Name struct {
+	First  string // NAME_FIRST
+	Full   string // NAME_FULL
+	Last   string // NAME_LAST
+	Middle string // NAME_MIDDLE
+	Org    string // NAME_ORG
+	Prefix string // NAME_PREFIX
+	Suffix string // NAME_SUFFIX
+	Type   string // NAME_TYPE, the usage type. Example: "PRIMARY".
+}
+
+/*
+Type Phone struct is a phone feature.
+*/
+type Phone struct {
+	Number string // PHONE_NUMBER
+	Type   string // PHONE_TYPE, the usage type. Example: "MOBILE".
+}
+
+/*
+Type Error struct describes a problem with a record definition found before calling Senzing.
+
+[errors.Is] reports true for [szerror.ErrSzBadInput] and [szerror.ErrSz].
+
+[szerror.ErrSz]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+[szerror.ErrSzBadInput]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+*/
+type Error struct {
+	Attribute string // Name of the attribute. Example: "DATE_OF_BIRTH".
+	Problem   string // What is wrong with it.
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The New function returns an empty Builder.
+
+Input
+  - dataSourceCode: Written as DATA_SOURCE. May be empty, as for GetRecordPreview().
+  - recordID: Written as RECORD_ID. May be empty, as for GetRecordPreview().
+
+Output
+  - A Builder.
+*/
+func New(dataSourceCode string, recordID string) *Builder {
+	result := &Builder{
+		dataSourceCode: dataSourceCode,
+		features:       []map[string]string{},
+		payload:        map[string]string{},
+		problems:       []error{},
+		recordID:       recordID,
+		recordType:     "",
+	}
+	result.checkText(attributeDataSource, dataSourceCode)
+	result.checkText(attributeRecordID, recordID)
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Feature methods
+// ----------------------------------------------------------------------------
+
+/*
+Method AddAddress adds an address feature.
+*/
+func (builder *Builder) AddAddress(address Address) *Builder {
+	builder.addFeature("ADDR", map[string]string{
+		"ADDR_CITY":        address.City,
+		"ADDR_COUNTRY":     address.Country,
+		"ADDR_FULL":        address.Full,
+		"ADDR_LINE1":       address.Line1,
+		"ADDR_LINE2":       address.Line2,
+		"ADDR_LINE3":       address.Line3,
+		"ADDR_POSTAL_CODE": address.PostalCode,
+		"ADDR_STATE":       address.State,
+	}, "ADDR_TYPE", address.Type)
+
+	return builder
+}
+
+/*
+Method AddDateOfBirth adds a DATE_OF_BIRTH feature.
+The date may be YYYY-MM-DD, YYYYMMDD, YYYY-MM, YYYY or MM/DD/YYYY.
+*/
+func (builder *Builder) AddDateOfBirth(date string) *Builder {
+	return builder.addDate("DATE_OF_BIRTH", date)
+}
+
+/*
+Method AddDateOfDeath adds a DATE_OF_DEATH feature.
+The date may be YYYY-MM-DD, YYYYMMDD, YYYY-MM, YYYY or MM/DD/YYYY.
+*/
+func (builder *Builder) AddDateOfDeath(date string) *Builder {
+	return builder.addDate("DATE_OF_DEATH", date)
+}
+
+/*
+Method AddDriversLicense adds a DRIVERS_LICENSE_NUMBER feature.
+*/
+func (builder *Builder) AddDriversLicense(number string, state string) *Builder {
+	return builder.addIdentifier("DRIVERS_LICENSE_NUMBER", number, "DRIVERS_LICENSE_STATE", state)
+}
+
+/*
+Method AddEmail adds an EMAIL_ADDRESS feature.
+*/
+func (builder *Builder) AddEmail(address string) *Builder {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil || parsed.Name != "" || parsed.Address != address {
+		builder.problem("EMAIL_ADDRESS", fmt.Sprintf(problemBadEmail, address))
+
+		return builder
+	}
+
+	builder.addFeature("EMAIL_ADDRESS", map[string]string{"EMAIL_ADDRESS": address}, "", "")
+
+	return builder
+}
+
+/*
+Method AddFeature adds a feature given as attributes, for features without a typed method.
+Example: {"WEBSITE_ADDRESS": "senzing.com"}.
+Attributes need not be in the Generic Entity Specification,
+as a configuration may define more, e.g. with the szconfigdoc package.
+Names that are not valid attribute names, or that the Builder sets itself, are reported as problems.
+*/
+func (builder *Builder) AddFeature(attributes map[string]string) *Builder {
+	feature := map[string]string{}
+
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		switch {
+		case !attributeNamePattern.MatchString(name):
+			builder.problem(name, problemBadAttributeName)
+
+			return builder
+		case reservedAttributes[strings.ToUpper(name)]:
+			builder.problem(name, problemReservedFeature)
+
+			return builder
+		}
+
+		feature[name] = attributes[name]
+	}
+
+	builder.addFeature("FEATURES", feature, "", "")
+
+	return builder
+}
+
+/*
+Method AddName adds a name feature.
+*/
+func (builder *Builder) AddName(name Name) *Builder {
+	if name.Org != "" && (name.First != "" || name.Last != "" || name.Middle != "" || name.Prefix != "" || name.Suffix != "") {
+		builder.problem("NAME_ORG", problemMixedName)
+
+		return builder
+	}
+
+	builder.addFeature("NAME", map[string]string{
+		"NAME_FIRST":  name.First,
+		"NAME_FULL":   name.Full,
+		"NAME_LAST":   name.Last,
+		"NAME_MIDDLE": name.Middle,
+		"NAME_ORG":    name.Org,
+		"NAME_PREFIX": name.Prefix,
+		"NAME_SUFFIX": name.Suffix,
+	}, "NAME_TYPE", name.Type)
+
+	return builder
+}
+
+/*
+Method AddNationalID adds a NATIONAL_ID_NUMBER feature.
+*/
+func (builder *Builder) AddNationalID(number string, country string) *Builder {
+	return builder.addIdentifier("NATIONAL_ID_NUMBER", number, "NATIONAL_ID_COUNTRY", country)
+}
+
+/*
+Method AddPassport adds a PASSPORT_NUMBER feature.
+*/
+func (builder *Builder) AddPassport(number string, country string) *Builder {
+	return builder.addIdentifier("PASSPORT_NUMBER", number, "PASSPORT_COUNTRY", country)
+}
+
+/*
+Method AddPhone adds a phone feature.
+The number may contain a leading '+', spaces, '(', ')', '.', '-', '/' and an extension such as "x123".
+*/
+func (builder *Builder) AddPhone(phone Phone) *Builder {
+	if !isPhoneNumber(phone.Number) {
+		builder.problem("PHONE_NUMBER", fmt.Sprintf(problemBadPhone, phone.Number))
+
+		return builder
+	}
+
+	builder.addFeature("PHONE_NUMBER", map[string]string{"PHONE_NUMBER": phone.Number}, "PHONE_TYPE", phone.Type)
+
+	return builder
+}
+
+/*
+Method AddRegistrationDate adds a REGISTRATION_DATE feature, the date an organization was registered.
+The date may be YYYY-MM-DD, YYYYMMDD, YYYY-MM, YYYY or MM/DD/YYYY.
+*/
+func (builder *Builder) AddRegistrationDate(date string) *Builder {
+	return builder.addDate("REGISTRATION_DATE", date)
+}
+
+/*
+Method AddRelationshipAnchor adds a REL_ANCHOR feature that other records can point to.
+
+Input
+  - domain: The relationship domain. Example: "CUSTOMERS".
+  - key: The key of this record in the domain, usually its record ID.
+*/
+func (builder *Builder) AddRelationshipAnchor(domain string, key string) *Builder {
+	builder.addRelationship(map[string]string{
+		"REL_ANCHOR_DOMAIN": domain,
+		"REL_ANCHOR_KEY":    key,
+	})
+
+	return builder
+}
+
+/*
+Method AddRelationshipPointer adds a REL_POINTER feature that points to the anchor of another record.
+
+Input
+  - domain: The relationship domain of the anchor.
+  - key: The key of the anchor.
+  - role: The role of the other record. Example: "SPOUSE". May be empty.
+*/
+func (builder *Builder) AddRelationshipPointer(domain string, key string, role string) *Builder {
+	builder.addRelationship(map[string]string{
+		"REL_POINTER_DOMAIN": domain,
+		"REL_POINTER_KEY":    key,
+		"REL_POINTER_ROLE":   role,
+	})
+
+	return builder
+}
+
+/*
+Method AddSSN adds an SSN_NUMBER feature.
+The number must have 9 digits, or 4 digits when only the last digits are known.
+*/
+func (builder *Builder) AddSSN(number string) *Builder {
+	if !isSSN(number) {
+		builder.problem("SSN_NUMBER", fmt.Sprintf(problemBadSSN, number))
+
+		return builder
+	}
+
+	builder.addFeature("SSN_NUMBER", map[string]string{"SSN_NUMBER": number}, "", "")
+
+	return builder
+}
+
+/*
+Method AddTaxID adds a TAX_ID_NUMBER feature.
+*/
+func (builder *Builder) AddTaxID(number string, country string) *Builder {
+	return builder.addIdentifier("TAX_ID_NUMBER", number, "TAX_ID_COUNTRY", country)
+}
+
+// ----------------------------------------------------------------------------
+// Record methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Build validates the record definition and returns it as JSON.
+
+Output
+  - A JSON document for AddRecord() or GetRecordPreview().
+    Features are listed in the order they were added, under "FEATURES".
+  - An error joining an *Error for each problem found.
+*/
+func (builder *Builder) Build() (string, error) {
+	err := builder.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	document := map[string]any{}
+	for name, value := range builder.payload {
+		document[name] = value
+	}
+
+	if builder.dataSourceCode != "" {
+		document[attributeDataSource] = builder.dataSourceCode
+	}
+
+	if builder.recordID != "" {
+		document[attributeRecordID] = builder.recordID
+	}
+
+	if builder.recordType != "" {
+		document[attributeRecordType] = builder.recordType
+	}
+
+	if len(builder.features) > 0 {
+		document[attributeFeatures] = builder.features
+	}
+
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	err = encoder.Encode(document)
+	if err != nil {
+		return "", fmt.Errorf("encoding record definition: %w", err)
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+/*
+Method SetPayload sets an attribute that is stored with the record but not used for resolution.
+Names that look like misspelled feature attributes, such as "NAME_FRIST", are reported as problems.
+*/
+func (builder *Builder) SetPayload(name string, value string) *Builder {
+	switch {
+	case !attributeNamePattern.MatchString(name):
+		builder.problem(name, problemBadAttributeName)
+	case reservedAttributes[strings.ToUpper(name)]:
+		builder.problem(name, problemReserved)
+	case featureAttributes[strings.ToUpper(name)] || hasFeaturePrefix(strings.ToUpper(name)):
+		builder.problem(name, problemUnknownAttribute+"; use a feature method")
+	default:
+		if builder.checkText(name, value) {
+			builder.payload[name] = value
+		}
+	}
+
+	return builder
+}
+
+/*
+Method SetRecordType sets RECORD_TYPE. Example: "PERSON" or "ORGANIZATION".
+*/
+func (builder *Builder) SetRecordType(recordType string) *Builder {
+	if builder.checkUsageType(attributeRecordType, recordType) {
+		builder.recordType = recordType
+	}
+
+	return builder
+}
+
+/*
+Method Validate reports the problems found so far.
+
+Output
+  - nil if there are none, otherwise an error joining an *Error for each problem.
+*/
+func (builder *Builder) Validate() error {
+	return errors.Join(builder.problems...)
+}
+
+// ----------------------------------------------------------------------------
+// Error methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Error returns a description of the problem.
+*/
+func (recordError *Error) Error() string {
+	return recordError.Attribute + " " + recordError.Problem
+}
+
+/*
+Method Is reports true for [szerror.ErrSzBadInput] and [szerror.ErrSz] so that [errors.Is] identifies invalid records.
+
+[szerror.ErrSz]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+[szerror.ErrSzBadInput]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+*/
+func (recordError *Error) Is(target error) bool {
+	return target == szerror.ErrSzBadInput || target == szerror.ErrSz //nolint:errorlint
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (builder *Builder) addDate(attribute string, date string) *Builder {
+	if !isDate(date) {
+		builder.problem(attribute, fmt.Sprintf(problemBadDate, date))
+
+		return builder
+	}
+
+	builder.addFeature(attribute, map[string]string{attribute: date}, "", "")
+
+	return builder
+}
+
+// The number is required; the issuing country or state is optional.
+func (builder *Builder) addIdentifier(numberAttribute string, number string, issuerAttribute string, issuer string) *Builder {
+	if number == "" {
+		builder.problem(numberAttribute, problemEmpty)
+
+		return builder
+	}
+
+	builder.addFeature(numberAttribute, map[string]string{
+		numberAttribute: number,
+		issuerAttribute: issuer,
+	}, "", "")
+
+	return builder
+}
+
+// Empty attributes are dropped; a feature left without attributes is a problem.
+func (builder *Builder) addFeature(feature string, attributes map[string]string, usageAttribute string, usageType string) {
+	result := map[string]string{}
+
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		value := attributes[name]
+		if value == "" {
+			continue
+		}
+
+		if !builder.checkText(name, value) {
+			return
+		}
+
+		result[name] = value
+	}
+
+	if len(result) == 0 {
+		builder.problem(feature, problemEmpty)
+
+		return
+	}
+
+	if usageType != "" {
+		if !builder.checkUsageType(usageAttribute, usageType) {
+			return
+		}
+
+		result[usageAttribute] = usageType
+	}
+
+	builder.features = append(builder.features, result)
+}
+
+// Both the domain and the key are required.
+func (builder *Builder) addRelationship(attributes map[string]string) {
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		if attributes[name] == "" && !strings.HasSuffix(name, "_ROLE") {
+			builder.problem(name, problemEmpty)
+
+			return
+		}
+	}
+
+	builder.addFeature("REL", attributes, "", "")
+}
+
+func (builder *Builder) checkText(attribute string, value string) bool {
+	switch {
+	case strings.IndexByte(value, 0) >= 0:
+		builder.problem(attribute, problemNulByte)
+	case !utf8.ValidString(value):
+		builder.problem(attribute, problemInvalidUTF8)
+	default:
+		return true
+	}
+
+	return false
+}
+
+func (builder *Builder) checkUsageType(attribute string, usageType string) bool {
+	if usageTypePattern.MatchString(usageType) {
+		return true
+	}
+
+	builder.problem(attribute, fmt.Sprintf(problemBadUsageType, usageType))
+
+	return false
+}
+
+func (builder *Builder) problem(attribute string, problem string) {
+	builder.problems = append(builder.problems, &Error{
+		Attribute: attribute,
+		Problem:   problem,
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func countDigits(value string) int {
+	result := 0
+
+	for _, character := range value {
+		if character >= '0' && character <= '9' {
+			result++
+		}
+	}
+
+	return result
+}
+
+func hasFeaturePrefix(name string) bool {
+	for _, prefix := range featurePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isDate(date string) bool {
+	for _, layout := range dateLayouts {
+		if _, err := time.Parse(layout, date); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isPhoneNumber(number string) bool {
+	number = phoneExtensionSuffix.ReplaceAllString(number, "")
+	if !phonePattern.MatchString(number) {
+		return false
+	}
+
+	digits := countDigits(number)
+
+	return digits >= minPhoneDigits && digits <= maxPhoneDigits
+}
+
+func isSSN(number string) bool {
+	if !ssnPattern.MatchString(number) {
+		return false
+	}
+
+	digits := countDigits(number)
+
+	return digits == ssnDigits || digits == ssnLastDigits
+}
@@ -0,0 +1,53 @@
+package szrecord_test
+
+import (
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szrecord"
+)
+
+// ----------------------------------------------------------------------------
+// Examples for godoc documentation
+// ----------------------------------------------------------------------------
+
+func Example() {
+	recordDefinition, err := szrecord.New("CUSTOMERS", "1001").
+		AddName(szrecord.Name{First: "Robert", Last: "Smith", Type: "PRIMARY"}).
+		AddAddress(szrecord.Address{Line1: "123 Main Street", City: "Las Vegas", State: "NV", PostalCode: "89132", Type: "HOME"}).
+		AddPhone(szrecord.Phone{Number: "702-919-1300", Type: "MOBILE"}).
+		AddDateOfBirth("1985-02-12").
+		Build()
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(recordDefinition)
+	// Output: {"DATA_SOURCE":"CUSTOMERS","FEATURES":[{"NAME_FIRST":"Robert","NAME_LAST":"Smith","NAME_TYPE":"PRIMARY"},{"ADDR_CITY":"Las Vegas","ADDR_LINE1":"123 Main Street","ADDR_POSTAL_CODE":"89132","ADDR_STATE":"NV","ADDR_TYPE":"HOME"},{"PHONE_NUMBER":"702-919-1300","PHONE_TYPE":"MOBILE"},{"DATE_OF_BIRTH":"1985-02-12"}],"RECORD_ID":"1001"}
+}
+
+func ExampleBuilder_Build() {
+	recordDefinition, err := szrecord.New("CUSTOMERS", "1001").
+		SetRecordType("PERSON").
+		AddName(szrecord.Name{First: "Robert", Last: "Smith", Type: "PRIMARY"}).
+		AddPhone(szrecord.Phone{Number: "702-919-1300", Type: "HOME"}).
+		AddDateOfBirth("1978-12-11").
+		Build()
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(recordDefinition)
+	// Output: {"DATA_SOURCE":"CUSTOMERS","FEATURES":[{"NAME_FIRST":"Robert","NAME_LAST":"Smith","NAME_TYPE":"PRIMARY"},{"PHONE_NUMBER":"702-919-1300","PHONE_TYPE":"HOME"},{"DATE_OF_BIRTH":"1978-12-11"}],"RECORD_ID":"1001","RECORD_TYPE":"PERSON"}
+}
+
+func ExampleBuilder_Validate() {
+	err := szrecord.New("CUSTOMERS", "1001").
+		AddDateOfBirth("12/31/78").
+		Validate()
+	fmt.Println(err)
+	// Output: DATE_OF_BIRTH "12/31/78" is not a recognized date; use YYYY-MM-DD, YYYYMMDD, YYYY-MM, YYYY or MM/DD/YYYY
+}
@@ -0,0 +1,182 @@
+package szrecord_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szrecord"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestBuilder_Build(test *testing.T) {
+	recordDefinition, err := szrecord.New("CUSTOMERS", "1001").
+		SetRecordType("PERSON").
+		AddName(szrecord.Name{First: "Robert", Last: "Smith", Type: "PRIMARY"}).
+		AddName(szrecord.Name{Full: "Bob Smith", Type: "AKA"}).
+		AddAddress(szrecord.Address{Line1: "123 Main Street", City: "Las Vegas", State: "NV", PostalCode: "89132", Type: "HOME"}).
+		AddPhone(szrecord.Phone{Number: "702-919-1300", Type: "MOBILE"}).
+		AddEmail("bsmith@work.com").
+		AddDateOfBirth("1978-12-11").
+		AddSSN("123-45-6789").
+		AddPassport("PP123456", "US").
+		AddDriversLicense("112233", "NV").
+		AddRelationshipAnchor("CUSTOMERS", "1001").
+		AddRelationshipPointer("CUSTOMERS", "1002", "SPOUSE").
+		AddFeature(map[string]string{"WEBSITE_ADDRESS": "example.com"}).
+		AddFeature(map[string]string{"LOYALTY_NUMBER": "L-1001"}).
+		SetPayload("STATUS", "Active").
+		Build()
+	require.NoError(test, err)
+
+	var document map[string]any
+
+	require.NoError(test, json.Unmarshal([]byte(recordDefinition), &document))
+	assert.Equal(test, "CUSTOMERS", document["DATA_SOURCE"])
+	assert.Equal(test, "1001", document["RECORD_ID"])
+	assert.Equal(test, "PERSON", document["RECORD_TYPE"])
+	assert.Equal(test, "Active", document["STATUS"])
+
+	features, isList := document["FEATURES"].([]any)
+	require.True(test, isList)
+	require.Len(test, features, 13)
+	assert.Equal(test, map[string]any{"NAME_FIRST": "Robert", "NAME_LAST": "Smith", "NAME_TYPE": "PRIMARY"}, features[0])
+	assert.Equal(test, map[string]any{"NAME_FULL": "Bob Smith", "NAME_TYPE": "AKA"}, features[1])
+	assert.Equal(test, map[string]any{"PHONE_NUMBER": "702-919-1300", "PHONE_TYPE": "MOBILE"}, features[3])
+	assert.Equal(test, map[string]any{"REL_POINTER_DOMAIN": "CUSTOMERS", "REL_POINTER_KEY": "1002", "REL_POINTER_ROLE": "SPOUSE"}, features[10])
+	assert.Equal(test, map[string]any{"LOYALTY_NUMBER": "L-1001"}, features[12], "attributes defined by the configuration")
+}
+
+func TestBuilder_Build_forPreview(test *testing.T) {
+	recordDefinition, err := szrecord.New("", "").
+		AddName(szrecord.Name{Org: "Acme & Sons", Type: "PRIMARY"}).
+		AddRegistrationDate("1999").
+		Build()
+	require.NoError(test, err)
+	assert.JSONEq(test, `{"FEATURES": [{"NAME_ORG": "Acme & Sons", "NAME_TYPE": "PRIMARY"}, {"REGISTRATION_DATE": "1999"}]}`, recordDefinition)
+	assert.Contains(test, recordDefinition, "&", "HTML characters should not be escaped")
+}
+
+func TestBuilder_Build_problems(test *testing.T) {
+	testCases := []struct {
+		name     string
+		builder  *szrecord.Builder
+		expected string
+	}{
+		{
+			name:     "badDate",
+			builder:  szrecord.New("CUSTOMERS", "1001").AddDateOfBirth("12/31/78"),
+			expected: `DATE_OF_BIRTH "12/31/78" is not a recognized date; use YYYY-MM-DD, YYYYMMDD, YYYY-MM, YYYY or MM/DD/YYYY`,
+		},
+		{
+			name:     "badEmail",
+			builder:  szrecord.New("CUSTOMERS", "1001").AddEmail("Bob <bsmith@work.com>"),
+			expected: `EMAIL_ADDRESS "Bob <bsmith@work.com>" is not a valid email address`,
+		},
+		{
+			name:     "badPhone",
+			builder:  szrecord.New("CUSTOMERS", "1001").AddPhone(szrecord.Phone{Number: "call me", Type: "HOME"}),
+			expected: `PHONE_NUMBER "call me" is not a valid phone number`,
+		},
+		{
+			name:     "badSSN",
+			builder:  szrecord.New("CUSTOMERS", "1001").AddSSN("12-345"),
+			expected: `SSN_NUMBER "12-345" is not a valid Social Security number; 9 digits or the last 4 digits are expected`,
+		},
+		{
+			name:     "badUsageType",
+			builder:  szrecord.New("CUSTOMERS", "1001").AddName(szrecord.Name{Full: "Bob Smith", Type: "HOME OFFICE"}),
+			expected: `NAME_TYPE "HOME OFFICE" is not a valid usage type; use letters, digits, '_' or '-'`,
+		},
+		{
+			name:     "emptyAddress",
+			builder:  szrecord.New("CUSTOMERS", "1001").AddAddress(szrecord.Address{Type: "HOME"}),
+			expected: "ADDR is empty",
+		},
+		{
+			name:     "emptyPassportNumber",
+			builder:  szrecord.New("CUSTOMERS", "1001").AddPassport("", "US"),
+			expected: "PASSPORT_NUMBER is empty",
+		},
+		{
+			name:     "emptyRelationshipKey",
+			builder:  szrecord.New("CUSTOMERS", "1001").AddRelationshipPointer("CUSTOMERS", "", "SPOUSE"),
+			expected: "REL_POINTER_KEY is empty",
+		},
+		{
+			name:     "mixedName",
+			builder:  szrecord.New("CUSTOMERS", "1001").AddName(szrecord.Name{Org: "Acme", Last: "Smith"}),
+			expected: "NAME_ORG mixes an organization name with parts of a person's name",
+		},
+		{
+			name:     "misspelledPayload",
+			builder:  szrecord.New("CUSTOMERS", "1001").SetPayload("NAME_FRIST", "Bob"),
+			expected: "NAME_FRIST is not an attribute of the Generic Entity Specification; use a feature method",
+		},
+		{
+			name:     "nulByte",
+			builder:  szrecord.New("CUSTOMERS", "10\x0001"),
+			expected: "RECORD_ID contains a NUL byte",
+		},
+		{
+			name:     "reservedPayload",
+			builder:  szrecord.New("CUSTOMERS", "1001").SetPayload("RECORD_ID", "1002"),
+			expected: "RECORD_ID is set by the Builder and cannot be used as a payload attribute",
+		},
+		{
+			name:     "badFeatureAttribute",
+			builder:  szrecord.New("CUSTOMERS", "1001").AddFeature(map[string]string{"WEB SITE": "example.com"}),
+			expected: "WEB SITE is not a valid attribute name",
+		},
+		{
+			name:     "reservedFeatureAttribute",
+			builder:  szrecord.New("CUSTOMERS", "1001").AddFeature(map[string]string{"DATA_SOURCE": "WATCHLIST"}),
+			expected: "DATA_SOURCE is set by the Builder and cannot be used as a feature attribute",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			recordDefinition, err := testCase.builder.Build()
+			require.ErrorIs(test, err, szerror.ErrSzBadInput)
+			assert.Empty(test, recordDefinition)
+			assert.Equal(test, testCase.expected, err.Error())
+
+			var recordError *szrecord.Error
+			require.ErrorAs(test, err, &recordError)
+		})
+	}
+}
+
+func TestBuilder_Validate(test *testing.T) {
+	builder := szrecord.New("CUSTOMERS", "1001").
+		AddName(szrecord.Name{Full: "Bob Smith"}).
+		AddDateOfBirth("yesterday").
+		AddPhone(szrecord.Phone{Number: "12", Type: "HOME"})
+
+	err := builder.Validate()
+	require.ErrorIs(test, err, szerror.ErrSz)
+	assert.Contains(test, err.Error(), "DATE_OF_BIRTH")
+	assert.Contains(test, err.Error(), "PHONE_NUMBER")
+
+	require.NoError(test, szrecord.New("CUSTOMERS", "1001").Validate())
+}
+
+func TestBuilder_formats(test *testing.T) {
+	for _, date := range []string{"1978-12-11", "19781211", "1978-12", "1978", "12/11/1978", "2/3/1978"} {
+		require.NoError(test, szrecord.New("", "").AddDateOfBirth(date).Validate(), date)
+	}
+
+	for _, number := range []string{"702-919-1300", "+1 (702) 919-1300", "702.919.1300 x123", "+44 20 7946 0958 ext. 7"} {
+		require.NoError(test, szrecord.New("", "").AddPhone(szrecord.Phone{Number: number}).Validate(), number)
+	}
+
+	for _, number := range []string{"123-45-6789", "123456789", "6789"} {
+		require.NoError(test, szrecord.New("", "").AddSSN(number).Validate(), number)
+	}
+}
@@ -0,0 +1,21 @@
+/*
+Package szscreen screens lists of names and identifiers against the resolved entities in the repository,
+without loading them.
+
+Each query is a CSV row or a JSON Lines object whose columns or keys are attributes of the
+Senzing Generic Entity Specification, such as NAME_FULL, DATE_OF_BIRTH, ADDR_FULL, PHONE_NUMBER,
+EMAIL_ADDRESS, SSN_NUMBER or PASSPORT_NUMBER.
+An optional QUERY_ID identifies the query in the results; otherwise its line number is used:
+
+	QUERY_ID,NAME_FULL,DATE_OF_BIRTH
+	q1,Robert Smith,1978-12-11
+
+A [Screener] runs the queries concurrently with SearchByAttributes() of [senzing.SzEngine],
+keeps the matches allowed by its [Config] thresholds and, optionally, explains each hit with WhySearch().
+Results are written in input order, as one CSV row per hit or one JSON Lines object per query.
+Queries that fail are reported with the status ERROR and do not stop the run.
+[Screener.Run] returns a [Summary] of the run.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+package szscreen
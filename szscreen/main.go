@@ -0,0 +1,153 @@
+package szscreen
+
+import (
+	"github.com/senzing-garage/sz-sdk-go-core/szsearch"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Format int selects how queries are read and results are written.
+*/
+type Format int
+
+/*
+Type Status string is the outcome of a query.
+*/
+type Status string
+
+// A query read from the input.
+type request struct {
+	fields  map[string]string
+	index   int
+	line    int
+	problem error
+	queryID string
+}
+
+// The outcome of a query, as written to JSON Lines.
+type outcome struct {
+	Error   string `json:"ERROR,omitempty"`
+	Hits    []hit  `json:"HITS"`
+	Line    int    `json:"LINE"`
+	QueryID string `json:"QUERY_ID"`
+	Status  Status `json:"STATUS"`
+	index   int
+}
+
+type hit struct {
+	BestName      string                    `json:"BEST_NAME"`
+	EntityID      int64                     `json:"ENTITY_ID"`
+	ERRuleCode    string                    `json:"ERRULE_CODE"`
+	Explanation   string                    `json:"EXPLANATION,omitempty"`
+	FeatureScores map[string][]featureScore `json:"FEATURE_SCORES"`
+	MatchKey      string                    `json:"MATCH_KEY"`
+	MatchLevel    string                    `json:"MATCH_LEVEL"`
+	Score         int                       `json:"SCORE"`
+	WhyKey        string                    `json:"WHY_KEY,omitempty"`
+}
+
+type featureScore struct {
+	CandidateFeature string `json:"CANDIDATE_FEAT"`
+	InboundFeature   string `json:"INBOUND_FEAT"`
+	Score            int    `json:"SCORE"`
+	ScoreBucket      string `json:"SCORE_BUCKET,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+The Format* constants are the supported formats.
+
+  - FormatCSV: Comma-separated values with a header row.
+  - FormatJSONL: JSON Lines, one JSON object per line.
+*/
+const (
+	FormatCSV Format = iota
+	FormatJSONL
+)
+
+/*
+The Status* constants are the outcomes of a query.
+
+  - StatusError: The query could not be screened.
+  - StatusHit: At least one entity matched.
+  - StatusNoHit: No entity matched.
+*/
+const (
+	StatusError Status = "ERROR"
+	StatusHit   Status = "HIT"
+	StatusNoHit Status = "NO_HIT"
+)
+
+const (
+	columnQueryID  = "QUERY_ID"
+	maxLineBytes   = 64 << 20
+	reorderWindow  = 4 // Queries read but not yet written, per unit of Concurrency.
+	startLineBytes = 64 << 10
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var csvHeader = []string{
+	"QUERY_ID",
+	"LINE",
+	"STATUS",
+	"ENTITY_ID",
+	"BEST_NAME",
+	"MATCH_LEVEL",
+	"MATCH_KEY",
+	"SCORE",
+	"ERRULE_CODE",
+	"WHY_KEY",
+	"EXPLANATION",
+	"ERROR",
+}
+
+// Columns that make up the name, address and phone of a query.
+var (
+	addressColumns = []string{
+		"ADDR_CITY",
+		"ADDR_COUNTRY",
+		"ADDR_FULL",
+		"ADDR_LINE1",
+		"ADDR_LINE2",
+		"ADDR_LINE3",
+		"ADDR_POSTAL_CODE",
+		"ADDR_STATE",
+		"ADDR_TYPE",
+	}
+	nameColumns = []string{
+		"NAME_FIRST",
+		"NAME_FULL",
+		"NAME_LAST",
+		"NAME_MIDDLE",
+		"NAME_ORG",
+		"NAME_PREFIX",
+		"NAME_SUFFIX",
+		"NAME_TYPE",
+	}
+	phoneColumns = []string{
+		"PHONE_NUMBER",
+		"PHONE_TYPE",
+	}
+)
+
+var formatNames = map[Format]string{
+	FormatCSV:   "CSV",
+	FormatJSONL: "JSONL",
+}
+
+var matchLevels = []szsearch.MatchLevel{
+	szsearch.MatchLevelResolved,
+	szsearch.MatchLevelPossiblySame,
+	szsearch.MatchLevelPossiblyRelated,
+	szsearch.MatchLevelNameOnly,
+	szsearch.MatchLevelUnknown,
+}
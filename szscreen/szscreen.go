@@ -0,0 +1,732 @@
+package szscreen
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szrecord"
+	"github.com/senzing-garage/sz-sdk-go-core/szsearch"
+)
+
+/*
+Type Config struct configures a Screener.
+The zero value reads and writes CSV, runs GOMAXPROCS queries at a time and reports every match.
+*/
+type Config struct {
+	Concurrency   int                 // Number of queries screened at a time. Values less than 1 are treated as runtime.GOMAXPROCS(0).
+	Explain       bool                // Call WhySearch() for each hit and write its explanation.
+	Flags         int64               // Flags for SearchByAttributes(). senzing.SzNoFlags selects the recommended default flags.
+	InputFormat   Format              // Format of the queries.
+	MaxHits       int                 // Maximum number of hits reported per query. Zero or less reports all.
+	MinMatchLevel szsearch.MatchLevel // Weakest match level reported as a hit. MatchLevelUnknown reports every level.
+	MinScore      int                 // Matches whose best feature score is lower are not hits.
+	OutputFormat  Format              // Format of the results.
+	SearchProfile string              // Search profile. Empty for the default profile.
+}
+
+/*
+Type Screener struct screens queries against the entities in the repository.
+*/
+type Screener struct {
+	config Config
+	engine szsearch.Engine
+}
+
+/*
+Type Summary struct describes a finished run.
+*/
+type Summary struct {
+	Duration     time.Duration  // Wall-clock time of the run.
+	Errors       int            // Queries with the status ERROR.
+	Hits         int            // Queries with the status HIT.
+	HitsByLevel  map[string]int // Queries with the status HIT, by the match level of their strongest hit.
+	NoHits       int            // Queries with the status NO_HIT.
+	Queries      int            // Queries read.
+	TotalMatches int            // Hits reported over all queries.
+}
+
+// ----------------------------------------------------------------------------
+// Constructors
+// ----------------------------------------------------------------------------
+
+/*
+The New function returns a Screener.
+
+Input
+  - engine: A senzing.SzEngine. Its methods are called concurrently.
+  - config: Formats, concurrency, search profile and thresholds.
+
+Output
+  - A Screener.
+*/
+func New(engine szsearch.Engine, config Config) *Screener {
+	if config.Concurrency < 1 {
+		config.Concurrency = runtime.GOMAXPROCS(0)
+	}
+
+	return &Screener{
+		config: config,
+		engine: engine,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Run screens every query read from the input and writes the results, in input order, to the output.
+Queries that fail are written with the status ERROR; the run continues.
+At most 4 × Concurrency queries are read ahead of the last result written,
+so a slow query holds back reading rather than letting finished results pile up.
+The run stops early if the context is cancelled, the input cannot be read or the output cannot be written.
+
+Input
+  - ctx: A context to control lifecycle.
+  - input: The queries, in the configured input format.
+  - output: Receives the results, in the configured output format.
+
+Output
+  - A summary of the queries screened, also when an error stopped the run.
+*/
+func (screener *Screener) Run(ctx context.Context, input io.Reader, output io.Writer) (*Summary, error) {
+	started := time.Now()
+	summary := &Summary{
+		Duration:     0,
+		Errors:       0,
+		Hits:         0,
+		HitsByLevel:  map[string]int{},
+		NoHits:       0,
+		Queries:      0,
+		TotalMatches: 0,
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var readErr error
+
+	requests := make(chan request)
+
+	// A slot is taken for each query read and given back when its result is written.
+	slots := make(chan struct{}, screener.config.Concurrency*reorderWindow)
+
+	go func() {
+		defer close(requests)
+
+		readErr = screener.read(ctx, input, slots, requests)
+	}()
+
+	outcomes := make(chan outcome)
+
+	var waitGroup sync.WaitGroup
+
+	for range screener.config.Concurrency {
+		waitGroup.Go(func() {
+			for request := range requests {
+				outcomes <- screener.screen(ctx, request)
+			}
+		})
+	}
+
+	go func() {
+		waitGroup.Wait()
+		close(outcomes)
+	}()
+
+	writer := newOutcomeWriter(screener.config.OutputFormat, output)
+	pending := map[int]outcome{}
+	next := 0
+
+	var writeErr error
+
+	for finished := range outcomes {
+		pending[finished.index] = finished
+
+		for ready, ok := pending[next]; ok; ready, ok = pending[next] {
+			delete(pending, next)
+			next++
+			<-slots
+
+			if writeErr != nil {
+				continue
+			}
+
+			writeErr = writer.write(ready)
+			if writeErr != nil {
+				cancel()
+
+				continue
+			}
+
+			summary.add(ready)
+		}
+	}
+
+	if writeErr == nil {
+		writeErr = writer.flush()
+	}
+
+	summary.Duration = time.Since(started)
+
+	if writeErr != nil {
+		// The reader was stopped because of the write error; its cancellation error adds nothing.
+		return summary, writeErr
+	}
+
+	return summary, readErr
+}
+
+/*
+Method String returns a text report of the run.
+*/
+func (summary *Summary) String() string {
+	var report strings.Builder
+
+	fmt.Fprintf(&report, "Queries:  %d\n", summary.Queries)
+	fmt.Fprintf(&report, "Hits:     %d\n", summary.Hits)
+
+	for _, level := range matchLevels {
+		if count := summary.HitsByLevel[level.String()]; count > 0 {
+			fmt.Fprintf(&report, "  %-18s%d\n", level.String()+":", count)
+		}
+	}
+
+	fmt.Fprintf(&report, "No hits:  %d\n", summary.NoHits)
+	fmt.Fprintf(&report, "Errors:   %d\n", summary.Errors)
+	fmt.Fprintf(&report, "Matches:  %d\n", summary.TotalMatches)
+	fmt.Fprintf(&report, "Duration: %s\n", summary.Duration.Round(time.Millisecond))
+
+	return report.String()
+}
+
+/*
+Method String returns the name of the format, e.g. "JSONL".
+*/
+func (format Format) String() string {
+	if name, ok := formatNames[format]; ok {
+		return name
+	}
+
+	return "Format(" + strconv.Itoa(int(format)) + ")"
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (screener *Screener) read(
+	ctx context.Context,
+	input io.Reader,
+	slots chan<- struct{},
+	requests chan<- request,
+) error {
+	send := func(next request) error {
+		if ctx.Err() != nil {
+			return fmt.Errorf("reading queries: %w", ctx.Err())
+		}
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			return fmt.Errorf("reading queries: %w", ctx.Err())
+		}
+
+		select {
+		case requests <- next:
+			return nil
+		case <-ctx.Done():
+			return fmt.Errorf("reading queries: %w", ctx.Err())
+		}
+	}
+
+	if screener.config.InputFormat == FormatJSONL {
+		return readJSONL(input, send)
+	}
+
+	return readCSV(input, send)
+}
+
+func (screener *Screener) screen(ctx context.Context, request request) outcome {
+	result := outcome{
+		Error:   "",
+		Hits:    []hit{},
+		Line:    request.line,
+		QueryID: request.queryID,
+		Status:  StatusNoHit,
+		index:   request.index,
+	}
+
+	if request.problem != nil {
+		return result.failed(request.problem)
+	}
+
+	query := queryFrom(request.fields)
+	options := szsearch.Options{
+		Flags:         screener.config.Flags,
+		Limit:         screener.config.MaxHits,
+		MinMatchLevel: screener.config.MinMatchLevel,
+		MinScore:      screener.config.MinScore,
+		Offset:        0,
+		SearchProfile: screener.config.SearchProfile,
+	}
+
+	whyOptions := szsearch.Options{
+		Flags:         0,
+		Limit:         0,
+		MinMatchLevel: szsearch.MatchLevelUnknown,
+		MinScore:      0,
+		Offset:        0,
+		SearchProfile: screener.config.SearchProfile,
+	}
+
+	results, err := szsearch.Search(ctx, screener.engine, query, options)
+	if err != nil {
+		return result.failed(err)
+	}
+
+	for _, match := range results.Results {
+		found := newHit(match)
+
+		if screener.config.Explain {
+			why, err := szsearch.Why(ctx, screener.engine, query, match.EntityID, whyOptions)
+			if err != nil {
+				return result.failed(fmt.Errorf("explaining entity %d: %w", match.EntityID, err))
+			}
+
+			found.WhyKey = why.MatchKey
+			found.Explanation = explain(why)
+		}
+
+		result.Hits = append(result.Hits, found)
+	}
+
+	if len(result.Hits) > 0 {
+		result.Status = StatusHit
+	}
+
+	return result
+}
+
+func (summary *Summary) add(finished outcome) {
+	summary.Queries++
+	summary.TotalMatches += len(finished.Hits)
+
+	switch finished.Status {
+	case StatusError:
+		summary.Errors++
+	case StatusHit:
+		summary.Hits++
+		summary.HitsByLevel[finished.Hits[0].MatchLevel]++
+	case StatusNoHit:
+		summary.NoHits++
+	}
+}
+
+func (result outcome) failed(err error) outcome {
+	result.Error = err.Error()
+	result.Hits = []hit{}
+	result.Status = StatusError
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Feature scores in a stable order, for example "NAME Robert Smith~Bob Smith 88 CLOSE; DOB ...".
+func explain(result szsearch.Result) string {
+	parts := []string{}
+
+	for _, featureType := range slices.Sorted(maps.Keys(result.FeatureScores)) {
+		for _, score := range result.FeatureScores[featureType] {
+			parts = append(parts, strings.TrimSpace(fmt.Sprintf(
+				"%s %s~%s %d %s",
+				featureType,
+				score.InboundFeature,
+				score.CandidateFeature,
+				score.Score,
+				score.ScoreBucket,
+			)))
+		}
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+func newHit(result szsearch.Result) hit {
+	scores := map[string][]featureScore{}
+
+	for featureType, featureScores := range result.FeatureScores {
+		for _, score := range featureScores {
+			scores[featureType] = append(scores[featureType], featureScore{
+				CandidateFeature: score.CandidateFeature,
+				InboundFeature:   score.InboundFeature,
+				Score:            score.Score,
+				ScoreBucket:      score.ScoreBucket,
+			})
+		}
+	}
+
+	return hit{
+		BestName:      result.BestName,
+		EntityID:      result.EntityID,
+		ERRuleCode:    result.ERRuleCode,
+		Explanation:   "",
+		FeatureScores: scores,
+		MatchKey:      result.MatchKey,
+		MatchLevel:    result.MatchLevel.String(),
+		Score:         result.Score,
+		WhyKey:        "",
+	}
+}
+
+// Names, addresses and phones are each given by a group of columns; other columns are single features.
+func queryFrom(fields map[string]string) szsearch.Query {
+	query := szsearch.Query{}
+	remaining := maps.Clone(fields)
+
+	if takeGroup(remaining, nameColumns) {
+		query.Names = []szrecord.Name{{
+			First:  fields["NAME_FIRST"],
+			Full:   fields["NAME_FULL"],
+			Last:   fields["NAME_LAST"],
+			Middle: fields["NAME_MIDDLE"],
+			Org:    fields["NAME_ORG"],
+			Prefix: fields["NAME_PREFIX"],
+			Suffix: fields["NAME_SUFFIX"],
+			Type:   fields["NAME_TYPE"],
+		}}
+	}
+
+	if takeGroup(remaining, addressColumns) {
+		query.Addresses = []szrecord.Address{{
+			City:       fields["ADDR_CITY"],
+			Country:    fields["ADDR_COUNTRY"],
+			Full:       fields["ADDR_FULL"],
+			Line1:      fields["ADDR_LINE1"],
+			Line2:      fields["ADDR_LINE2"],
+			Line3:      fields["ADDR_LINE3"],
+			PostalCode: fields["ADDR_POSTAL_CODE"],
+			State:      fields["ADDR_STATE"],
+			Type:       fields["ADDR_TYPE"],
+		}}
+	}
+
+	if takeGroup(remaining, phoneColumns) {
+		query.Phones = []szrecord.Phone{{
+			Number: fields["PHONE_NUMBER"],
+			Type:   fields["PHONE_TYPE"],
+		}}
+	}
+
+	if email, ok := remaining["EMAIL_ADDRESS"]; ok {
+		query.Emails = []string{email}
+
+		delete(remaining, "EMAIL_ADDRESS")
+	}
+
+	query.DateOfBirth = remaining["DATE_OF_BIRTH"]
+	query.SSN = remaining["SSN_NUMBER"]
+
+	delete(remaining, "DATE_OF_BIRTH")
+	delete(remaining, "SSN_NUMBER")
+
+	if len(remaining) > 0 {
+		query.Features = []map[string]string{remaining}
+	}
+
+	return query
+}
+
+func csvRequest(index int, reader *csv.Reader, header []string, row []string) request {
+	line, _ := reader.FieldPos(0)
+	if len(row) > len(header) {
+		return failedRequest(index, line, fmt.Errorf("line %d has %d columns; the header has %d", line, len(row), len(header)))
+	}
+
+	fields := map[string]string{}
+	for column, value := range row {
+		fields[header[column]] = value
+	}
+
+	return newRequest(index, line, fields)
+}
+
+func failedRequest(index int, line int, problem error) request {
+	return request{
+		fields:  nil,
+		index:   index,
+		line:    line,
+		problem: problem,
+		queryID: strconv.Itoa(line),
+	}
+}
+
+// Empty values are dropped; column names are upper-cased.
+func newRequest(index int, line int, fields map[string]string) request {
+	cleaned := map[string]string{}
+
+	for name, value := range fields {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			cleaned[strings.ToUpper(strings.TrimSpace(name))] = value
+		}
+	}
+
+	queryID := cleaned[columnQueryID]
+	if queryID == "" {
+		queryID = strconv.Itoa(line)
+	}
+
+	delete(cleaned, columnQueryID)
+
+	return request{
+		fields:  cleaned,
+		index:   index,
+		line:    line,
+		problem: nil,
+		queryID: queryID,
+	}
+}
+
+func readCSV(input io.Reader, send func(request) error) error {
+	reader := csv.NewReader(input)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading CSV header: %w", err)
+	}
+
+	header = slices.Clone(header)
+
+	for index := 0; ; index++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		var (
+			next       request
+			parseError *csv.ParseError
+		)
+
+		switch {
+		case errors.As(err, &parseError):
+			next = failedRequest(index, parseError.Line, err)
+		case err != nil:
+			return fmt.Errorf("reading CSV: %w", err)
+		default:
+			next = csvRequest(index, reader, header, row)
+		}
+
+		err = send(next)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func readJSONL(input io.Reader, send func(request) error) error {
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, startLineBytes), maxLineBytes)
+
+	index := 0
+
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		next, err := parseJSONLine(index, line, text)
+		if err != nil {
+			next = failedRequest(index, line, err)
+		}
+
+		err = send(next)
+		if err != nil {
+			return err
+		}
+
+		index++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading JSON Lines: %w", err)
+	}
+
+	return nil
+}
+
+// Numbers and booleans are accepted as text; objects and arrays are not.
+func parseJSONLine(index int, line int, text []byte) (request, error) {
+	var document map[string]any
+
+	decoder := json.NewDecoder(bytes.NewReader(text))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&document)
+	if err != nil {
+		return request{}, fmt.Errorf("line %d is not a JSON object: %w", line, err)
+	}
+
+	fields := map[string]string{}
+
+	for name, value := range document {
+		switch typed := value.(type) {
+		case string:
+			fields[name] = typed
+		case json.Number:
+			fields[name] = typed.String()
+		case bool:
+			fields[name] = strconv.FormatBool(typed)
+		case nil:
+		default:
+			return request{}, fmt.Errorf("line %d: %s is not a text value", line, name)
+		}
+	}
+
+	return newRequest(index, line, fields), nil
+}
+
+// Reports whether any column of the group is present and removes the group from the fields.
+func takeGroup(fields map[string]string, columns []string) bool {
+	found := false
+
+	for _, column := range columns {
+		if _, ok := fields[column]; ok {
+			found = true
+
+			delete(fields, column)
+		}
+	}
+
+	return found
+}
+
+// ----------------------------------------------------------------------------
+// Output
+// ----------------------------------------------------------------------------
+
+type outcomeWriter interface {
+	flush() error
+	write(finished outcome) error
+}
+
+type csvOutcomeWriter struct {
+	wroteHeader bool
+	writer      *csv.Writer
+}
+
+type jsonlOutcomeWriter struct {
+	encoder *json.Encoder
+	writer  *bufio.Writer
+}
+
+func newOutcomeWriter(format Format, output io.Writer) outcomeWriter {
+	if format == FormatJSONL {
+		writer := bufio.NewWriter(output)
+		encoder := json.NewEncoder(writer)
+		encoder.SetEscapeHTML(false)
+
+		return &jsonlOutcomeWriter{
+			encoder: encoder,
+			writer:  writer,
+		}
+	}
+
+	return &csvOutcomeWriter{
+		wroteHeader: false,
+		writer:      csv.NewWriter(output),
+	}
+}
+
+// The header is written even when there are no queries.
+func (writer *csvOutcomeWriter) flush() error {
+	err := writer.writeHeader()
+	if err != nil {
+		return err
+	}
+
+	writer.writer.Flush()
+
+	return wrapWriteError(writer.writer.Error())
+}
+
+// One row per hit; a query without hits gets a single row.
+func (writer *csvOutcomeWriter) write(finished outcome) error {
+	err := writer.writeHeader()
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{}
+	prefix := []string{finished.QueryID, strconv.Itoa(finished.Line), string(finished.Status)}
+
+	for _, found := range finished.Hits {
+		rows = append(rows, append(slices.Clone(prefix),
+			strconv.FormatInt(found.EntityID, 10),
+			found.BestName,
+			found.MatchLevel,
+			found.MatchKey,
+			strconv.Itoa(found.Score),
+			found.ERRuleCode,
+			found.WhyKey,
+			found.Explanation,
+			finished.Error,
+		))
+	}
+
+	if len(rows) == 0 {
+		rows = append(rows, append(slices.Clone(prefix), "", "", "", "", "", "", "", "", finished.Error))
+	}
+
+	return wrapWriteError(writer.writer.WriteAll(rows))
+}
+
+func (writer *csvOutcomeWriter) writeHeader() error {
+	if writer.wroteHeader {
+		return nil
+	}
+
+	writer.wroteHeader = true
+
+	return wrapWriteError(writer.writer.Write(csvHeader))
+}
+
+func (writer *jsonlOutcomeWriter) flush() error {
+	return wrapWriteError(writer.writer.Flush())
+}
+
+func (writer *jsonlOutcomeWriter) write(finished outcome) error {
+	return wrapWriteError(writer.encoder.Encode(finished))
+}
+
+func wrapWriteError(err error) error {
+	if err != nil {
+		return fmt.Errorf("writing results: %w", err)
+	}
+
+	return nil
+}
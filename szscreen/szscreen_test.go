@@ -0,0 +1,260 @@
+package szscreen_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szscreen"
+	"github.com/senzing-garage/sz-sdk-go-core/szsearch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	hitResponse = `{"RESOLVED_ENTITIES":[
+{"MATCH_INFO":{"MATCH_LEVEL_CODE":"POSSIBLY_SAME","MATCH_KEY":"+NAME+DOB","ERRULE_CODE":"CNAME_CFF","FEATURE_SCORES":{"NAME":[{"INBOUND_FEAT_DESC":"Robert Smith","CANDIDATE_FEAT_DESC":"Bob Smith","SCORE":88,"SCORE_BUCKET":"CLOSE"}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":5,"ENTITY_NAME":"Bob Smith"}}},
+{"MATCH_INFO":{"MATCH_LEVEL_CODE":"RESOLVED","MATCH_KEY":"+NAME+SSN","ERRULE_CODE":"SF1","FEATURE_SCORES":{"SSN":[{"INBOUND_FEAT_DESC":"053-39-3251","CANDIDATE_FEAT_DESC":"053-39-3251","SCORE":100,"SCORE_BUCKET":"SAME"}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"Robert Smith"}}},
+{"MATCH_INFO":{"MATCH_LEVEL_CODE":"NAME_ONLY","MATCH_KEY":"+NAME","FEATURE_SCORES":{"NAME":[{"INBOUND_FEAT_DESC":"Robert Smith","CANDIDATE_FEAT_DESC":"R Smith","SCORE":70}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":3,"ENTITY_NAME":"R Smith"}}}
+]}`
+	noHitResponse = `{"RESOLVED_ENTITIES":[]}`
+	whyResponse   = `{"WHY_RESULTS":[{"ENTITY_ID":1,"MATCH_INFO":{"WHY_KEY":"+NAME+SSN","WHY_ERRULE_CODE":"SF1","MATCH_LEVEL_CODE":"RESOLVED","FEATURE_SCORES":{"NAME":[{"INBOUND_FEAT_DESC":"Robert Smith","CANDIDATE_FEAT_DESC":"Robert Smith","SCORE":100,"SCORE_BUCKET":"SAME"}]}}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"Robert Smith"}}]}`
+)
+
+const csvQueries = `QUERY_ID,NAME_FULL,DATE_OF_BIRTH,SSN_NUMBER
+q1,Robert Smith,1978-12-11,
+q2,Jane Doe,,
+q3,Robert Smith,someday,
+,Robert Smith,,053-39-3251
+`
+
+type fakeEngine struct {
+	calls   atomic.Int64
+	err     error
+	slow    chan struct{} // If set, searches for "Slow" wait until it is closed.
+	whyCall atomic.Int64
+}
+
+func (engine *fakeEngine) SearchByAttributes(
+	ctx context.Context,
+	attributes string,
+	searchProfile string,
+	flags int64,
+) (string, error) {
+	_, _, _ = ctx, searchProfile, flags
+
+	engine.calls.Add(1)
+
+	if engine.slow != nil && strings.Contains(attributes, "Slow") {
+		<-engine.slow
+	}
+
+	if engine.err != nil {
+		return "", engine.err
+	}
+
+	if strings.Contains(attributes, "Smith") {
+		return hitResponse, nil
+	}
+
+	return noHitResponse, nil
+}
+
+func (engine *fakeEngine) WhySearch(
+	ctx context.Context,
+	attributes string,
+	entityID int64,
+	searchProfile string,
+	flags int64,
+) (string, error) {
+	_, _, _, _, _ = ctx, attributes, entityID, searchProfile, flags
+
+	engine.whyCall.Add(1)
+
+	return whyResponse, nil
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(_ []byte) (int, error) {
+	return 0, errWrite
+}
+
+var errWrite = errors.New("disk full")
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestScreener_Run_csv(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{}
+	screener := szscreen.New(engine, szscreen.Config{
+		Concurrency:   4,
+		MinMatchLevel: szsearch.MatchLevelPossiblySame,
+	})
+
+	var output strings.Builder
+
+	summary, err := screener.Run(ctx, strings.NewReader(csvQueries), &output)
+	require.NoError(test, err)
+
+	rows, err := csv.NewReader(strings.NewReader(output.String())).ReadAll()
+	require.NoError(test, err)
+	require.Len(test, rows, 7)
+	assert.Equal(test, "QUERY_ID", rows[0][0])
+
+	columns := func(row []string) []string { return []string{row[0], row[1], row[2], row[3], row[5]} }
+	assert.Equal(test, []string{"q1", "2", "HIT", "1", "RESOLVED"}, columns(rows[1]))
+	assert.Equal(test, []string{"q1", "2", "HIT", "5", "POSSIBLY_SAME"}, columns(rows[2]))
+	assert.Equal(test, []string{"q2", "3", "NO_HIT", "", ""}, columns(rows[3]))
+	assert.Equal(test, []string{"q3", "4", "ERROR", "", ""}, columns(rows[4]))
+	assert.Contains(test, rows[4][11], "DATE_OF_BIRTH")
+	assert.Equal(test, []string{"5", "5", "HIT", "1", "RESOLVED"}, columns(rows[5]))
+
+	assert.Equal(test, 4, summary.Queries)
+	assert.Equal(test, 2, summary.Hits)
+	assert.Equal(test, 1, summary.NoHits)
+	assert.Equal(test, 1, summary.Errors)
+	assert.Equal(test, 4, summary.TotalMatches)
+	assert.Equal(test, map[string]int{"RESOLVED": 2}, summary.HitsByLevel)
+	assert.Equal(test, int64(3), engine.calls.Load(), "the invalid query should not be searched")
+	assert.Contains(test, summary.String(), "Queries:  4\n")
+	assert.Contains(test, summary.String(), "  RESOLVED:         2\n")
+}
+
+func TestScreener_Run_jsonl(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{}
+	screener := szscreen.New(engine, szscreen.Config{
+		Explain:      true,
+		InputFormat:  szscreen.FormatJSONL,
+		MaxHits:      1,
+		OutputFormat: szscreen.FormatJSONL,
+		MinScore:     90,
+	})
+	input := `{"QUERY_ID": "a", "NAME_FIRST": "Robert", "NAME_LAST": "Smith", "PASSPORT_NUMBER": 12345}
+
+{"QUERY_ID": "b", "NAME_FULL": ["not", "text"]}
+not json
+{"QUERY_ID": "c", "EMAIL_ADDRESS": "jane@example.com"}
+`
+
+	var output strings.Builder
+
+	summary, err := screener.Run(ctx, strings.NewReader(input), &output)
+	require.NoError(test, err)
+
+	results := []map[string]any{}
+
+	scanner := bufio.NewScanner(strings.NewReader(output.String()))
+	for scanner.Scan() {
+		var result map[string]any
+
+		require.NoError(test, json.Unmarshal(scanner.Bytes(), &result))
+
+		results = append(results, result)
+	}
+
+	require.Len(test, results, 4)
+	assert.Equal(test, "a", results[0]["QUERY_ID"])
+	assert.Equal(test, "HIT", results[0]["STATUS"])
+
+	hits, _ := results[0]["HITS"].([]any)
+	require.Len(test, hits, 1)
+
+	first, _ := hits[0].(map[string]any)
+	assert.InDelta(test, 1, first["ENTITY_ID"], 0)
+	assert.Equal(test, "+NAME+SSN", first["WHY_KEY"])
+	assert.Equal(test, "NAME Robert Smith~Robert Smith 100 SAME", first["EXPLANATION"])
+
+	assert.Equal(test, "ERROR", results[1]["STATUS"])
+	assert.InDelta(test, 3, results[1]["LINE"], 0)
+	assert.Equal(test, "ERROR", results[2]["STATUS"])
+	assert.Equal(test, "4", results[2]["QUERY_ID"])
+	assert.Equal(test, "NO_HIT", results[3]["STATUS"])
+
+	assert.Equal(test, 4, summary.Queries)
+	assert.Equal(test, int64(1), engine.whyCall.Load())
+}
+
+func TestScreener_Run_engineError(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{err: errors.New("engine failed")}
+	screener := szscreen.New(engine, szscreen.Config{})
+
+	var output strings.Builder
+
+	summary, err := screener.Run(ctx, strings.NewReader(csvQueries), &output)
+	require.NoError(test, err)
+	assert.Equal(test, 4, summary.Errors)
+	assert.Contains(test, output.String(), "engine failed")
+}
+
+func TestScreener_Run_empty(test *testing.T) {
+	ctx := test.Context()
+	screener := szscreen.New(&fakeEngine{}, szscreen.Config{})
+
+	var output strings.Builder
+
+	summary, err := screener.Run(ctx, strings.NewReader(""), &output)
+	require.NoError(test, err)
+	assert.Equal(test, 0, summary.Queries)
+	assert.True(test, strings.HasPrefix(output.String(), "QUERY_ID,LINE,STATUS"))
+}
+
+func TestScreener_Run_writeError(test *testing.T) {
+	ctx := test.Context()
+	screener := szscreen.New(&fakeEngine{}, szscreen.Config{})
+
+	_, err := screener.Run(ctx, strings.NewReader(csvQueries), failingWriter{})
+	require.ErrorIs(test, err, errWrite)
+}
+
+func TestScreener_Run_slowQuery(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{slow: make(chan struct{})}
+	screener := szscreen.New(engine, szscreen.Config{Concurrency: 2, InputFormat: szscreen.FormatJSONL})
+	input := `{"NAME_FULL": "Slow"}` + "\n" + strings.Repeat(`{"NAME_FULL": "Jane Doe"}`+"\n", 99)
+	finished := make(chan error)
+
+	go func() {
+		_, err := screener.Run(ctx, strings.NewReader(input), io.Discard)
+		finished <- err
+	}()
+
+	// While the first query is screened, no more than 4 × Concurrency queries are read.
+
+	require.Eventually(test, func() bool { return engine.calls.Load() == 8 }, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(test, int64(8), engine.calls.Load())
+
+	close(engine.slow)
+	require.NoError(test, <-finished)
+	assert.Equal(test, int64(100), engine.calls.Load())
+}
+
+func TestScreener_Run_canceled(test *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	cancel()
+
+	screener := szscreen.New(&fakeEngine{}, szscreen.Config{Concurrency: 1})
+
+	var output strings.Builder
+
+	_, err := screener.Run(ctx, strings.NewReader(csvQueries), &output)
+	require.ErrorIs(test, err, context.Canceled)
+}
+
+func TestFormat_String(test *testing.T) {
+	assert.Equal(test, "CSV", szscreen.FormatCSV.String())
+	assert.Equal(test, "JSONL", szscreen.FormatJSONL.String())
+	assert.Equal(test, "Format(7)", szscreen.Format(7).String())
+}
@@ -0,0 +1,20 @@
+/*
+Package szsearch searches the entity repository with typed queries and returns ranked, typed results.
+
+A [Query] describes what to look for with the feature types of the [szrecord] package.
+[Search] calls SearchByAttributes() of [senzing.SzEngine] and returns a [Result] for each matching entity
+with its match level, match key, feature scores and best name:
+
+	results, err := szsearch.Search(ctx, szEngine, szsearch.Query{
+		Names:       []szrecord.Name{{Full: "Robert Smith"}},
+		DateOfBirth: "1978-12-11",
+	}, szsearch.Options{MinMatchLevel: szsearch.MatchLevelPossiblySame, Limit: 10})
+
+Results are filtered by [Options] on the client, ordered from the strongest match to the weakest,
+and paginated with Offset and Limit.
+[Why] calls WhySearch() to explain how a query relates to a single entity.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+[szrecord]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szrecord
+*/
+package szsearch
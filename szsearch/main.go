@@ -0,0 +1,111 @@
+package szsearch
+
+import (
+	"context"
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Engine interface is the part of [senzing.SzEngine] used for searching.
+Any senzing.SzEngine satisfies it.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+type Engine interface {
+	Searcher
+	WhySearch(ctx context.Context, attributes string, entityID int64, searchProfile string, flags int64) (string, error)
+}
+
+/*
+Type Searcher interface is the part of [senzing.SzEngine] used by Search and SearchAttributes.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+type Searcher interface {
+	SearchByAttributes(ctx context.Context, attributes string, searchProfile string, flags int64) (string, error)
+}
+
+/*
+Type MatchLevel int ranks how strongly an entity matches a query.
+Lower values are stronger matches, except MatchLevelUnknown (0), which ranks below every other level.
+*/
+type MatchLevel int
+
+// Fragments of SearchByAttributes() and WhySearch() responses.
+
+type entityDocument struct {
+	ResolvedEntity struct {
+		EntityID   int64  `json:"ENTITY_ID"`
+		EntityName string `json:"ENTITY_NAME"`
+	} `json:"RESOLVED_ENTITY"`
+}
+
+type featureScoreDocument struct {
+	CandidateFeat     string `json:"CANDIDATE_FEAT"`
+	CandidateFeatDesc string `json:"CANDIDATE_FEAT_DESC"`
+	FullScore         *int   `json:"FULL_SCORE"`
+	GNRFullName       *int   `json:"GNR_FN"`
+	InboundFeat       string `json:"INBOUND_FEAT"`
+	InboundFeatDesc   string `json:"INBOUND_FEAT_DESC"`
+	Score             *int   `json:"SCORE"`
+	ScoreBucket       string `json:"SCORE_BUCKET"`
+}
+
+type matchInfoDocument struct {
+	ERRuleCode     string                            `json:"ERRULE_CODE"`
+	FeatureScores  map[string][]featureScoreDocument `json:"FEATURE_SCORES"`
+	MatchKey       string                            `json:"MATCH_KEY"`
+	MatchLevel     int                               `json:"MATCH_LEVEL"`
+	MatchLevelCode string                            `json:"MATCH_LEVEL_CODE"`
+	WhyERRuleCode  string                            `json:"WHY_ERRULE_CODE"`
+	WhyKey         string                            `json:"WHY_KEY"`
+}
+
+type searchDocument struct {
+	ResolvedEntities []struct {
+		Entity    entityDocument    `json:"ENTITY"`
+		MatchInfo matchInfoDocument `json:"MATCH_INFO"`
+	} `json:"RESOLVED_ENTITIES"`
+}
+
+type whyDocument struct {
+	Entities   []entityDocument `json:"ENTITIES"`
+	WhyResults []struct {
+		EntityID  int64             `json:"ENTITY_ID"`
+		MatchInfo matchInfoDocument `json:"MATCH_INFO"`
+	} `json:"WHY_RESULTS"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+The MatchLevel* constants are the match levels reported by Senzing, strongest first.
+MatchLevelUnknown is used when a response has no match level.
+*/
+const (
+	MatchLevelUnknown MatchLevel = iota
+	MatchLevelResolved
+	MatchLevelPossiblySame
+	MatchLevelPossiblyRelated
+	MatchLevelNameOnly
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errNoWhyResults = errors.New("no WHY_RESULTS")
+
+var matchLevelCodes = map[MatchLevel]string{
+	MatchLevelUnknown:         "UNKNOWN",
+	MatchLevelResolved:        "RESOLVED",
+	MatchLevelPossiblySame:    "POSSIBLY_SAME",
+	MatchLevelPossiblyRelated: "POSSIBLY_RELATED",
+	MatchLevelNameOnly:        "NAME_ONLY",
+}
@@ -0,0 +1,428 @@
+package szsearch
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szrecord"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+/*
+Type Query struct describes the entity to search for.
+At least one feature must be given.
+*/
+type Query struct {
+	Addresses   []szrecord.Address  // Addresses of the entity.
+	DateOfBirth string              // YYYY-MM-DD, YYYYMMDD, YYYY-MM, YYYY or MM/DD/YYYY.
+	Emails      []string            // Email addresses of the entity.
+	Features    []map[string]string // Other features, as for szrecord.Builder.AddFeature().
+	Names       []szrecord.Name     // Names of the entity.
+	Phones      []szrecord.Phone    // Phone numbers of the entity.
+	SSN         string              // 9 digits, or the last 4 digits.
+}
+
+/*
+Type Options struct controls a search and the selection of its results.
+The zero value searches with the default flags and profile and returns every result.
+*/
+type Options struct {
+	Flags         int64      // Flags for the Senzing call. senzing.SzNoFlags selects the recommended default flags.
+	Limit         int        // Maximum number of results returned. Zero or less returns all.
+	MinMatchLevel MatchLevel // Weakest match level kept. MatchLevelUnknown keeps every level.
+	MinScore      int        // Results whose Score is lower are dropped.
+	Offset        int        // Number of ranked results skipped before the first one returned.
+	SearchProfile string     // Search profile. Empty for the default profile.
+}
+
+/*
+Type FeatureScore struct compares a feature of the query with a feature of the entity.
+*/
+type FeatureScore struct {
+	CandidateFeature string // The feature of the entity.
+	InboundFeature   string // The feature of the query.
+	Score            int    // From 0 to 100.
+	ScoreBucket      string // Example: "SAME", "CLOSE", "LIKELY".
+}
+
+/*
+Type Result struct is an entity matching a query.
+*/
+type Result struct {
+	BestName      string                    // ENTITY_NAME of the entity.
+	EntityID      int64                     // ENTITY_ID of the entity.
+	ERRuleCode    string                    // The rule that matched.
+	FeatureScores map[string][]FeatureScore // By feature type. Example: "NAME".
+	MatchKey      string                    // Example: "+NAME+DOB".
+	MatchLevel    MatchLevel                // From MATCH_LEVEL_CODE.
+	Score         int                       // The highest feature score.
+}
+
+/*
+Type Results struct is a page of ranked results.
+*/
+type Results struct {
+	Offset  int      // Position of the first result in the ranked results.
+	Results []Result // The page.
+	Total   int      // Number of ranked results before pagination.
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Search function searches for entities matching the query.
+SzEntityIncludeEntityName and SzIncludeFeatureScores are always added to the flags, as the results need them.
+
+Input
+  - ctx: A context to control lifecycle.
+  - engine: A senzing.SzEngine.
+  - query: What to search for.
+  - options: The search profile and flags, and how results are filtered and paginated.
+
+Output
+  - The page of results selected by the options.
+*/
+func Search(ctx context.Context, engine Searcher, query Query, options Options) (*Results, error) {
+	attributes, err := query.Attributes()
+	if err != nil {
+		return nil, err
+	}
+
+	return SearchAttributes(ctx, engine, attributes, options)
+}
+
+/*
+The SearchAttributes function is like Search, but takes the attributes JSON of SearchByAttributes().
+
+Input
+  - ctx: A context to control lifecycle.
+  - engine: A senzing.SzEngine.
+  - attributes: What to search for, as a JSON document.
+  - options: The search profile and flags, and how results are filtered and paginated.
+
+Output
+  - The page of results selected by the options.
+*/
+func SearchAttributes(ctx context.Context, engine Searcher, attributes string, options Options) (*Results, error) {
+	flags := options.Flags
+	if flags == senzing.SzNoFlags {
+		flags = senzing.SzSearchByAttributesDefaultFlags
+	}
+
+	response, err := engine.SearchByAttributes(
+		ctx,
+		attributes,
+		options.SearchProfile,
+		flags|senzing.SzEntityIncludeEntityName|senzing.SzIncludeFeatureScores,
+	)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	results, err := ParseSearchResponse(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return Rank(results, options), nil
+}
+
+/*
+The Why function explains how the query relates to an entity.
+SzIncludeFeatureScores is always added to the flags.
+
+Input
+  - ctx: A context to control lifecycle.
+  - engine: A senzing.SzEngine.
+  - query: What was searched for.
+  - entityID: The entity to explain.
+  - options: The search profile and flags. Filtering and pagination options are ignored.
+
+Output
+  - The result for the entity. MatchKey holds the WHY_KEY.
+*/
+func Why(ctx context.Context, engine Engine, query Query, entityID int64, options Options) (Result, error) {
+	attributes, err := query.Attributes()
+	if err != nil {
+		return Result{}, err
+	}
+
+	flags := options.Flags
+	if flags == senzing.SzNoFlags {
+		flags = senzing.SzWhySearchDefaultFlags
+	}
+
+	response, err := engine.WhySearch(ctx, attributes, entityID, options.SearchProfile, flags|senzing.SzIncludeFeatureScores)
+	if err != nil {
+		return Result{}, err //nolint:wrapcheck
+	}
+
+	return ParseWhySearchResponse(response)
+}
+
+/*
+The ParseSearchResponse function turns a SearchByAttributes() response into results, in response order.
+
+Input
+  - response: The JSON document returned by SearchByAttributes().
+
+Output
+  - A Result for each entity in RESOLVED_ENTITIES.
+*/
+func ParseSearchResponse(response string) ([]Result, error) {
+	var document searchDocument
+
+	err := json.Unmarshal([]byte(response), &document)
+	if err != nil {
+		return nil, fmt.Errorf("parsing search response: %w", err)
+	}
+
+	result := make([]Result, 0, len(document.ResolvedEntities))
+	for _, entity := range document.ResolvedEntities {
+		result = append(result, newResult(
+			entity.Entity.ResolvedEntity.EntityID,
+			entity.Entity.ResolvedEntity.EntityName,
+			entity.MatchInfo,
+		))
+	}
+
+	return result, nil
+}
+
+/*
+The ParseWhySearchResponse function turns a WhySearch() response into a result.
+
+Input
+  - response: The JSON document returned by WhySearch().
+
+Output
+  - The Result for the first entry of WHY_RESULTS.
+*/
+func ParseWhySearchResponse(response string) (Result, error) {
+	var document whyDocument
+
+	err := json.Unmarshal([]byte(response), &document)
+	if err != nil {
+		return Result{}, fmt.Errorf("parsing why search response: %w", err)
+	}
+
+	if len(document.WhyResults) == 0 {
+		return Result{}, fmt.Errorf("parsing why search response: %w", errNoWhyResults)
+	}
+
+	whyResult := document.WhyResults[0]
+	bestName := ""
+
+	for _, entity := range document.Entities {
+		if entity.ResolvedEntity.EntityID == whyResult.EntityID {
+			bestName = entity.ResolvedEntity.EntityName
+		}
+	}
+
+	return newResult(whyResult.EntityID, bestName, whyResult.MatchInfo), nil
+}
+
+/*
+The Rank function filters, orders and paginates results.
+Results are ordered by match level, strongest first, then by Score, highest first, then by EntityID,
+so the same results always come out in the same order.
+
+Input
+  - results: Results, as returned by ParseSearchResponse().
+  - options: MinMatchLevel and MinScore filter the results; Offset and Limit select the page.
+
+Output
+  - The page. Total counts the results kept by the filters.
+*/
+func Rank(results []Result, options Options) *Results {
+	kept := make([]Result, 0, len(results))
+
+	for _, result := range results {
+		if options.MinMatchLevel != MatchLevelUnknown &&
+			(result.MatchLevel == MatchLevelUnknown || result.MatchLevel > options.MinMatchLevel) {
+			continue
+		}
+
+		if result.Score < options.MinScore {
+			continue
+		}
+
+		kept = append(kept, result)
+	}
+
+	slices.SortStableFunc(kept, compareResults)
+
+	offset := min(max(options.Offset, 0), len(kept))
+	page := kept[offset:]
+
+	if options.Limit > 0 && len(page) > options.Limit {
+		page = page[:options.Limit]
+	}
+
+	return &Results{
+		Offset:  offset,
+		Results: page,
+		Total:   len(kept),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Attributes returns the query as the attributes JSON of SearchByAttributes() and WhySearch().
+Problems with the query are reported as *szrecord.Error values.
+*/
+func (query Query) Attributes() (string, error) {
+	if query.isEmpty() {
+		return "", &szrecord.Error{
+			Attribute: "Query",
+			Problem:   "has no features",
+		}
+	}
+
+	builder := szrecord.New("", "")
+
+	for _, name := range query.Names {
+		builder.AddName(name)
+	}
+
+	for _, address := range query.Addresses {
+		builder.AddAddress(address)
+	}
+
+	for _, phone := range query.Phones {
+		builder.AddPhone(phone)
+	}
+
+	for _, email := range query.Emails {
+		builder.AddEmail(email)
+	}
+
+	if query.DateOfBirth != "" {
+		builder.AddDateOfBirth(query.DateOfBirth)
+	}
+
+	if query.SSN != "" {
+		builder.AddSSN(query.SSN)
+	}
+
+	for _, feature := range query.Features {
+		builder.AddFeature(feature)
+	}
+
+	return builder.Build() //nolint:wrapcheck
+}
+
+/*
+Method String returns the MATCH_LEVEL_CODE of the match level, e.g. "POSSIBLY_SAME".
+*/
+func (matchLevel MatchLevel) String() string {
+	if code, ok := matchLevelCodes[matchLevel]; ok {
+		return code
+	}
+
+	return "MatchLevel(" + strconv.Itoa(int(matchLevel)) + ")"
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func compareResults(left Result, right Result) int {
+	if left.MatchLevel != right.MatchLevel {
+		// MatchLevelUnknown sorts last.
+		return cmp.Compare(sortableLevel(left.MatchLevel), sortableLevel(right.MatchLevel))
+	}
+
+	if left.Score != right.Score {
+		return cmp.Compare(right.Score, left.Score)
+	}
+
+	return cmp.Compare(left.EntityID, right.EntityID)
+}
+
+// Senzing has reported MATCH_LEVEL as a number and as MATCH_LEVEL_CODE; WhySearch() only gives the code.
+func matchLevelOf(matchInfo matchInfoDocument) MatchLevel {
+	if matchInfo.MatchLevelCode != "" {
+		for level, code := range matchLevelCodes {
+			if code == matchInfo.MatchLevelCode {
+				return level
+			}
+		}
+	}
+
+	level := MatchLevel(matchInfo.MatchLevel)
+	if _, ok := matchLevelCodes[level]; ok {
+		return level
+	}
+
+	return MatchLevelUnknown
+}
+
+func newResult(entityID int64, bestName string, matchInfo matchInfoDocument) Result {
+	result := Result{
+		BestName:      bestName,
+		EntityID:      entityID,
+		ERRuleCode:    cmp.Or(matchInfo.ERRuleCode, matchInfo.WhyERRuleCode),
+		FeatureScores: map[string][]FeatureScore{},
+		MatchKey:      cmp.Or(matchInfo.MatchKey, matchInfo.WhyKey),
+		MatchLevel:    matchLevelOf(matchInfo),
+		Score:         0,
+	}
+
+	for featureType, scores := range matchInfo.FeatureScores {
+		for _, score := range scores {
+			featureScore := FeatureScore{
+				CandidateFeature: cmp.Or(score.CandidateFeatDesc, score.CandidateFeat),
+				InboundFeature:   cmp.Or(score.InboundFeatDesc, score.InboundFeat),
+				Score:            scoreOf(score),
+				ScoreBucket:      score.ScoreBucket,
+			}
+			result.FeatureScores[featureType] = append(result.FeatureScores[featureType], featureScore)
+			result.Score = max(result.Score, featureScore.Score)
+		}
+	}
+
+	return result
+}
+
+// The SCORE of a feature or, in older versions, its FULL_SCORE or, for names without one, its GNR_FN.
+func scoreOf(score featureScoreDocument) int {
+	for _, candidate := range []*int{score.Score, score.FullScore, score.GNRFullName} {
+		if candidate != nil {
+			return *candidate
+		}
+	}
+
+	return 0
+}
+
+func sortableLevel(matchLevel MatchLevel) int {
+	if matchLevel == MatchLevelUnknown {
+		return int(MatchLevelNameOnly) + 1
+	}
+
+	return int(matchLevel)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (query Query) isEmpty() bool {
+	return len(query.Names) == 0 &&
+		len(query.Addresses) == 0 &&
+		len(query.Phones) == 0 &&
+		len(query.Emails) == 0 &&
+		query.DateOfBirth == "" &&
+		query.SSN == "" &&
+		len(query.Features) == 0
+}
@@ -0,0 +1,229 @@
+package szsearch_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szrecord"
+	"github.com/senzing-garage/sz-sdk-go-core/szsearch"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const searchResponse = `{"RESOLVED_ENTITIES":[
+{"MATCH_INFO":{"MATCH_LEVEL_CODE":"POSSIBLY_RELATED","MATCH_KEY":"+PHONE","ERRULE_CODE":"SF1","FEATURE_SCORES":{"PHONE":[{"INBOUND_FEAT_DESC":"702-919-1300","CANDIDATE_FEAT_DESC":"702-919-1300","SCORE":100,"SCORE_BUCKET":"SAME"}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":7,"ENTITY_NAME":"Bobby Smith"}}},
+{"MATCH_INFO":{"MATCH_LEVEL":1,"MATCH_LEVEL_CODE":"RESOLVED","MATCH_KEY":"+NAME+SSN","ERRULE_CODE":"SF1_PNAME_CSTAB","FEATURE_SCORES":{"NAME":[{"INBOUND_FEAT":"JOHNSON","CANDIDATE_FEAT":"JOHNSON","GNR_FN":100,"GNR_SN":100}],"SSN":[{"INBOUND_FEAT":"053-39-3251","CANDIDATE_FEAT":"053-39-3251","FULL_SCORE":100}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"JOHNSON"}}},
+{"MATCH_INFO":{"MATCH_LEVEL_CODE":"POSSIBLY_SAME","MATCH_KEY":"+NAME+DOB","FEATURE_SCORES":{"NAME":[{"INBOUND_FEAT_DESC":"Robert Smith","CANDIDATE_FEAT_DESC":"Bob Smith","SCORE":88,"SCORE_BUCKET":"CLOSE"}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":5,"ENTITY_NAME":"Bob Smith"}}},
+{"MATCH_INFO":{"MATCH_LEVEL_CODE":"POSSIBLY_SAME","MATCH_KEY":"+NAME+ADDRESS","FEATURE_SCORES":{"NAME":[{"INBOUND_FEAT_DESC":"Robert Smith","CANDIDATE_FEAT_DESC":"Robert Smyth","SCORE":92,"SCORE_BUCKET":"CLOSE"}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":9,"ENTITY_NAME":"Robert Smyth"}}},
+{"MATCH_INFO":{"MATCH_LEVEL_CODE":"NAME_ONLY","MATCH_KEY":"+NAME","FEATURE_SCORES":{"NAME":[{"INBOUND_FEAT_DESC":"Robert Smith","CANDIDATE_FEAT_DESC":"R Smith","SCORE":70,"SCORE_BUCKET":"LIKELY"}]}},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":3,"ENTITY_NAME":"R Smith"}}}
+]}`
+
+const whySearchResponse = `{"WHY_RESULTS":[{"ENTITY_ID":100001,"MATCH_INFO":{"WHY_KEY":"+PNAME+EMAIL","WHY_ERRULE_CODE":"SF1","MATCH_LEVEL_CODE":"POSSIBLY_RELATED","FEATURE_SCORES":{"EMAIL":[{"INBOUND_FEAT_DESC":"bsmith@work.com","CANDIDATE_FEAT_DESC":"bsmith@work.com","SCORE":100,"SCORE_BUCKET":"SAME"}]}}}],"ENTITIES":[{"RESOLVED_ENTITY":{"ENTITY_ID":100001,"ENTITY_NAME":"Robert Smith"}}]}`
+
+var query = szsearch.Query{
+	Names:  []szrecord.Name{{Full: "Robert Smith"}},
+	Emails: []string{"bsmith@work.com"},
+}
+
+type fakeEngine struct {
+	attributes    string
+	err           error
+	flags         int64
+	response      string
+	searchProfile string
+}
+
+func (engine *fakeEngine) SearchByAttributes(
+	ctx context.Context,
+	attributes string,
+	searchProfile string,
+	flags int64,
+) (string, error) {
+	_ = ctx
+	engine.attributes = attributes
+	engine.searchProfile = searchProfile
+	engine.flags = flags
+
+	return engine.response, engine.err
+}
+
+func (engine *fakeEngine) WhySearch(
+	ctx context.Context,
+	attributes string,
+	entityID int64,
+	searchProfile string,
+	flags int64,
+) (string, error) {
+	_ = entityID
+
+	return engine.SearchByAttributes(ctx, attributes, searchProfile, flags)
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSearch(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{response: searchResponse}
+
+	results, err := szsearch.Search(ctx, engine, query, szsearch.Options{SearchProfile: "SEARCH"})
+	require.NoError(test, err)
+	assert.JSONEq(test, `{"FEATURES": [{"NAME_FULL": "Robert Smith"}, {"EMAIL_ADDRESS": "bsmith@work.com"}]}`, engine.attributes)
+	assert.Equal(test, "SEARCH", engine.searchProfile)
+	assert.Equal(test, senzing.SzSearchByAttributesDefaultFlags, engine.flags)
+	assert.Equal(test, 5, results.Total)
+	assert.Equal(test, []int64{1, 9, 5, 7, 3}, entityIDs(results.Results))
+
+	first := results.Results[0]
+	assert.Equal(test, "JOHNSON", first.BestName)
+	assert.Equal(test, szsearch.MatchLevelResolved, first.MatchLevel)
+	assert.Equal(test, "+NAME+SSN", first.MatchKey)
+	assert.Equal(test, "SF1_PNAME_CSTAB", first.ERRuleCode)
+	assert.Equal(test, 100, first.Score)
+	assert.Equal(test, []szsearch.FeatureScore{{
+		CandidateFeature: "053-39-3251",
+		InboundFeature:   "053-39-3251",
+		Score:            100,
+		ScoreBucket:      "",
+	}}, first.FeatureScores["SSN"])
+}
+
+func TestSearch_addsRequiredFlags(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{response: searchResponse}
+
+	_, err := szsearch.Search(ctx, engine, query, szsearch.Options{Flags: senzing.SzSearchIncludeResolved})
+	require.NoError(test, err)
+	assert.Equal(
+		test,
+		senzing.SzSearchIncludeResolved|senzing.SzEntityIncludeEntityName|senzing.SzIncludeFeatureScores,
+		engine.flags,
+	)
+}
+
+func TestSearch_badQuery(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{response: searchResponse}
+
+	_, err := szsearch.Search(ctx, engine, szsearch.Query{DateOfBirth: "someday"}, szsearch.Options{})
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	assert.Empty(test, engine.attributes, "SearchByAttributes should not be called")
+
+	_, err = szsearch.Search(ctx, engine, szsearch.Query{}, szsearch.Options{})
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	assert.Equal(test, "Query has no features", err.Error())
+}
+
+func TestSearch_engineError(test *testing.T) {
+	ctx := test.Context()
+	engineError := errors.New("engine failed")
+	engine := &fakeEngine{err: engineError}
+
+	_, err := szsearch.Search(ctx, engine, query, szsearch.Options{})
+	require.ErrorIs(test, err, engineError)
+}
+
+func TestSearch_badResponse(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{response: "}{"}
+
+	_, err := szsearch.Search(ctx, engine, query, szsearch.Options{})
+	require.Error(test, err)
+}
+
+func TestRank(test *testing.T) {
+	results, err := szsearch.ParseSearchResponse(searchResponse)
+	require.NoError(test, err)
+
+	testCases := []struct {
+		name      string
+		options   szsearch.Options
+		entityIDs []int64
+		total     int
+	}{
+		{
+			name:      "minMatchLevel",
+			options:   szsearch.Options{MinMatchLevel: szsearch.MatchLevelPossiblySame},
+			entityIDs: []int64{1, 9, 5},
+			total:     3,
+		},
+		{
+			name:      "minScore",
+			options:   szsearch.Options{MinScore: 90},
+			entityIDs: []int64{1, 9, 7},
+			total:     3,
+		},
+		{
+			name:      "offsetAndLimit",
+			options:   szsearch.Options{Offset: 1, Limit: 2},
+			entityIDs: []int64{9, 5},
+			total:     5,
+		},
+		{
+			name:      "offsetPastEnd",
+			options:   szsearch.Options{Offset: 10},
+			entityIDs: []int64{},
+			total:     5,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			page := szsearch.Rank(results, testCase.options)
+			assert.Equal(test, testCase.entityIDs, entityIDs(page.Results))
+			assert.Equal(test, testCase.total, page.Total)
+		})
+	}
+}
+
+func TestRank_deterministic(test *testing.T) {
+	results := []szsearch.Result{
+		{EntityID: 3, MatchLevel: szsearch.MatchLevelUnknown, Score: 100},
+		{EntityID: 2, MatchLevel: szsearch.MatchLevelPossiblySame, Score: 80},
+		{EntityID: 1, MatchLevel: szsearch.MatchLevelPossiblySame, Score: 80},
+	}
+	page := szsearch.Rank(results, szsearch.Options{})
+	assert.Equal(test, []int64{1, 2, 3}, entityIDs(page.Results))
+}
+
+func TestWhy(test *testing.T) {
+	ctx := test.Context()
+	engine := &fakeEngine{response: whySearchResponse}
+
+	result, err := szsearch.Why(ctx, engine, query, 100001, szsearch.Options{})
+	require.NoError(test, err)
+	assert.Equal(test, senzing.SzWhySearchDefaultFlags, engine.flags)
+	assert.Equal(test, int64(100001), result.EntityID)
+	assert.Equal(test, "Robert Smith", result.BestName)
+	assert.Equal(test, "+PNAME+EMAIL", result.MatchKey)
+	assert.Equal(test, "SF1", result.ERRuleCode)
+	assert.Equal(test, szsearch.MatchLevelPossiblyRelated, result.MatchLevel)
+	assert.Equal(test, 100, result.Score)
+}
+
+func TestParseWhySearchResponse_noResults(test *testing.T) {
+	_, err := szsearch.ParseWhySearchResponse(`{"WHY_RESULTS": []}`)
+	require.Error(test, err)
+}
+
+func TestMatchLevel_String(test *testing.T) {
+	assert.Equal(test, "POSSIBLY_SAME", szsearch.MatchLevelPossiblySame.String())
+	assert.Equal(test, "MatchLevel(9)", szsearch.MatchLevel(9).String())
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func entityIDs(results []szsearch.Result) []int64 {
+	result := []int64{}
+	for _, entity := range results {
+		result = append(result, entity.EntityID)
+	}
+
+	return result
+}
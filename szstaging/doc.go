@@ -0,0 +1,29 @@
+/*
+Package szstaging rolls out a Senzing configuration in two phases.
+
+First, [Stager.Stage] registers a candidate configuration without making it the default.
+Its comment is tagged with the default configuration it was staged from, e.g. "[staged from 4016704640] Add WATCHLIST",
+and it is validated by a [Validator]; a [DiagnosticValidator] initializes a probe Szdiagnostic with it:
+
+	stager := szstaging.New(szConfigManager, &szstaging.DiagnosticValidator{
+		InstanceName: "probe",
+		Settings:     settings,
+	})
+	candidate, err := stager.Stage(ctx, configDefinition, "Add WATCHLIST")
+
+The Senzing library allows one SzDiagnostic per process, so a DiagnosticValidator always fails,
+with [ErrDiagnosticInUse], in a process that holds another SzDiagnostic, such as one created by an Szabstractfactory.
+Stage from a process without one, or provide another [Validator].
+
+Candidates can be listed with [Stager.List], validated again with [Stager.Validate] and dropped with [Stager.Abandon].
+Abandoned and promoted candidates are recorded in an [AbandonedStore]; share a [FileAbandonedStore],
+with [NewWithAbandonedStore], for every process that stages configurations in the same repository.
+Then [Stager.Promote] makes a candidate the default with ReplaceDefaultConfigID(), as a compare-and-swap
+against the default configuration it was staged from:
+
+	err = stager.Promote(ctx, candidate.ConfigID)
+
+If the default configuration changed meanwhile, Promote fails with szerror.ErrSzReplaceConflict
+and the candidate, now stale, should be staged again from the new default.
+*/
+package szstaging
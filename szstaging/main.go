@@ -0,0 +1,68 @@
+package szstaging
+
+import (
+	"context"
+	"errors"
+	"regexp"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type AbandonedStore interface keeps the configuration identifiers of abandoned and promoted candidates.
+Stagers sharing an AbandonedStore skip the candidates abandoned or promoted by any of them.
+See [FileAbandonedStore] and [MemoryAbandonedStore].
+*/
+type AbandonedStore interface {
+	Abandon(ctx context.Context, configID int64) error
+	Abandoned(ctx context.Context) ([]int64, error)
+	Promote(ctx context.Context, configID int64) error
+	Promoted(ctx context.Context) ([]int64, error)
+}
+
+/*
+Type ConfigManager interface is the part of [senzing.SzConfigManager] used for staging.
+Any senzing.SzConfigManager satisfies it.
+
+[senzing.SzConfigManager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzConfigManager
+*/
+type ConfigManager interface {
+	GetConfigRegistry(ctx context.Context) (string, error)
+	GetDefaultConfigID(ctx context.Context) (int64, error)
+	RegisterConfig(ctx context.Context, configDefinition string, configComment string) (int64, error)
+	ReplaceDefaultConfigID(ctx context.Context, currentDefaultConfigID int64, newDefaultConfigID int64) error
+}
+
+/*
+Type Validator interface checks that Senzing can run with a registered configuration.
+See [DiagnosticValidator].
+*/
+type Validator interface {
+	Validate(ctx context.Context, configID int64) error
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	baseTen        = 10
+	promotedPrefix = "promoted "
+	storeFileMode  = 0o600
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// ErrDiagnosticInUse is returned by [DiagnosticValidator.Validate] in a process where an SzDiagnostic is initialized.
+var ErrDiagnosticInUse = errors.New("an SzDiagnostic is already initialized in this process")
+
+var (
+	errAbandoned        = errors.New("the staged configuration was abandoned")
+	errNotStaged        = errors.New("not a staged configuration")
+	errPromoted         = errors.New("the staged configuration was promoted")
+	stagedCommentRegexp = regexp.MustCompile(`^\[staged from (\d+)\] ?(.*)$`)
+)
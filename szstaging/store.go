@@ -0,0 +1,229 @@
+package szstaging
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/*
+Type FileAbandonedStore struct is an AbandonedStore kept in a text file, one configuration identifier per line;
+promoted candidates are prefixed with "promoted ".
+Stagers in several processes may share the file: each abandonment or promotion is appended in a single write,
+and the file is read again on every call.
+*/
+type FileAbandonedStore struct {
+	path string
+}
+
+/*
+Type MemoryAbandonedStore struct is an AbandonedStore that is not persisted.
+Abandoned and promoted candidates are only skipped by the Stagers of this process that share it.
+*/
+type MemoryAbandonedStore struct {
+	abandoned []int64
+	mutex     sync.Mutex
+	promoted  []int64
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewFileAbandonedStore function returns a FileAbandonedStore.
+The file is created by the first abandonment or promotion.
+
+Input
+  - path: The text file.
+*/
+func NewFileAbandonedStore(path string) *FileAbandonedStore {
+	return &FileAbandonedStore{path: path}
+}
+
+/*
+The NewMemoryAbandonedStore function returns an empty MemoryAbandonedStore.
+*/
+func NewMemoryAbandonedStore() *MemoryAbandonedStore {
+	return &MemoryAbandonedStore{
+		abandoned: []int64{},
+		mutex:     sync.Mutex{},
+		promoted:  []int64{},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Public methods - FileAbandonedStore
+// ----------------------------------------------------------------------------
+
+/*
+Method Abandon appends the configuration identifier to the file.
+*/
+func (store *FileAbandonedStore) Abandon(ctx context.Context, configID int64) error {
+	_ = ctx
+
+	err := store.append(strconv.FormatInt(configID, baseTen))
+	if err != nil {
+		return fmt.Errorf("recording abandoned configuration: %w", err)
+	}
+
+	return nil
+}
+
+/*
+Method Abandoned reads the identifiers of abandoned configurations from the file.
+A missing file holds none. An incomplete last line, left by a crash, is ignored.
+*/
+func (store *FileAbandonedStore) Abandoned(ctx context.Context) ([]int64, error) {
+	_ = ctx
+
+	abandoned, _, err := store.read()
+	if err != nil {
+		return nil, fmt.Errorf("reading abandoned configurations: %w", err)
+	}
+
+	return abandoned, nil
+}
+
+/*
+Method Promote appends the configuration identifier, prefixed with "promoted ", to the file.
+*/
+func (store *FileAbandonedStore) Promote(ctx context.Context, configID int64) error {
+	_ = ctx
+
+	err := store.append(promotedPrefix + strconv.FormatInt(configID, baseTen))
+	if err != nil {
+		return fmt.Errorf("recording promoted configuration: %w", err)
+	}
+
+	return nil
+}
+
+/*
+Method Promoted reads the identifiers of promoted configurations from the file.
+A missing file holds none. An incomplete last line, left by a crash, is ignored.
+*/
+func (store *FileAbandonedStore) Promoted(ctx context.Context) ([]int64, error) {
+	_ = ctx
+
+	_, promoted, err := store.read()
+	if err != nil {
+		return nil, fmt.Errorf("reading promoted configurations: %w", err)
+	}
+
+	return promoted, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods - MemoryAbandonedStore
+// ----------------------------------------------------------------------------
+
+/*
+Method Abandon records the configuration identifier.
+*/
+func (store *MemoryAbandonedStore) Abandon(ctx context.Context, configID int64) error {
+	_ = ctx
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.abandoned = append(store.abandoned, configID)
+
+	return nil
+}
+
+/*
+Method Abandoned returns the recorded identifiers of abandoned configurations.
+*/
+func (store *MemoryAbandonedStore) Abandoned(ctx context.Context) ([]int64, error) {
+	_ = ctx
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	return slices.Clone(store.abandoned), nil
+}
+
+/*
+Method Promote records the configuration identifier.
+*/
+func (store *MemoryAbandonedStore) Promote(ctx context.Context, configID int64) error {
+	_ = ctx
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.promoted = append(store.promoted, configID)
+
+	return nil
+}
+
+/*
+Method Promoted returns the recorded identifiers of promoted configurations.
+*/
+func (store *MemoryAbandonedStore) Promoted(ctx context.Context) ([]int64, error) {
+	_ = ctx
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	return slices.Clone(store.promoted), nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods - FileAbandonedStore
+// ----------------------------------------------------------------------------
+
+// Append a line in a single write.
+func (store *FileAbandonedStore) append(line string) error {
+	file, err := os.OpenFile(store.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, storeFileMode)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	_, err = file.WriteString(line + "\n")
+	if err == nil {
+		err = file.Sync()
+	}
+
+	return errors.Join(err, file.Close())
+}
+
+// The abandoned and the promoted configuration identifiers.
+func (store *FileAbandonedStore) read() ([]int64, []int64, error) {
+	abandoned := []int64{}
+	promoted := []int64{}
+
+	content, err := os.ReadFile(store.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return abandoned, promoted, nil
+	}
+
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	lines := bytes.Split(content, []byte("\n"))
+	for index, line := range lines[:len(lines)-1] {
+		text, isPromoted := strings.CutPrefix(string(bytes.TrimSpace(line)), promotedPrefix)
+
+		configID, err := strconv.ParseInt(text, baseTen, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", index+1, err)
+		}
+
+		if isPromoted {
+			promoted = append(promoted, configID)
+		} else {
+			abandoned = append(abandoned, configID)
+		}
+	}
+
+	return abandoned, promoted, nil
+}
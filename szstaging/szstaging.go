@@ -0,0 +1,306 @@
+package szstaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-go-core/szconfigregistry"
+)
+
+/*
+Type Candidate struct is a staged configuration.
+*/
+type Candidate struct {
+	BaseConfigID int64     // The default configuration when the candidate was staged.
+	Comment      string    // The comment given to Stage().
+	ConfigID     int64     // The registered configuration.
+	CreatedAt    time.Time // When the candidate was staged.
+	IsStale      bool      // The default configuration is no longer BaseConfigID; Promote() will fail.
+}
+
+/*
+Type Stager struct stages configurations and promotes them to the default configuration.
+*/
+type Stager struct {
+	abandonedStore AbandonedStore
+	configManager  ConfigManager
+	validator      Validator
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The New function returns a Stager whose abandoned and promoted candidates are only known to it,
+in a [MemoryAbandonedStore]. Use [NewWithAbandonedStore] to share them with other Stagers and processes.
+
+Input
+  - configManager: A senzing.SzConfigManager.
+  - validator: Validates candidates; usually a DiagnosticValidator.
+*/
+func New(configManager ConfigManager, validator Validator) *Stager {
+	return NewWithAbandonedStore(configManager, validator, NewMemoryAbandonedStore())
+}
+
+/*
+The NewWithAbandonedStore function returns a Stager that keeps abandoned and promoted candidates in an AbandonedStore.
+
+Input
+  - configManager: A senzing.SzConfigManager.
+  - validator: Validates candidates; usually a DiagnosticValidator.
+  - abandonedStore: Where abandoned and promoted candidates are kept, such as a [FileAbandonedStore].
+*/
+func NewWithAbandonedStore(configManager ConfigManager, validator Validator, abandonedStore AbandonedStore) *Stager {
+	return &Stager{
+		abandonedStore: abandonedStore,
+		configManager:  configManager,
+		validator:      validator,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Abandon drops a candidate: it is no longer listed, and Promote() refuses it.
+
+Registered configurations cannot be unregistered, so the candidate stays in the configuration registry
+and is recorded in the AbandonedStore of the Stager.
+It is abandoned for every Stager sharing that store; with [New], that is only this Stager.
+A candidate becomes stale anyway once the default configuration changes.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The candidate.
+*/
+func (stager *Stager) Abandon(ctx context.Context, configID int64) error {
+	_, err := stager.candidate(ctx, configID)
+	if err != nil {
+		return err
+	}
+
+	return stager.abandonedStore.Abandon(ctx, configID) //nolint:wrapcheck
+}
+
+/*
+Method List lists the candidates that were neither promoted nor abandoned.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The candidates, oldest first.
+*/
+func (stager *Stager) List(ctx context.Context) ([]Candidate, error) {
+	defaultConfigID, err := stager.configManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	configRegistry, err := stager.configManager.GetConfigRegistry(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	entries, err := szconfigregistry.Parse(configRegistry, defaultConfigID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	abandoned, err := stager.abandonedStore.Abandoned(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	promoted, err := stager.abandonedStore.Promoted(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	result := []Candidate{}
+
+	for _, entry := range entries {
+		baseConfigID, comment, isStaged := parseComment(entry.Comment)
+		if !isStaged || entry.IsDefault ||
+			slices.Contains(abandoned, entry.ConfigID) || slices.Contains(promoted, entry.ConfigID) {
+			continue
+		}
+
+		result = append(result, Candidate{
+			BaseConfigID: baseConfigID,
+			Comment:      comment,
+			ConfigID:     entry.ConfigID,
+			CreatedAt:    entry.CreatedAt,
+			IsStale:      baseConfigID != defaultConfigID,
+		})
+	}
+
+	return result, nil
+}
+
+/*
+Method Promote validates a candidate and makes it the default configuration with ReplaceDefaultConfigID(),
+which fails with szerror.ErrSzReplaceConflict if the default configuration is no longer the one
+the candidate was staged from.
+The promotion is recorded in the AbandonedStore of the Stager, so the candidate is not listed again,
+even after another candidate is promoted.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The candidate.
+*/
+func (stager *Stager) Promote(ctx context.Context, configID int64) error {
+	candidate, err := stager.candidate(ctx, configID)
+	if err != nil {
+		return err
+	}
+
+	err = stager.validator.Validate(ctx, configID)
+	if err != nil {
+		return fmt.Errorf("validating staged configuration %d: %w", configID, err)
+	}
+
+	err = stager.configManager.ReplaceDefaultConfigID(ctx, candidate.BaseConfigID, configID)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return stager.abandonedStore.Promote(ctx, configID) //nolint:wrapcheck
+}
+
+/*
+Method Stage registers a configuration as a candidate, without making it the default configuration,
+and validates it. The comment of the registered configuration is tagged with the current default configuration,
+e.g. "[staged from 4016704640] Add WATCHLIST".
+
+A candidate that fails validation is abandoned.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configDefinition: The Senzing configuration JSON document.
+  - configComment: A free-form description.
+
+Output
+  - The candidate.
+*/
+func (stager *Stager) Stage(ctx context.Context, configDefinition string, configComment string) (*Candidate, error) {
+	defaultConfigID, err := stager.configManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	configID, err := stager.configManager.RegisterConfig(
+		ctx,
+		configDefinition,
+		stagedComment(defaultConfigID, configComment),
+	)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if configID == defaultConfigID {
+		return nil, fmt.Errorf("staging: %w; it is the default configuration %d", errNotStaged, configID)
+	}
+
+	candidate, err := stager.candidate(ctx, configID)
+	if err != nil {
+		return nil, fmt.Errorf("staging: configuration %d was registered before: %w", configID, err)
+	}
+
+	err = stager.validator.Validate(ctx, configID)
+	if err != nil {
+		return nil, errors.Join(
+			fmt.Errorf("validating staged configuration %d: %w", configID, err),
+			stager.abandonedStore.Abandon(ctx, configID),
+		)
+	}
+
+	return candidate, nil
+}
+
+/*
+Method Validate validates a candidate.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The candidate.
+*/
+func (stager *Stager) Validate(ctx context.Context, configID int64) error {
+	_, err := stager.candidate(ctx, configID)
+	if err != nil {
+		return err
+	}
+
+	return stager.validator.Validate(ctx, configID) //nolint:wrapcheck
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// A listed candidate.
+func (stager *Stager) candidate(ctx context.Context, configID int64) (*Candidate, error) {
+	abandoned, err := stager.abandonedStore.Abandoned(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if slices.Contains(abandoned, configID) {
+		return nil, fmt.Errorf("configuration %d: %w", configID, errAbandoned)
+	}
+
+	promoted, err := stager.abandonedStore.Promoted(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if slices.Contains(promoted, configID) {
+		return nil, fmt.Errorf("configuration %d: %w", configID, errPromoted)
+	}
+
+	candidates, err := stager.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		if candidate.ConfigID == configID {
+			return &candidate, nil
+		}
+	}
+
+	return nil, fmt.Errorf("configuration %d: %w", configID, errNotStaged)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// The default configuration a candidate was staged from, and the comment given to Stage().
+func parseComment(configComment string) (int64, string, bool) {
+	match := stagedCommentRegexp.FindStringSubmatch(configComment)
+	if match == nil {
+		return 0, "", false
+	}
+
+	baseConfigID, err := strconv.ParseInt(match[1], baseTen, 64)
+	if err != nil {
+		return 0, "", false
+	}
+
+	return baseConfigID, match[2], true
+}
+
+// The comment of a candidate, cut with szconfigmanager.TruncateComment.
+func stagedComment(baseConfigID int64, configComment string) string {
+	return szconfigmanager.TruncateComment(
+		"[staged from " + strconv.FormatInt(baseConfigID, baseTen) + "] " + configComment,
+	)
+}
@@ -0,0 +1,278 @@
+package szstaging_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/internal/testhelper"
+	"github.com/senzing-garage/sz-sdk-go-core/szabstractfactory"
+	"github.com/senzing-garage/sz-sdk-go-core/szstaging"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeValidator struct {
+	invalid   map[int64]bool
+	validated []int64
+}
+
+func (validator *fakeValidator) Validate(ctx context.Context, configID int64) error {
+	_ = ctx
+	validator.validated = append(validator.validated, configID)
+
+	if validator.invalid[configID] {
+		return errors.Join(szerror.ErrSzConfiguration, errors.New("bad configuration"))
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestStager_Stage(test *testing.T) {
+	test.Parallel()
+
+	ctx := test.Context()
+	manager := testhelper.NewConfigManager("{}")
+	validator := &fakeValidator{}
+	stager := szstaging.New(manager, validator)
+
+	candidate, err := stager.Stage(ctx, `{"STAGED": 1}`, "Add WATCHLIST")
+	require.NoError(test, err)
+	assert.Equal(test, int64(2), candidate.ConfigID)
+	assert.Equal(test, int64(1), candidate.BaseConfigID)
+	assert.Equal(test, "Add WATCHLIST", candidate.Comment)
+	assert.False(test, candidate.IsStale)
+	assert.Equal(test, "[staged from 1] Add WATCHLIST", manager.Comments[2])
+	assert.Equal(test, int64(1), manager.DefaultConfigID)
+	assert.Equal(test, []int64{2}, validator.validated)
+
+	candidates, err := stager.List(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, []szstaging.Candidate{*candidate}, candidates)
+}
+
+func TestStager_Stage_invalid(test *testing.T) {
+	test.Parallel()
+
+	ctx := test.Context()
+	manager := testhelper.NewConfigManager("{}")
+	stager := szstaging.New(manager, &fakeValidator{invalid: map[int64]bool{2: true}})
+
+	_, err := stager.Stage(ctx, `{"STAGED": 1}`, "Broken")
+	require.ErrorIs(test, err, szerror.ErrSzConfiguration)
+
+	candidates, err := stager.List(ctx)
+	require.NoError(test, err)
+	assert.Empty(test, candidates)
+	require.Error(test, stager.Promote(ctx, 2))
+}
+
+func TestStager_Promote(test *testing.T) {
+	test.Parallel()
+
+	ctx := test.Context()
+	manager := testhelper.NewConfigManager("{}")
+	validator := &fakeValidator{}
+	stager := szstaging.New(manager, validator)
+
+	candidate, err := stager.Stage(ctx, `{"STAGED": 1}`, "Add WATCHLIST")
+	require.NoError(test, err)
+	require.NoError(test, stager.Validate(ctx, candidate.ConfigID))
+	require.NoError(test, stager.Promote(ctx, candidate.ConfigID))
+	assert.Equal(test, candidate.ConfigID, manager.DefaultConfigID)
+	assert.Equal(test, []int64{2, 2, 2}, validator.validated)
+
+	candidates, err := stager.List(ctx)
+	require.NoError(test, err)
+	assert.Empty(test, candidates)
+	require.Error(test, stager.Promote(ctx, candidate.ConfigID))
+}
+
+func TestStager_Promote_stale(test *testing.T) {
+	test.Parallel()
+
+	ctx := test.Context()
+	manager := testhelper.NewConfigManager("{}")
+	stager := szstaging.New(manager, &fakeValidator{})
+
+	first, err := stager.Stage(ctx, `{"STAGED": 1}`, "First")
+	require.NoError(test, err)
+	second, err := stager.Stage(ctx, `{"STAGED": 2}`, "Second")
+	require.NoError(test, err)
+	require.NoError(test, stager.Promote(ctx, second.ConfigID))
+
+	candidates, err := stager.List(ctx)
+	require.NoError(test, err)
+	require.Len(test, candidates, 1)
+	assert.True(test, candidates[0].IsStale)
+
+	err = stager.Promote(ctx, first.ConfigID)
+	require.ErrorIs(test, err, szerror.ErrSzReplaceConflict)
+	assert.Equal(test, second.ConfigID, manager.DefaultConfigID)
+}
+
+func TestStager_Promote_promotedNotListed(test *testing.T) {
+	test.Parallel()
+
+	ctx := test.Context()
+	manager := testhelper.NewConfigManager("{}")
+	path := filepath.Join(test.TempDir(), "abandoned.txt")
+	stager := szstaging.NewWithAbandonedStore(manager, &fakeValidator{}, szstaging.NewFileAbandonedStore(path))
+
+	first, err := stager.Stage(ctx, `{"STAGED": 1}`, "First")
+	require.NoError(test, err)
+	require.NoError(test, stager.Promote(ctx, first.ConfigID))
+
+	second, err := stager.Stage(ctx, `{"STAGED": 2}`, "Second")
+	require.NoError(test, err)
+	require.NoError(test, stager.Promote(ctx, second.ConfigID))
+
+	// Another process sharing the file.
+	other := szstaging.NewWithAbandonedStore(manager, &fakeValidator{}, szstaging.NewFileAbandonedStore(path))
+	candidates, err := other.List(ctx)
+	require.NoError(test, err)
+	assert.Empty(test, candidates, "the first candidate is no longer the default but was promoted")
+	require.ErrorContains(test, other.Promote(ctx, first.ConfigID), "was promoted")
+	assert.Equal(test, second.ConfigID, manager.DefaultConfigID)
+}
+
+func TestStager_Abandon(test *testing.T) {
+	test.Parallel()
+
+	ctx := test.Context()
+	manager := testhelper.NewConfigManager("{}")
+	stager := szstaging.New(manager, &fakeValidator{})
+
+	candidate, err := stager.Stage(ctx, `{"STAGED": 1}`, "Add WATCHLIST")
+	require.NoError(test, err)
+	require.NoError(test, stager.Abandon(ctx, candidate.ConfigID))
+
+	candidates, err := stager.List(ctx)
+	require.NoError(test, err)
+	assert.Empty(test, candidates)
+	require.Error(test, stager.Promote(ctx, candidate.ConfigID))
+	require.Error(test, stager.Abandon(ctx, candidate.ConfigID))
+	require.Error(test, stager.Abandon(ctx, 1))
+	assert.Equal(test, int64(1), manager.DefaultConfigID)
+}
+
+func TestStager_Abandon_fileAbandonedStore(test *testing.T) {
+	test.Parallel()
+
+	ctx := test.Context()
+	manager := testhelper.NewConfigManager("{}")
+	path := filepath.Join(test.TempDir(), "abandoned.txt")
+	stager := szstaging.NewWithAbandonedStore(manager, &fakeValidator{}, szstaging.NewFileAbandonedStore(path))
+
+	candidate, err := stager.Stage(ctx, `{"STAGED": 1}`, "Add WATCHLIST")
+	require.NoError(test, err)
+	require.NoError(test, stager.Abandon(ctx, candidate.ConfigID))
+
+	// Another process sharing the file.
+	other := szstaging.NewWithAbandonedStore(manager, &fakeValidator{}, szstaging.NewFileAbandonedStore(path))
+	candidates, err := other.List(ctx)
+	require.NoError(test, err)
+	assert.Empty(test, candidates)
+	require.Error(test, other.Promote(ctx, candidate.ConfigID))
+}
+
+func TestFileAbandonedStore(test *testing.T) {
+	test.Parallel()
+
+	ctx := test.Context()
+	path := filepath.Join(test.TempDir(), "abandoned.txt")
+	store := szstaging.NewFileAbandonedStore(path)
+
+	abandoned, err := store.Abandoned(ctx)
+	require.NoError(test, err)
+	assert.Empty(test, abandoned)
+
+	require.NoError(test, os.WriteFile(path, []byte("2\n3\n45"), 0o600))
+
+	abandoned, err = store.Abandoned(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, []int64{2, 3}, abandoned, "the incomplete last line is ignored")
+
+	require.NoError(test, os.WriteFile(path, []byte("2\n"), 0o600))
+	require.NoError(test, store.Promote(ctx, 3))
+
+	abandoned, err = store.Abandoned(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, []int64{2}, abandoned)
+
+	promoted, err := store.Promoted(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, []int64{3}, promoted)
+
+	require.NoError(test, os.WriteFile(path, []byte("2\nthree\n"), 0o600))
+
+	_, err = store.Abandoned(ctx)
+	require.ErrorContains(test, err, "line 2")
+}
+
+func TestDiagnosticValidator_Validate_diagnosticInUse(test *testing.T) {
+	ctx := test.Context()
+	szAbstractFactory := &szabstractfactory.Szabstractfactory{
+		ConfigID:       senzing.SzInitializeWithDefaultConfiguration,
+		InstanceName:   test.Name(),
+		Settings:       testhelper.NewRepository(test, "CUSTOMERS"),
+		VerboseLogging: senzing.SzNoLogging,
+	}
+
+	defer func() { require.NoError(test, szAbstractFactory.Close(context.Background())) }()
+
+	_, err := szAbstractFactory.CreateDiagnostic(ctx)
+	require.NoError(test, err)
+
+	validator := &szstaging.DiagnosticValidator{InstanceName: "probe", Settings: szAbstractFactory.Settings}
+	require.ErrorIs(test, validator.Validate(ctx, 1), szstaging.ErrDiagnosticInUse)
+}
+
+func TestStager_Promote_szConfigManager(test *testing.T) {
+	ctx := test.Context()
+	szConfigManager := testhelper.NewSzConfigManager(test, "CUSTOMERS")
+
+	baseConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+
+	// Distinct definitions, as Senzing derives configuration identifiers from them.
+	withDataSource := func(dataSourceCode string) string {
+		szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, baseConfigID)
+		require.NoError(test, err)
+		_, err = szConfig.RegisterDataSource(ctx, dataSourceCode)
+		require.NoError(test, err)
+		configDefinition, err := szConfig.Export(ctx)
+		require.NoError(test, err)
+
+		return configDefinition
+	}
+
+	stager := szstaging.New(szConfigManager, &fakeValidator{})
+	first, err := stager.Stage(ctx, withDataSource("REFERENCE"), "First")
+	require.NoError(test, err)
+	second, err := stager.Stage(ctx, withDataSource("WATCHLIST"), "Second")
+	require.NoError(test, err)
+	assert.Equal(test, baseConfigID, second.BaseConfigID)
+	require.NoError(test, stager.Promote(ctx, second.ConfigID))
+
+	defaultConfigID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, second.ConfigID, defaultConfigID)
+
+	candidates, err := stager.List(ctx)
+	require.NoError(test, err)
+	require.Len(test, candidates, 1)
+	assert.Equal(test, first.ConfigID, candidates[0].ConfigID)
+	assert.True(test, candidates[0].IsStale)
+
+	err = stager.Promote(ctx, first.ConfigID)
+	require.ErrorIs(test, err, szerror.ErrSzReplaceConflict)
+}
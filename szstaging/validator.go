@@ -0,0 +1,44 @@
+package szstaging
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szdiagnostic"
+)
+
+/*
+Type DiagnosticValidator struct validates a configuration by initializing a probe Szdiagnostic with it.
+
+Important: the Senzing library allows one SzDiagnostic per process.
+Validate always fails with [ErrDiagnosticInUse] while another SzDiagnostic is initialized,
+such as one returned by Szabstractfactory.CreateDiagnostic() that has not been destroyed.
+Stage and promote configurations from a process that holds no SzDiagnostic, e.g. a separate deployment step,
+or use a Validator of your own.
+*/
+type DiagnosticValidator struct {
+	InstanceName   string
+	Settings       string
+	VerboseLogging int64
+}
+
+/*
+Method Validate initializes, then destroys, a probe Szdiagnostic with the configuration.
+It fails with [ErrDiagnosticInUse] if an SzDiagnostic is already initialized in this process.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configID: The configuration to validate.
+*/
+func (validator *DiagnosticValidator) Validate(ctx context.Context, configID int64) error {
+	probe := &szdiagnostic.Szdiagnostic{}
+	if probe.IsInitialized(ctx) {
+		return ErrDiagnosticInUse
+	}
+
+	err := probe.Initialize(ctx, validator.InstanceName, validator.Settings, configID, validator.VerboseLogging)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return probe.Destroy(ctx) //nolint:wrapcheck
+}
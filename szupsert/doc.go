@@ -0,0 +1,30 @@
+/*
+Package szupsert adds records only when their content changed since they were last added.
+
+Full refreshes often re-send records that have not changed, and each AddRecord() of
+[senzing.SzEngine] costs a full resolution pass.
+An [Upserter] keeps, in a [Store], a fingerprint of each record it added, by data source code and record ID.
+A record whose fingerprint is unchanged is skipped:
+
+	store, err := szupsert.OpenBoltStore("/var/lib/loader/fingerprints.db")
+	...
+	defer store.Close()
+
+	upserter := szupsert.New(szEngine, store)
+	result, err := upserter.Upsert(ctx, "CUSTOMERS", "1001", recordDefinition, senzing.SzNoFlags)
+	...
+	if result.Action == szupsert.ActionSkipped { ... }
+
+The fingerprint is the SHA-256 hash of the record in a canonical form: keys sorted, insignificant
+whitespace removed and the DATA_SOURCE and RECORD_ID keys left out, so formatting changes are not
+mistaken for changes of content.
+
+The store is only updated after AddRecord() or DeleteRecord() succeeds.
+If the process stops in between, the record is added again on the next run, which is harmless.
+When the store and the repository disagree, for instance after records were added or deleted by another
+program, [Upserter.Reconcile] rebuilds the fingerprints from GetRecord().
+The szupsert-reconcile command runs it against a [BoltStore].
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+package szupsert
@@ -0,0 +1,100 @@
+package szupsert
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Type Action int is what an upsert did.
+*/
+type Action int
+
+/*
+Type Engine interface is the part of [senzing.SzEngine] used for upserts.
+Any senzing.SzEngine satisfies it.
+
+[senzing.SzEngine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/senzing#SzEngine
+*/
+type Engine interface {
+	AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error)
+	DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error)
+	GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error)
+}
+
+/*
+Type Key struct identifies a record.
+*/
+type Key struct {
+	DataSourceCode string // Upper case. Example: "CUSTOMERS".
+	RecordID       string // Example: "1001".
+}
+
+/*
+Type Store interface keeps the fingerprints of records.
+Implementations must be safe for concurrent use.
+*/
+type Store interface {
+	Delete(key Key) error
+	Get(key Key) (string, bool, error)
+	Keys() ([]Key, error)
+	Put(key Key, fingerprint string) error
+}
+
+// Fragment of a GetRecord() response.
+type recordDocument struct {
+	JSONData json.RawMessage `json:"JSON_DATA"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+The Action* constants are what an upsert did.
+
+  - ActionAdded: The record was new or changed, and was added.
+  - ActionDeleted: The record was deleted.
+  - ActionSkipped: The record was unchanged; the repository was not called.
+*/
+const (
+	ActionAdded Action = iota
+	ActionDeleted
+	ActionSkipped
+)
+
+const (
+	openTimeout   = time.Second
+	storeFileMode = 0o600
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var (
+	errEmptyKey     = errors.New("the data source code and record ID must not be empty")
+	errNotAnObject  = errors.New("not a JSON object")
+	errTrailingData = errors.New("unexpected data after the JSON object")
+)
+
+// The bucket holding a bucket of fingerprints per data source code.
+var fingerprintsBucket = []byte("fingerprints")
+
+var actionNames = map[Action]string{
+	ActionAdded:   "ADDED",
+	ActionDeleted: "DELETED",
+	ActionSkipped: "SKIPPED",
+}
+
+// Keys that identify the record; they are part of the Key, not of the fingerprint.
+var recordAttributes = map[string]bool{
+	"DATA_SOURCE": true,
+	"RECORD_ID":   true,
+}
@@ -0,0 +1,239 @@
+package szupsert
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"go.etcd.io/bbolt"
+)
+
+/*
+Type BoltStore struct is a Store persisted in a [bbolt] database file.
+
+Each Put() and Delete() is its own transaction, committed to disk before it returns,
+so a crash loses no fingerprint that was stored.
+Fingerprints are kept in a bucket per data source code, keyed by record ID.
+
+[bbolt]: https://pkg.go.dev/go.etcd.io/bbolt
+*/
+type BoltStore struct {
+	database *bbolt.DB
+}
+
+/*
+Type MemoryStore struct is a Store that is not persisted, for tests and single runs.
+*/
+type MemoryStore struct {
+	fingerprints map[Key]string
+	mutex        sync.Mutex
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewMemoryStore function returns an empty MemoryStore.
+*/
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		fingerprints: map[Key]string{},
+		mutex:        sync.Mutex{},
+	}
+}
+
+/*
+The OpenBoltStore function opens, or creates, a BoltStore.
+Only one process may use the file at a time; opening it while another holds it fails after a second.
+
+Input
+  - path: The database file.
+
+Output
+  - The store. Close it when done.
+*/
+func OpenBoltStore(path string) (*BoltStore, error) {
+	options := *bbolt.DefaultOptions
+	options.Timeout = openTimeout
+
+	database, err := bbolt.Open(path, storeFileMode, &options)
+	if err != nil {
+		return nil, fmt.Errorf("opening fingerprint store: %w", err)
+	}
+
+	err = database.Update(func(transaction *bbolt.Tx) error {
+		_, err := transaction.CreateBucketIfNotExists(fingerprintsBucket)
+
+		return err //nolint:wrapcheck
+	})
+	if err != nil {
+		_ = database.Close()
+
+		return nil, fmt.Errorf("opening fingerprint store: %w", err)
+	}
+
+	return &BoltStore{database: database}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods - BoltStore
+// ----------------------------------------------------------------------------
+
+/*
+Method Close closes the database file.
+*/
+func (store *BoltStore) Close() error {
+	err := store.database.Close()
+	if err != nil {
+		return fmt.Errorf("closing fingerprint store: %w", err)
+	}
+
+	return nil
+}
+
+/*
+Method Delete forgets the fingerprint of a record.
+*/
+func (store *BoltStore) Delete(key Key) error {
+	err := store.database.Update(func(transaction *bbolt.Tx) error {
+		bucket := transaction.Bucket(fingerprintsBucket).Bucket([]byte(key.DataSourceCode))
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.Delete([]byte(key.RecordID))
+	})
+	if err != nil {
+		return fmt.Errorf("deleting fingerprint: %w", err)
+	}
+
+	return nil
+}
+
+/*
+Method Get returns the fingerprint of a record and whether there is one.
+*/
+func (store *BoltStore) Get(key Key) (string, bool, error) {
+	var (
+		fingerprint string
+		found       bool
+	)
+
+	err := store.database.View(func(transaction *bbolt.Tx) error {
+		bucket := transaction.Bucket(fingerprintsBucket).Bucket([]byte(key.DataSourceCode))
+		if bucket == nil {
+			return nil
+		}
+
+		value := bucket.Get([]byte(key.RecordID))
+		if value != nil {
+			fingerprint, found = string(value), true
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("reading fingerprint: %w", err)
+	}
+
+	return fingerprint, found, nil
+}
+
+/*
+Method Keys returns the records that have a fingerprint.
+*/
+func (store *BoltStore) Keys() ([]Key, error) {
+	result := []Key{}
+
+	err := store.database.View(func(transaction *bbolt.Tx) error {
+		return transaction.Bucket(fingerprintsBucket).ForEachBucket(func(dataSourceCode []byte) error {
+			bucket := transaction.Bucket(fingerprintsBucket).Bucket(dataSourceCode)
+
+			return bucket.ForEach(func(recordID []byte, _ []byte) error {
+				result = append(result, Key{DataSourceCode: string(dataSourceCode), RecordID: string(recordID)})
+
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading fingerprints: %w", err)
+	}
+
+	return result, nil
+}
+
+/*
+Method Put sets the fingerprint of a record.
+*/
+func (store *BoltStore) Put(key Key, fingerprint string) error {
+	if len(key.DataSourceCode) == 0 || len(key.RecordID) == 0 {
+		return fmt.Errorf("storing fingerprint: %w", errEmptyKey)
+	}
+
+	err := store.database.Update(func(transaction *bbolt.Tx) error {
+		bucket, err := transaction.Bucket(fingerprintsBucket).CreateBucketIfNotExists([]byte(key.DataSourceCode))
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		return bucket.Put([]byte(key.RecordID), []byte(fingerprint))
+	})
+	if err != nil {
+		return fmt.Errorf("storing fingerprint: %w", err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods - MemoryStore
+// ----------------------------------------------------------------------------
+
+/*
+Method Delete forgets the fingerprint of a record.
+*/
+func (store *MemoryStore) Delete(key Key) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	delete(store.fingerprints, key)
+
+	return nil
+}
+
+/*
+Method Get returns the fingerprint of a record and whether there is one.
+*/
+func (store *MemoryStore) Get(key Key) (string, bool, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	fingerprint, found := store.fingerprints[key]
+
+	return fingerprint, found, nil
+}
+
+/*
+Method Keys returns the records that have a fingerprint.
+*/
+func (store *MemoryStore) Keys() ([]Key, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	return slices.Collect(maps.Keys(store.fingerprints)), nil
+}
+
+/*
+Method Put sets the fingerprint of a record.
+*/
+func (store *MemoryStore) Put(key Key, fingerprint string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.fingerprints[key] = fingerprint
+
+	return nil
+}
@@ -0,0 +1,331 @@
+package szupsert
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+/*
+Type ReconcileSummary struct counts what [Upserter.Reconcile] did.
+*/
+type ReconcileSummary struct {
+	Checked   int // Records looked up with GetRecord().
+	Removed   int // Fingerprints removed because the record is not in the repository.
+	Unchanged int // Fingerprints that were correct.
+	Updated   int // Fingerprints added or corrected.
+}
+
+/*
+Type Result struct is the outcome of an upsert or a delete.
+*/
+type Result struct {
+	Action      Action // What was done.
+	Fingerprint string // The fingerprint of the record; "" after a delete.
+	Response    string // The response of AddRecord() or DeleteRecord(); "" when skipped.
+}
+
+/*
+Type Upserter struct adds records whose fingerprint changed and skips the others.
+It is safe for concurrent use, but concurrent upserts of the same record are not ordered.
+*/
+type Upserter struct {
+	engine Engine
+	store  Store
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Fingerprint function returns the fingerprint of a record definition.
+
+Input
+  - recordDefinition: A JSON object, as passed to AddRecord().
+
+Output
+  - The hexadecimal SHA-256 hash of the canonical form of the record.
+*/
+func Fingerprint(recordDefinition string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(recordDefinition))
+	decoder.UseNumber()
+
+	var record map[string]any
+
+	err := decoder.Decode(&record)
+	if err == nil && record == nil {
+		err = errNotAnObject
+	}
+
+	if err == nil {
+		_, err = decoder.Token()
+		if errors.Is(err, io.EOF) {
+			err = nil
+		} else {
+			err = errTrailingData
+		}
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("parsing record definition: %w", err)
+	}
+
+	for attribute := range recordAttributes {
+		delete(record, attribute)
+	}
+
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	err = encoder.Encode(record)
+	if err != nil {
+		return "", fmt.Errorf("encoding record definition: %w", err)
+	}
+
+	hash := sha256.Sum256(bytes.TrimSpace(buffer.Bytes()))
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+/*
+The NewKey function returns the Key of a record.
+
+Input
+  - dataSourceCode: Identifies the provenance of the data. It is not case-sensitive.
+  - recordID: The unique identifier within the records of the same data source.
+*/
+func NewKey(dataSourceCode string, recordID string) Key {
+	return Key{
+		DataSourceCode: strings.ToUpper(dataSourceCode),
+		RecordID:       recordID,
+	}
+}
+
+/*
+The New function returns an Upserter.
+
+Input
+  - engine: A senzing.SzEngine.
+  - store: Where fingerprints are kept, such as a [BoltStore] or a [MemoryStore].
+*/
+func New(engine Engine, store Store) *Upserter {
+	return &Upserter{
+		engine: engine,
+		store:  store,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+Method String returns the name of the action, e.g. "SKIPPED".
+*/
+func (action Action) String() string {
+	if name, ok := actionNames[action]; ok {
+		return name
+	}
+
+	return "Action(" + strconv.Itoa(int(action)) + ")"
+}
+
+/*
+Method Delete deletes a record and forgets its fingerprint.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - flags: Flags passed to DeleteRecord().
+
+Output
+  - The result, with the response of DeleteRecord().
+*/
+func (upserter *Upserter) Delete(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	flags int64,
+) (*Result, error) {
+	response, err := upserter.engine.DeleteRecord(ctx, dataSourceCode, recordID, flags)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	err = upserter.store.Delete(NewKey(dataSourceCode, recordID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{Action: ActionDeleted, Fingerprint: "", Response: response}, nil
+}
+
+/*
+Method Reconcile rebuilds fingerprints from the records in the repository.
+
+Each record in the store, and each of the extra keys, is read with GetRecord().
+Fingerprints of records that are not in the repository are removed; the others are recomputed from JSON_DATA.
+Use the extra keys for records that were added without this Upserter.
+
+Input
+  - ctx: A context to control lifecycle.
+  - keys: Records to check in addition to those in the store.
+
+Output
+  - What was done.
+*/
+func (upserter *Upserter) Reconcile(ctx context.Context, keys ...Key) (*ReconcileSummary, error) {
+	stored, err := upserter.store.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	toCheck := map[Key]bool{}
+	for _, key := range slices.Concat(stored, keys) {
+		toCheck[NewKey(key.DataSourceCode, key.RecordID)] = true
+	}
+
+	summary := &ReconcileSummary{}
+
+	for _, key := range slices.SortedFunc(maps.Keys(toCheck), compareKeys) {
+		err = ctx.Err()
+		if err != nil {
+			return summary, err //nolint:wrapcheck
+		}
+
+		err = upserter.reconcile(ctx, key, summary)
+		if err != nil {
+			return summary, err
+		}
+	}
+
+	return summary, nil
+}
+
+/*
+Method Upsert adds a record unless its fingerprint is the one stored after it was last added.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: Identifies the provenance of the data.
+  - recordID: The unique identifier within the records of the same data source.
+  - recordDefinition: A JSON document containing the record, as passed to AddRecord().
+  - flags: Flags passed to AddRecord().
+
+Output
+  - The result: ActionAdded with the response of AddRecord(), or ActionSkipped.
+*/
+func (upserter *Upserter) Upsert(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	recordDefinition string,
+	flags int64,
+) (*Result, error) {
+	fingerprint, err := Fingerprint(recordDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	key := NewKey(dataSourceCode, recordID)
+
+	stored, found, err := upserter.store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if found && stored == fingerprint {
+		return &Result{Action: ActionSkipped, Fingerprint: fingerprint, Response: ""}, nil
+	}
+
+	response, err := upserter.engine.AddRecord(ctx, dataSourceCode, recordID, recordDefinition, flags)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	err = upserter.store.Put(key, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{Action: ActionAdded, Fingerprint: fingerprint, Response: response}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (upserter *Upserter) reconcile(ctx context.Context, key Key, summary *ReconcileSummary) error {
+	summary.Checked++
+
+	stored, found, err := upserter.store.Get(key)
+	if err != nil {
+		return err
+	}
+
+	response, err := upserter.engine.GetRecord(
+		ctx,
+		key.DataSourceCode,
+		key.RecordID,
+		senzing.SzEntityIncludeRecordJSONData,
+	)
+
+	switch {
+	case errors.Is(err, szerror.ErrSzNotFound):
+		if found {
+			summary.Removed++
+
+			return upserter.store.Delete(key)
+		}
+
+		return nil
+	case err != nil:
+		return err //nolint:wrapcheck
+	}
+
+	var document recordDocument
+
+	err = json.Unmarshal([]byte(response), &document)
+	if err != nil {
+		return fmt.Errorf("parsing record %s %s: %w", key.DataSourceCode, key.RecordID, err)
+	}
+
+	fingerprint, err := Fingerprint(string(document.JSONData))
+	if err != nil {
+		return fmt.Errorf("record %s %s: %w", key.DataSourceCode, key.RecordID, err)
+	}
+
+	if found && stored == fingerprint {
+		summary.Unchanged++
+
+		return nil
+	}
+
+	summary.Updated++
+
+	return upserter.store.Put(key, fingerprint)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func compareKeys(a Key, b Key) int {
+	return strings.Compare(a.DataSourceCode+"\x00"+a.RecordID, b.DataSourceCode+"\x00"+b.RecordID)
+}
@@ -0,0 +1,279 @@
+package szupsert_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go-core/szupsert"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	record1001 = `{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001", "NAME_FULL": "Robert Smith", "AMOUNT": 100.50}`
+	reordered  = `{
+		"AMOUNT": 100.50,
+		"NAME_FULL": "Robert Smith"
+	}`
+	changed = `{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": "1001", "NAME_FULL": "Robert Smith", "AMOUNT": 100.5}`
+)
+
+type fakeEngine struct {
+	added   int
+	deleted int
+	err     error
+	records map[string]string
+}
+
+func newFakeEngine() *fakeEngine {
+	return &fakeEngine{records: map[string]string{}}
+}
+
+func (engine *fakeEngine) AddRecord(
+	ctx context.Context,
+	dataSourceCode string,
+	recordID string,
+	recordDefinition string,
+	flags int64,
+) (string, error) {
+	_, _ = ctx, flags
+
+	if engine.err != nil {
+		return "", engine.err
+	}
+
+	engine.added++
+	engine.records[dataSourceCode+"/"+recordID] = recordDefinition
+
+	return `{"AFFECTED_ENTITIES":[{"ENTITY_ID":1}]}`, nil
+}
+
+func (engine *fakeEngine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	_, _ = ctx, flags
+
+	if engine.err != nil {
+		return "", engine.err
+	}
+
+	engine.deleted++
+	delete(engine.records, dataSourceCode+"/"+recordID)
+
+	return "", nil
+}
+
+func (engine *fakeEngine) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	_ = ctx
+
+	if flags&senzing.SzEntityIncludeRecordJSONData == 0 {
+		return "", errors.New("JSON_DATA not requested")
+	}
+
+	record, found := engine.records[dataSourceCode+"/"+recordID]
+	if !found {
+		return "", errors.Join(szerror.ErrSzNotFound, errors.New("unknown record"))
+	}
+
+	return `{"DATA_SOURCE":"` + dataSourceCode + `","RECORD_ID":"` + recordID + `","JSON_DATA":` + record + `}`, nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestFingerprint(test *testing.T) {
+	expected, err := szupsert.Fingerprint(record1001)
+	require.NoError(test, err)
+	assert.Len(test, expected, 64)
+
+	actual, err := szupsert.Fingerprint(reordered)
+	require.NoError(test, err)
+	assert.Equal(test, expected, actual, "key order, whitespace, DATA_SOURCE and RECORD_ID do not matter")
+
+	actual, err = szupsert.Fingerprint(changed)
+	require.NoError(test, err)
+	assert.NotEqual(test, expected, actual, "numbers are compared as written")
+
+	for _, bad := range []string{``, `[1]`, `null`, `{"a": 1} {}`, `{"a":`} {
+		_, err = szupsert.Fingerprint(bad)
+		require.Error(test, err, bad)
+	}
+}
+
+func TestUpserter_Upsert(test *testing.T) {
+	ctx := test.Context()
+	engine := newFakeEngine()
+	upserter := szupsert.New(engine, szupsert.NewMemoryStore())
+
+	result, err := upserter.Upsert(ctx, "CUSTOMERS", "1001", record1001, senzing.SzWithInfo)
+	require.NoError(test, err)
+	assert.Equal(test, szupsert.ActionAdded, result.Action)
+	assert.Contains(test, result.Response, "AFFECTED_ENTITIES")
+
+	result, err = upserter.Upsert(ctx, "customers", "1001", reordered, senzing.SzWithInfo)
+	require.NoError(test, err)
+	assert.Equal(test, szupsert.ActionSkipped, result.Action)
+	assert.Empty(test, result.Response)
+
+	result, err = upserter.Upsert(ctx, "CUSTOMERS", "1001", changed, senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Equal(test, szupsert.ActionAdded, result.Action)
+	assert.Equal(test, 2, engine.added)
+}
+
+func TestUpserter_Upsert_failedAddIsNotRemembered(test *testing.T) {
+	ctx := test.Context()
+	engine := newFakeEngine()
+	engine.err = errors.New("engine failed")
+	upserter := szupsert.New(engine, szupsert.NewMemoryStore())
+
+	_, err := upserter.Upsert(ctx, "CUSTOMERS", "1001", record1001, senzing.SzNoFlags)
+	require.ErrorIs(test, err, engine.err)
+
+	engine.err = nil
+	result, err := upserter.Upsert(ctx, "CUSTOMERS", "1001", record1001, senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Equal(test, szupsert.ActionAdded, result.Action)
+}
+
+func TestUpserter_Upsert_badRecord(test *testing.T) {
+	engine := newFakeEngine()
+	upserter := szupsert.New(engine, szupsert.NewMemoryStore())
+
+	_, err := upserter.Upsert(test.Context(), "CUSTOMERS", "1001", `{"NAME_FULL":`, senzing.SzNoFlags)
+	require.Error(test, err)
+	assert.Equal(test, 0, engine.added)
+}
+
+func TestUpserter_Delete(test *testing.T) {
+	ctx := test.Context()
+	engine := newFakeEngine()
+	store := szupsert.NewMemoryStore()
+	upserter := szupsert.New(engine, store)
+
+	_, err := upserter.Upsert(ctx, "CUSTOMERS", "1001", record1001, senzing.SzNoFlags)
+	require.NoError(test, err)
+
+	result, err := upserter.Delete(ctx, "CUSTOMERS", "1001", senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Equal(test, szupsert.ActionDeleted, result.Action)
+
+	_, found, err := store.Get(szupsert.NewKey("CUSTOMERS", "1001"))
+	require.NoError(test, err)
+	assert.False(test, found)
+
+	result, err = upserter.Upsert(ctx, "CUSTOMERS", "1001", record1001, senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Equal(test, szupsert.ActionAdded, result.Action)
+}
+
+func TestUpserter_Reconcile(test *testing.T) {
+	ctx := test.Context()
+	engine := newFakeEngine()
+	store := szupsert.NewMemoryStore()
+	upserter := szupsert.New(engine, store)
+
+	_, err := upserter.Upsert(ctx, "CUSTOMERS", "1001", record1001, senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = upserter.Upsert(ctx, "CUSTOMERS", "1002", `{"NAME_FULL": "Jane Doe"}`, senzing.SzNoFlags)
+	require.NoError(test, err)
+
+	// Changes made without the Upserter.
+	delete(engine.records, "CUSTOMERS/1002")
+	engine.records["CUSTOMERS/1003"] = `{"NAME_FULL": "John Doe"}`
+	require.NoError(test, store.Put(szupsert.NewKey("CUSTOMERS", "1001"), "stale"))
+
+	summary, err := upserter.Reconcile(ctx, szupsert.NewKey("CUSTOMERS", "1003"), szupsert.NewKey("CUSTOMERS", "1004"))
+	require.NoError(test, err)
+	assert.Equal(test, szupsert.ReconcileSummary{Checked: 4, Removed: 1, Unchanged: 0, Updated: 2}, *summary)
+
+	keys, err := store.Keys()
+	require.NoError(test, err)
+	assert.ElementsMatch(test, []szupsert.Key{szupsert.NewKey("CUSTOMERS", "1001"), szupsert.NewKey("CUSTOMERS", "1003")}, keys)
+
+	result, err := upserter.Upsert(ctx, "CUSTOMERS", "1001", record1001, senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Equal(test, szupsert.ActionSkipped, result.Action)
+
+	summary, err = upserter.Reconcile(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, 2, summary.Unchanged)
+}
+
+func TestBoltStore(test *testing.T) {
+	path := filepath.Join(test.TempDir(), "fingerprints.db")
+	key1 := szupsert.NewKey("CUSTOMERS", "1001")
+	key2 := szupsert.NewKey("CUSTOMERS", "1002")
+	key3 := szupsert.NewKey("WATCHLIST", "1001")
+
+	store, err := szupsert.OpenBoltStore(path)
+	require.NoError(test, err)
+	require.NoError(test, store.Put(key1, "one"))
+	require.NoError(test, store.Put(key1, "uno"))
+	require.NoError(test, store.Put(key2, "two"))
+	require.NoError(test, store.Put(key3, "three"))
+	require.NoError(test, store.Delete(key2))
+	require.NoError(test, store.Delete(szupsert.NewKey("UNKNOWN", "1")))
+	require.NoError(test, store.Close())
+
+	store, err = szupsert.OpenBoltStore(path)
+	require.NoError(test, err)
+
+	defer func() { require.NoError(test, store.Close()) }()
+
+	fingerprint, found, err := store.Get(key1)
+	require.NoError(test, err)
+	assert.True(test, found)
+	assert.Equal(test, "uno", fingerprint)
+
+	_, found, err = store.Get(key2)
+	require.NoError(test, err)
+	assert.False(test, found)
+
+	_, found, err = store.Get(szupsert.NewKey("UNKNOWN", "1"))
+	require.NoError(test, err)
+	assert.False(test, found)
+
+	keys, err := store.Keys()
+	require.NoError(test, err)
+	assert.ElementsMatch(test, []szupsert.Key{key1, key3}, keys)
+}
+
+func TestBoltStore_emptyKey(test *testing.T) {
+	store, err := szupsert.OpenBoltStore(filepath.Join(test.TempDir(), "fingerprints.db"))
+	require.NoError(test, err)
+
+	defer func() { require.NoError(test, store.Close()) }()
+
+	require.Error(test, store.Put(szupsert.NewKey("", "1001"), "one"))
+	require.Error(test, store.Put(szupsert.NewKey("CUSTOMERS", ""), "one"))
+}
+
+func TestBoltStore_inUse(test *testing.T) {
+	path := filepath.Join(test.TempDir(), "fingerprints.db")
+
+	store, err := szupsert.OpenBoltStore(path)
+	require.NoError(test, err)
+
+	defer func() { require.NoError(test, store.Close()) }()
+
+	_, err = szupsert.OpenBoltStore(path)
+	require.Error(test, err)
+}
+
+func TestBoltStore_corrupt(test *testing.T) {
+	path := filepath.Join(test.TempDir(), "fingerprints.db")
+	require.NoError(test, os.WriteFile(path, []byte("garbage\n{}\n"), 0o600))
+
+	_, err := szupsert.OpenBoltStore(path)
+	require.Error(test, err)
+}
+
+func TestAction_String(test *testing.T) {
+	assert.Equal(test, "SKIPPED", szupsert.ActionSkipped.String())
+	assert.Equal(test, "Action(9)", szupsert.Action(9).String())
+}
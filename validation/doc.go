@@ -0,0 +1,21 @@
+/*
+Package validation checks string parameters before they are sent to the Senzing C binary.
+
+The [szconfig], [szconfigmanager], [szdiagnostic], [szengine], and [szproduct] packages
+reject parameters that would be altered or misread by the C binary:
+
+  - Embedded NUL bytes, which silently truncate C strings.
+  - Invalid UTF-8.
+  - JSON parameters, such as record definitions and search attributes, that do not parse.
+  - Values larger than the configured [Limits].
+
+Rejected parameters are reported as [szerror.ErrSzBadInput] errors without calling the C binary.
+
+[szconfig]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfig
+[szconfigmanager]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szconfigmanager
+[szdiagnostic]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szdiagnostic
+[szengine]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szengine
+[szproduct]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go-core/szproduct
+[szerror.ErrSzBadInput]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+*/
+package validation
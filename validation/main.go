@@ -0,0 +1,28 @@
+package validation
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+DefaultMaxDocumentBytes is the default size limit of JSON documents, settings and configuration definitions.
+
+DefaultMaxIdentifierBytes is the default size limit of identifiers, such as data source codes and record IDs.
+*/
+const (
+	DefaultMaxDocumentBytes   = 64 << 20
+	DefaultMaxIdentifierBytes = 64 << 10
+)
+
+const (
+	kindDocument inputKind = iota
+	kindIdentifier
+	kindJSON
+)
+
+const (
+	problemInvalidJSON  = "is not valid JSON"
+	problemInvalidUTF8  = "is not valid UTF-8"
+	problemNulByte      = "contains a NUL byte at offset %d"
+	problemSizeExceeded = "is %d bytes; the limit is %d bytes"
+)
@@ -0,0 +1,229 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+/*
+Type Error struct describes a parameter that was rejected before calling the Senzing C binary.
+
+[errors.Is] reports true for [szerror.ErrSzBadInput] and [szerror.ErrSz].
+
+[szerror.ErrSz]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+[szerror.ErrSzBadInput]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+*/
+type Error struct {
+	Name    string // Name of the rejected parameter. Example: "recordDefinition".
+	Problem string // Why the parameter was rejected.
+}
+
+/*
+Type Input struct is a named parameter to be checked by [Check].
+Create one with [Document], [Identifier] or [JSON].
+*/
+type Input struct {
+	kind  inputKind
+	name  string
+	value string
+}
+
+/*
+Type Limits struct holds the limits applied by [Check].
+A size limit of zero disables the check.
+
+ParseJSON is on by default, so JSON that does not parse is rejected before calling the Senzing C binary.
+Turn it off to let the C binary report such JSON with its own error number and reason.
+*/
+type Limits struct {
+	MaxDocumentBytes   int  // Size limit of JSON documents, settings and configuration definitions.
+	MaxIdentifierBytes int  // Size limit of identifiers, such as data source codes and record IDs.
+	ParseJSON          bool // Reject JSON parameters that do not parse.
+}
+
+type inputKind int
+
+var (
+	limits      = DefaultLimits()
+	limitsMutex sync.RWMutex
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The Check function validates parameters in order and reports the first rejected one.
+
+Input
+  - inputs: The parameters to check.
+
+Output
+  - nil if all parameters are acceptable, otherwise an *Error.
+*/
+func Check(inputs ...Input) error {
+	currentLimits := GetLimits()
+
+	for _, input := range inputs {
+		if problem := input.problem(currentLimits); problem != "" {
+			return &Error{
+				Name:    input.name,
+				Problem: problem,
+			}
+		}
+	}
+
+	return nil
+}
+
+/*
+The DefaultLimits function returns the limits used when none have been set.
+
+Output
+  - The default limits.
+*/
+func DefaultLimits() Limits {
+	return Limits{
+		MaxDocumentBytes:   DefaultMaxDocumentBytes,
+		MaxIdentifierBytes: DefaultMaxIdentifierBytes,
+		ParseJSON:          true,
+	}
+}
+
+/*
+The Document function describes a text document parameter, such as settings or a configuration definition.
+Its content is not parsed.
+
+Input
+  - name: The name of the parameter. Example: "settings".
+  - value: The value of the parameter.
+
+Output
+  - An Input for [Check].
+*/
+func Document(name string, value string) Input {
+	return Input{
+		kind:  kindDocument,
+		name:  name,
+		value: value,
+	}
+}
+
+/*
+The GetLimits function returns the limits currently applied by [Check].
+
+Output
+  - The current limits.
+*/
+func GetLimits() Limits {
+	limitsMutex.RLock()
+	defer limitsMutex.RUnlock()
+
+	return limits
+}
+
+/*
+The Identifier function describes a short parameter, such as a data source code or record ID.
+
+Input
+  - name: The name of the parameter. Example: "recordID".
+  - value: The value of the parameter.
+
+Output
+  - An Input for [Check].
+*/
+func Identifier(name string, value string) Input {
+	return Input{
+		kind:  kindIdentifier,
+		name:  name,
+		value: value,
+	}
+}
+
+/*
+The JSON function describes a JSON document parameter, such as a record definition.
+A non-empty value must parse as JSON, unless [Limits] ParseJSON is turned off.
+An empty value is accepted, as some methods use it to disable a capability.
+
+Input
+  - name: The name of the parameter. Example: "recordDefinition".
+  - value: The value of the parameter.
+
+Output
+  - An Input for [Check].
+*/
+func JSON(name string, value string) Input {
+	return Input{
+		kind:  kindJSON,
+		name:  name,
+		value: value,
+	}
+}
+
+/*
+The SetLimits function changes the limits applied by [Check] for the whole process.
+
+Input
+  - newLimits: The limits. A size limit of zero disables the check.
+*/
+func SetLimits(newLimits Limits) {
+	limitsMutex.Lock()
+	defer limitsMutex.Unlock()
+
+	limits = newLimits
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+/*
+Method Error returns a description of the rejected parameter.
+*/
+func (validationError *Error) Error() string {
+	return validationError.Name + " " + validationError.Problem
+}
+
+/*
+Method Is reports true for [szerror.ErrSzBadInput] and [szerror.ErrSz] so that [errors.Is] identifies rejected parameters.
+
+[szerror.ErrSz]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+[szerror.ErrSzBadInput]: https://pkg.go.dev/github.com/senzing-garage/sz-sdk-go/szerror
+*/
+func (validationError *Error) Is(target error) bool {
+	return target == szerror.ErrSzBadInput || target == szerror.ErrSz //nolint:errorlint
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (input Input) problem(currentLimits Limits) string {
+	maxBytes := currentLimits.MaxDocumentBytes
+	if input.kind == kindIdentifier {
+		maxBytes = currentLimits.MaxIdentifierBytes
+	}
+
+	if maxBytes > 0 && len(input.value) > maxBytes {
+		return fmt.Sprintf(problemSizeExceeded, len(input.value), maxBytes)
+	}
+
+	if offset := strings.IndexByte(input.value, 0); offset >= 0 {
+		return fmt.Sprintf(problemNulByte, offset)
+	}
+
+	if !utf8.ValidString(input.value) {
+		return problemInvalidUTF8
+	}
+
+	if currentLimits.ParseJSON && input.kind == kindJSON && len(input.value) > 0 && !json.Valid([]byte(input.value)) {
+		return problemInvalidJSON
+	}
+
+	return ""
+}
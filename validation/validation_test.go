@@ -0,0 +1,121 @@
+package validation_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/senzing-garage/sz-sdk-go-core/validation"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestValidation_Check(test *testing.T) {
+	err := validation.Check(
+		validation.Identifier("dataSourceCode", "CUSTOMERS"),
+		validation.Identifier("recordID", "1001"),
+		validation.JSON("recordDefinition", `{"NAME_FULL": "Bob Smith"}`),
+		validation.JSON("avoidEntityIDs", ""),
+		validation.Document("settings", "}{"),
+	)
+	require.NoError(test, err)
+}
+
+func TestValidation_Check_badJSON(test *testing.T) {
+	err := validation.Check(validation.JSON("recordDefinition", "}{"))
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	assert.Equal(test, "recordDefinition is not valid JSON", err.Error())
+
+	var validationError *validation.Error
+	require.ErrorAs(test, err, &validationError)
+	assert.Equal(test, "recordDefinition", validationError.Name)
+}
+
+func TestValidation_Check_badJSONNotParsed(test *testing.T) {
+	defer validation.SetLimits(validation.DefaultLimits())
+
+	limits := validation.DefaultLimits()
+	limits.ParseJSON = false
+	validation.SetLimits(limits)
+
+	err := validation.Check(validation.JSON("recordDefinition", "}{"))
+	require.NoError(test, err)
+}
+
+func TestValidation_Check_invalidUTF8(test *testing.T) {
+	err := validation.Check(validation.Identifier("recordID", "10\xff01"))
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	assert.Equal(test, "recordID is not valid UTF-8", err.Error())
+}
+
+func TestValidation_Check_nulByte(test *testing.T) {
+	err := validation.Check(
+		validation.Identifier("dataSourceCode", "CUSTOMERS"),
+		validation.Identifier("recordID", "1001\x00DROP"),
+	)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	assert.Equal(test, "recordID contains a NUL byte at offset 4", err.Error())
+}
+
+func TestValidation_Check_nulByteInJSON(test *testing.T) {
+	err := validation.Check(validation.JSON("attributes", "{\"NAME_FULL\": \"Bob\x00\"}"))
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+}
+
+func TestValidation_SetLimits(test *testing.T) {
+	defer validation.SetLimits(validation.DefaultLimits())
+
+	validation.SetLimits(validation.Limits{MaxDocumentBytes: 10, MaxIdentifierBytes: 2, ParseJSON: true})
+	assert.Equal(test, 2, validation.GetLimits().MaxIdentifierBytes)
+
+	err := validation.Check(validation.Identifier("recordID", "1001"))
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	assert.Equal(test, "recordID is 4 bytes; the limit is 2 bytes", err.Error())
+
+	err = validation.Check(validation.JSON("recordDefinition", `{"NAME_FULL": "Bob Smith"}`))
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+
+	validation.SetLimits(validation.Limits{MaxDocumentBytes: 0, MaxIdentifierBytes: 0, ParseJSON: true})
+	err = validation.Check(validation.Identifier("recordID", strings.Repeat("1", validation.DefaultMaxIdentifierBytes+1)))
+	require.NoError(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Fuzz tests
+// ----------------------------------------------------------------------------
+
+func FuzzValidation_Identifier(fuzz *testing.F) {
+	for _, seed := range []string{"", "1001", "CUSTOMERS", "10\x0001", "\xff", "Zoë"} {
+		fuzz.Add(seed)
+	}
+
+	fuzz.Fuzz(func(test *testing.T, value string) {
+		err := validation.Check(validation.Identifier("recordID", value))
+		expectValid := !strings.Contains(value, "\x00") && utf8.ValidString(value)
+		assert.Equal(test, expectValid, err == nil, "value: %q", value)
+	})
+}
+
+func FuzzValidation_JSON(fuzz *testing.F) {
+	for _, seed := range []string{"", "{}", "}{", `{"NAME_FULL": "Bob Smith"}`, "{\"A\": \"\x00\"}", "[\"\xff\"]"} {
+		fuzz.Add(seed)
+	}
+
+	fuzz.Fuzz(func(test *testing.T, value string) {
+		err := validation.Check(validation.JSON("recordDefinition", value))
+		expectValid := !strings.Contains(value, "\x00") &&
+			utf8.ValidString(value) &&
+			(value == "" || json.Valid([]byte(value)))
+		assert.Equal(test, expectValid, err == nil, "value: %q", value)
+
+		if err != nil {
+			require.ErrorIs(test, err, szerror.ErrSzBadInput)
+		}
+	})
+}